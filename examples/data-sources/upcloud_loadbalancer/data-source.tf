@@ -0,0 +1,19 @@
+# Read load balancer details by name
+data "upcloud_loadbalancer" "web" {
+  name = "web-lb"
+  zone = "fi-hel1"
+}
+
+# Names and IP addresses of the backend members
+output "web_backend_members" {
+  value = {
+    for backend in data.upcloud_loadbalancer.web.backends : backend.name => [
+      for member in backend.members : "${member.ip}:${member.port}"
+    ]
+  }
+}
+
+# Operational states of the load balancer nodes
+output "web_node_states" {
+  value = data.upcloud_loadbalancer.web.nodes[*].operational_state
+}
@@ -0,0 +1,32 @@
+data "upcloud_loadbalancer" "web" {
+  name = "web-lb"
+  zone = "fi-hel1"
+}
+
+# Read health and traffic metrics of the load balancer and ensure that all backend members are healthy
+data "upcloud_loadbalancer_metrics" "web" {
+  id = data.upcloud_loadbalancer.web.id
+
+  lifecycle {
+    postcondition {
+      condition     = self.all_members_healthy
+      error_message = "All backend members of the load balancer must be healthy."
+    }
+  }
+}
+
+# Health statuses of the backend members
+output "web_member_statuses" {
+  value = {
+    for backend in data.upcloud_loadbalancer_metrics.web.backends : backend.name => {
+      for member in backend.members : member.name => member.status
+    }
+  }
+}
+
+# Number of HTTP requests per frontend
+output "web_frontend_requests" {
+  value = {
+    for frontend in data.upcloud_loadbalancer_metrics.web.frontends : frontend.name => frontend.total_http_requests
+  }
+}
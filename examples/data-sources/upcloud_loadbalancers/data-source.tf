@@ -0,0 +1,17 @@
+# List production load balancers attached to a private network in fi-hel1 zone
+data "upcloud_loadbalancers" "production" {
+  zone    = "fi-hel1"
+  network = "03000000-0000-4000-8000-000000000001"
+
+  labels = {
+    env = "production"
+  }
+}
+
+output "production_loadbalancer_dns_names" {
+  value = {
+    for lb in data.upcloud_loadbalancers.production.loadbalancers : lb.name => [
+      for network in lb.networks : network.dns_name if network.type == "public"
+    ]
+  }
+}
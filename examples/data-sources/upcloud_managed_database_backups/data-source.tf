@@ -0,0 +1,27 @@
+# Use data source to list the available backups of a managed database and restore one of them into a new service
+
+# Create a Managed PostgreSQL resource
+resource "upcloud_managed_database_postgresql" "example" {
+  name  = "postgres-example1"
+  title = "postgres-example1"
+  plan  = "1x1xCPU-2GB-25GB"
+  zone  = "fi-hel1"
+}
+
+# Read the available backups of the service
+data "upcloud_managed_database_backups" "example" {
+  service = upcloud_managed_database_postgresql.example.id
+}
+
+# Restore the state of the oldest backup into a new service
+resource "upcloud_managed_database_postgresql" "restored" {
+  name  = "postgres-example1-restored"
+  title = "postgres-example1-restored"
+  plan  = "1x1xCPU-2GB-25GB"
+  zone  = "fi-hel1"
+
+  fork {
+    service              = upcloud_managed_database_postgresql.example.id
+    recovery_target_time = data.upcloud_managed_database_backups.example.backups[0].backup_time
+  }
+}
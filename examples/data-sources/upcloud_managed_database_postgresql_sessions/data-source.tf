@@ -12,3 +12,11 @@ resource "upcloud_managed_database_postgresql" "example" {
 data "upcloud_managed_database_postgresql_sessions" "example" {
   service = upcloud_managed_database_postgresql.example.id
 }
+
+# Read the sessions of a specific user that have been running a query for at least 30 seconds
+data "upcloud_managed_database_postgresql_sessions" "long_running" {
+  service            = upcloud_managed_database_postgresql.example.id
+  username           = "upadmin"
+  state              = "active"
+  min_query_duration = "30s"
+}
@@ -0,0 +1,31 @@
+resource "upcloud_managed_object_storage" "this" {
+  name              = "example"
+  region            = "europe-1"
+  configured_status = "started"
+}
+
+data "upcloud_managed_object_storage_policy_document" "read_website" {
+  statement {
+    sid       = "ListBucket"
+    actions   = ["s3:ListBucket"]
+    resources = ["arn:aws:s3:::website"]
+
+    condition {
+      test     = "StringLike"
+      variable = "s3:prefix"
+      values   = ["public/*"]
+    }
+  }
+
+  statement {
+    sid       = "ReadObjects"
+    actions   = ["s3:GetObject", "s3:GetObjectVersion"]
+    resources = ["arn:aws:s3:::website/public/*"]
+  }
+}
+
+resource "upcloud_managed_object_storage_policy" "read_website" {
+  name         = "read-website"
+  document     = data.upcloud_managed_object_storage_policy_document.read_website.document
+  service_uuid = upcloud_managed_object_storage.this.id
+}
@@ -0,0 +1,12 @@
+# Find a server by its hostname
+data "upcloud_server" "example" {
+  hostname = "web1.example.tld"
+  zone     = "fi-hel1"
+}
+
+output "public_ip" {
+  value = [
+    for iface in data.upcloud_server.example.network_interfaces : iface.ip_addresses[0].address
+    if iface.type == "public"
+  ]
+}
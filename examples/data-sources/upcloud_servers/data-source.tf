@@ -0,0 +1,14 @@
+# List started web servers in fi-hel1 zone
+data "upcloud_servers" "web" {
+  zone           = "fi-hel1"
+  hostname_regex = "^web[0-9]+\\."
+  power_state    = "started"
+
+  labels = {
+    env = "production"
+  }
+}
+
+output "web_server_ids" {
+  value = data.upcloud_servers.web.servers[*].id
+}
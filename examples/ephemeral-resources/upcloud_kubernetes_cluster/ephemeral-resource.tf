@@ -44,3 +44,14 @@ resource "local_file" "example" {
   content  = ephemeral.upcloud_kubernetes_cluster.example.kubeconfig
   filename = "example.conf"
 }
+
+# Alternatively, render a kubeconfig that uses an exec credential plugin to fetch short-lived credentials, with a custom context name
+ephemeral "upcloud_kubernetes_cluster" "exec" {
+  id           = upcloud_kubernetes_cluster.example.id
+  context_name = "example"
+
+  exec {
+    command = "example-credential-plugin"
+    args    = ["token", "--cluster", upcloud_kubernetes_cluster.example.id, "--lifetime", "1h"]
+  }
+}
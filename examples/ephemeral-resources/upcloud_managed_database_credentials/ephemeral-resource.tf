@@ -0,0 +1,21 @@
+resource "upcloud_managed_database_postgresql" "example" {
+  name  = "postgres"
+  plan  = "1x1xCPU-2GB-25GB"
+  title = "postgres"
+  zone  = "fi-hel1"
+}
+
+# Read the connection details without storing them in the state
+ephemeral "upcloud_managed_database_credentials" "example" {
+  id = upcloud_managed_database_postgresql.example.id
+}
+
+# Configure e.g. PostgreSQL provider with the ephemeral credentials
+provider "postgresql" {
+  host     = ephemeral.upcloud_managed_database_credentials.example.service_host
+  port     = ephemeral.upcloud_managed_database_credentials.example.service_port
+  username = ephemeral.upcloud_managed_database_credentials.example.service_username
+  password = ephemeral.upcloud_managed_database_credentials.example.service_password
+  database = ephemeral.upcloud_managed_database_credentials.example.primary_database
+  sslmode  = "require"
+}
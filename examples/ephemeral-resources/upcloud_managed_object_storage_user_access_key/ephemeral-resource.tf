@@ -0,0 +1,37 @@
+resource "upcloud_managed_object_storage" "example" {
+  name              = "example"
+  region            = "europe-1"
+  configured_status = "started"
+}
+
+resource "upcloud_managed_object_storage_user" "example" {
+  service_uuid = upcloud_managed_object_storage.example.id
+  username     = "example"
+}
+
+resource "upcloud_managed_object_storage_user_policy" "example" {
+  service_uuid = upcloud_managed_object_storage.example.id
+  username     = upcloud_managed_object_storage_user.example.username
+  name         = "ECSS3FullAccess"
+}
+
+# Create an access key that is deleted at the end of the Terraform run
+ephemeral "upcloud_managed_object_storage_user_access_key" "example" {
+  service_uuid = upcloud_managed_object_storage.example.id
+  username     = upcloud_managed_object_storage_user.example.username
+}
+
+# Configure e.g. AWS provider with the short-lived access key
+provider "aws" {
+  access_key = ephemeral.upcloud_managed_object_storage_user_access_key.example.access_key_id
+  secret_key = ephemeral.upcloud_managed_object_storage_user_access_key.example.secret_access_key
+  region     = "europe-1"
+
+  skip_credentials_validation = true
+  skip_region_validation      = true
+  skip_requesting_account_id  = true
+
+  endpoints {
+    s3 = "https://${[for e in upcloud_managed_object_storage.example.endpoint : e.domain_name if e.type == "public"][0]}"
+  }
+}
@@ -59,6 +59,8 @@ resource "upcloud_gateway_connection_tunnel" "this" {
   remote_address     = "100.123.123.10"
 
   ipsec_auth_psk {
-    psk = "you_probably_want_to_use_env_vars_here"
+    # The pre-shared key is write-only and is not stored in the state. Increment psk_version to replace the tunnel with a new key.
+    psk         = "you_probably_want_to_use_env_vars_here"
+    psk_version = 1
   }
 }
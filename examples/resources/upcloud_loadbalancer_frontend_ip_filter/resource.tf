@@ -0,0 +1,59 @@
+variable "lb_zone" {
+  type    = string
+  default = "fi-hel2"
+}
+
+resource "upcloud_network" "lb_network" {
+  name = "lb-test-net"
+  zone = var.lb_zone
+  ip_network {
+    address = "10.0.0.0/24"
+    dhcp    = true
+    family  = "IPv4"
+  }
+}
+
+# Only allow connections from the office network and the monitoring host. Other connections are rejected.
+resource "upcloud_loadbalancer_frontend_ip_filter" "lb_fe_1_allow" {
+  frontend = resource.upcloud_loadbalancer_frontend.lb_fe_1.id
+  name     = "allowed-clients"
+  type     = "allow"
+  priority = 100
+  addresses = [
+    "192.0.2.0/24",
+    "198.51.100.10",
+  ]
+}
+
+resource "upcloud_loadbalancer_frontend" "lb_fe_1" {
+  loadbalancer         = resource.upcloud_loadbalancer.lb.id
+  name                 = "lb-fe-1-test"
+  mode                 = "tcp"
+  port                 = 8080
+  default_backend_name = resource.upcloud_loadbalancer_backend.lb_be_1.name
+}
+
+resource "upcloud_loadbalancer" "lb" {
+  configured_status = "started"
+  name              = "lb-test"
+  plan              = "development"
+  zone              = var.lb_zone
+
+  networks {
+    type   = "public"
+    family = "IPv4"
+    name   = "public"
+  }
+
+  networks {
+    type    = "private"
+    family  = "IPv4"
+    name    = "private"
+    network = resource.upcloud_network.lb_network.id
+  }
+}
+
+resource "upcloud_loadbalancer_backend" "lb_be_1" {
+  loadbalancer = resource.upcloud_loadbalancer.lb.id
+  name         = "lb-be-1-test"
+}
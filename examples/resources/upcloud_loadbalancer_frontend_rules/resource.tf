@@ -0,0 +1,91 @@
+variable "lb_zone" {
+  type    = string
+  default = "fi-hel2"
+}
+
+resource "upcloud_network" "lb_network" {
+  name = "lb-test-net"
+  zone = var.lb_zone
+  ip_network {
+    address = "10.0.0.0/24"
+    dhcp    = true
+    family  = "IPv4"
+  }
+}
+
+# Rules are evaluated in the order they are defined. The first rule gets priority 100, the second 99, and so on.
+resource "upcloud_loadbalancer_frontend_rules" "lb_fe_1" {
+  frontend = resource.upcloud_loadbalancer_frontend.lb_fe_1.id
+
+  rule {
+    name = "api"
+
+    matchers {
+      path {
+        method = "starts"
+        value  = "/api/"
+      }
+    }
+
+    actions {
+      use_backend {
+        backend_name = resource.upcloud_loadbalancer_backend.lb_be_api.name
+      }
+    }
+  }
+
+  rule {
+    name = "legacy"
+
+    matchers {
+      path {
+        method = "starts"
+        value  = "/old/"
+      }
+    }
+
+    actions {
+      http_redirect {
+        location = "/new/"
+      }
+    }
+  }
+}
+
+resource "upcloud_loadbalancer_frontend" "lb_fe_1" {
+  loadbalancer         = resource.upcloud_loadbalancer.lb.id
+  name                 = "lb-fe-1-test"
+  mode                 = "http"
+  port                 = 8080
+  default_backend_name = resource.upcloud_loadbalancer_backend.lb_be_1.name
+}
+
+resource "upcloud_loadbalancer" "lb" {
+  configured_status = "started"
+  name              = "lb-test"
+  plan              = "development"
+  zone              = var.lb_zone
+
+  networks {
+    type   = "public"
+    family = "IPv4"
+    name   = "public"
+  }
+
+  networks {
+    type    = "private"
+    family  = "IPv4"
+    name    = "private"
+    network = resource.upcloud_network.lb_network.id
+  }
+}
+
+resource "upcloud_loadbalancer_backend" "lb_be_1" {
+  loadbalancer = resource.upcloud_loadbalancer.lb.id
+  name         = "lb-be-1-test"
+}
+
+resource "upcloud_loadbalancer_backend" "lb_be_api" {
+  loadbalancer = resource.upcloud_loadbalancer.lb.id
+  name         = "lb-be-api-test"
+}
@@ -0,0 +1,27 @@
+resource "upcloud_managed_database_postgresql" "example" {
+  name  = "postgres"
+  plan  = "1x1xCPU-2GB-25GB"
+  title = "postgres"
+  zone  = "fi-hel1"
+}
+
+resource "upcloud_managed_database_opensearch" "logs" {
+  name  = "opensearch-logs"
+  plan  = "1x2xCPU-4GB-80GB-1D"
+  title = "opensearch-logs"
+  zone  = "fi-hel1"
+}
+
+# Ship PostgreSQL logs to OpenSearch
+resource "upcloud_managed_database_integration" "logs" {
+  type                = "logs"
+  source_service      = upcloud_managed_database_postgresql.example.id
+  destination_service = upcloud_managed_database_opensearch.logs.id
+}
+
+# Expose PostgreSQL metrics to an external Prometheus endpoint
+resource "upcloud_managed_database_integration" "prometheus" {
+  type                 = "prometheus"
+  source_service       = upcloud_managed_database_postgresql.example.id
+  destination_endpoint = "https://prometheus.example.com"
+}
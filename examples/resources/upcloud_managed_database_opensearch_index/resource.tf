@@ -0,0 +1,14 @@
+resource "upcloud_managed_database_opensearch" "example" {
+  name  = "opensearch-example"
+  plan  = "1x2xCPU-4GB-80GB-1D"
+  title = "opensearch-example"
+  zone  = "fi-hel1"
+}
+
+resource "upcloud_managed_database_opensearch_index" "logs" {
+  service            = upcloud_managed_database_opensearch.example.id
+  index_name         = "logs"
+  number_of_shards   = 1
+  number_of_replicas = 1
+  refresh_interval   = "30s"
+}
@@ -0,0 +1,27 @@
+resource "upcloud_managed_database_opensearch" "example" {
+  name  = "opensearch-example"
+  plan  = "1x2xCPU-4GB-80GB-1D"
+  title = "opensearch-example"
+  zone  = "fi-hel1"
+}
+
+# Apply settings and mappings to all new indices matching logs-* pattern
+resource "upcloud_managed_database_opensearch_index_template" "logs" {
+  service = upcloud_managed_database_opensearch.example.id
+  name    = "logs"
+  template = jsonencode({
+    index_patterns = ["logs-*"]
+    template = {
+      settings = {
+        number_of_shards   = 1
+        number_of_replicas = 1
+      }
+      mappings = {
+        properties = {
+          "@timestamp" = { type = "date" }
+          message      = { type = "text" }
+        }
+      }
+    }
+  })
+}
@@ -0,0 +1,36 @@
+resource "upcloud_managed_database_opensearch" "example" {
+  name  = "opensearch-example"
+  plan  = "1x2xCPU-4GB-80GB-1D"
+  title = "opensearch-example"
+  zone  = "fi-hel1"
+}
+
+# Delete logs-* indices 30 days after they have been created
+resource "upcloud_managed_database_opensearch_ism_policy" "delete_old_logs" {
+  service   = upcloud_managed_database_opensearch.example.id
+  policy_id = "delete-old-logs"
+  policy = jsonencode({
+    description   = "Delete log indices after 30 days"
+    default_state = "hot"
+    states = [
+      {
+        name    = "hot"
+        actions = []
+        transitions = [
+          {
+            state_name = "delete"
+            conditions = { min_index_age = "30d" }
+          }
+        ]
+      },
+      {
+        name        = "delete"
+        actions     = [{ delete = {} }]
+        transitions = []
+      }
+    ]
+    ism_template = [
+      { index_patterns = ["logs-*"] }
+    ]
+  })
+}
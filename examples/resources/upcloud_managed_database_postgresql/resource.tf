@@ -18,3 +18,26 @@ resource "upcloud_managed_database_postgresql" "example_2" {
     admin_password = "<ADMIN_PASSWORD>"
   }
 }
+
+# Fork of an existing service restored to a point in time
+resource "upcloud_managed_database_postgresql" "example_3" {
+  name  = "postgres-3"
+  plan  = "1x1xCPU-2GB-25GB"
+  title = "postgres-staging"
+  zone  = "fi-hel1"
+  fork {
+    service              = upcloud_managed_database_postgresql.example_1.id
+    recovery_target_time = "2026-01-02T15:04:05Z"
+  }
+}
+
+# Fork of an existing service in another zone
+resource "upcloud_managed_database_postgresql" "example_4" {
+  name  = "postgres-4"
+  plan  = "1x1xCPU-2GB-25GB"
+  title = "postgres-copy"
+  zone  = "fi-hel2"
+  fork {
+    service = upcloud_managed_database_postgresql.example_1.id
+  }
+}
@@ -0,0 +1,25 @@
+resource "upcloud_managed_database_postgresql" "example" {
+  name  = "postgres"
+  plan  = "1x1xCPU-2GB-25GB"
+  title = "postgres"
+  zone  = "fi-hel1"
+}
+
+resource "upcloud_managed_database_logical_database" "example_db" {
+  service = upcloud_managed_database_postgresql.example.id
+  name    = "example_db"
+}
+
+resource "upcloud_managed_database_user" "example_user" {
+  service  = upcloud_managed_database_postgresql.example.id
+  username = "example_user"
+}
+
+resource "upcloud_managed_database_postgresql_connection_pool" "example_pool" {
+  service   = upcloud_managed_database_postgresql.example.id
+  pool_name = "example_pool"
+  database  = upcloud_managed_database_logical_database.example_db.name
+  username  = upcloud_managed_database_user.example_user.username
+  pool_mode = "transaction"
+  pool_size = 20
+}
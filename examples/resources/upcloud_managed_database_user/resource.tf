@@ -10,3 +10,54 @@ resource "upcloud_managed_database_user" "example_user" {
   username = "example_user"
   password = "<USER_PASSWORD>"
 }
+
+# Rotate Valkey credentials without downtime by using two users with identical access control.
+# Point the clients to the active user, then change the password of the inactive one.
+resource "upcloud_managed_database_valkey" "example" {
+  name  = "valkey"
+  plan  = "1x1xCPU-2GB"
+  title = "valkey"
+  zone  = "fi-hel1"
+}
+
+variable "valkey_active_user" {
+  default = "blue"
+  type    = string
+}
+
+variable "valkey_passwords" {
+  type      = map(string)
+  sensitive = true
+}
+
+resource "upcloud_managed_database_user" "valkey" {
+  for_each = toset(["blue", "green"])
+
+  service  = upcloud_managed_database_valkey.example.id
+  username = "cache-${each.key}"
+  password = var.valkey_passwords[each.key]
+
+  valkey_access_control {
+    categories = ["+@read", "+@write"]
+    keys       = ["cache:*"]
+  }
+}
+
+output "valkey_username" {
+  value = upcloud_managed_database_user.valkey[var.valkey_active_user].username
+}
+
+# Use write-only password to keep the password out of the state (requires Terraform 1.11 or later).
+# Increment password_wo_version to update the password.
+variable "db_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "upcloud_managed_database_user" "example_wo_user" {
+  service             = upcloud_managed_database_postgresql.example.id
+  username            = "example_wo_user"
+  password_wo         = var.db_password
+  password_wo_version = 1
+}
@@ -8,3 +8,41 @@ resource "upcloud_managed_object_storage_bucket" "example" {
   service_uuid = upcloud_managed_object_storage.example.id
   name         = "bucket"
 }
+
+# Bucket configuration, such as versioning and lifecycle rules, is managed through the S3-compatible API of the service
+# and requires a user with permissions to configure the bucket. The provider creates a temporary access key for the user
+# for each operation, so no access key is stored in the state.
+resource "upcloud_managed_object_storage_user" "admin" {
+  username     = "bucket-admin"
+  service_uuid = upcloud_managed_object_storage.example.id
+}
+
+resource "upcloud_managed_object_storage_user_policy" "admin" {
+  name         = "ECSS3FullAccess"
+  username     = upcloud_managed_object_storage_user.admin.username
+  service_uuid = upcloud_managed_object_storage.example.id
+}
+
+resource "upcloud_managed_object_storage_bucket" "configured" {
+  access_key_username = upcloud_managed_object_storage_user.admin.username
+
+  service_uuid = upcloud_managed_object_storage.example.id
+  name         = "configured-bucket"
+
+  versioning             = "Enabled"
+  server_side_encryption = "AES256"
+
+  lifecycle_rule {
+    id              = "expire-logs"
+    prefix          = "logs/"
+    expiration_days = 30
+  }
+
+  cors_rule {
+    allowed_methods = ["GET", "HEAD"]
+    allowed_origins = ["https://example.com"]
+    max_age_seconds = 3600
+  }
+
+  depends_on = [upcloud_managed_object_storage_user_policy.admin]
+}
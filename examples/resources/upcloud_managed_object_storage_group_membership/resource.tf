@@ -0,0 +1,63 @@
+resource "upcloud_managed_object_storage" "example" {
+  name              = "group-example-objstov2"
+  region            = "europe-1"
+  configured_status = "started"
+
+  network {
+    family = "IPv4"
+    name   = "public"
+    type   = "public"
+  }
+}
+
+# User with permissions to manage groups through the IAM-compatible API
+data "upcloud_managed_object_storage_policy_document" "iam_admin" {
+  statement {
+    actions   = ["iam:*"]
+    resources = ["*"]
+  }
+}
+
+resource "upcloud_managed_object_storage_policy" "iam_admin" {
+  name         = "iam-admin"
+  document     = data.upcloud_managed_object_storage_policy_document.iam_admin.document
+  service_uuid = upcloud_managed_object_storage.example.id
+}
+
+resource "upcloud_managed_object_storage_user" "iam_admin" {
+  username     = "iam-admin"
+  service_uuid = upcloud_managed_object_storage.example.id
+}
+
+resource "upcloud_managed_object_storage_user_policy" "iam_admin" {
+  name         = upcloud_managed_object_storage_policy.iam_admin.name
+  username     = upcloud_managed_object_storage_user.iam_admin.username
+  service_uuid = upcloud_managed_object_storage.example.id
+}
+
+resource "upcloud_managed_object_storage_group" "example" {
+  access_key_username = upcloud_managed_object_storage_user.iam_admin.username
+
+  name         = "developers"
+  service_uuid = upcloud_managed_object_storage.example.id
+
+  depends_on = [upcloud_managed_object_storage_user_policy.iam_admin]
+}
+
+# Add service accounts to the group
+resource "upcloud_managed_object_storage_user" "developer" {
+  for_each = toset(["ci", "deployer"])
+
+  username     = each.key
+  service_uuid = upcloud_managed_object_storage.example.id
+}
+
+resource "upcloud_managed_object_storage_group_membership" "developer" {
+  for_each = upcloud_managed_object_storage_user.developer
+
+  access_key_username = upcloud_managed_object_storage_user.iam_admin.username
+
+  group        = upcloud_managed_object_storage_group.example.name
+  username     = each.value.username
+  service_uuid = upcloud_managed_object_storage.example.id
+}
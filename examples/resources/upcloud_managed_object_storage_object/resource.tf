@@ -0,0 +1,56 @@
+resource "upcloud_managed_object_storage" "example" {
+  name              = "object-example-objstov2"
+  region            = "europe-1"
+  configured_status = "started"
+
+  network {
+    family = "IPv4"
+    name   = "public"
+    type   = "public"
+  }
+}
+
+resource "upcloud_managed_object_storage_bucket" "example" {
+  service_uuid = upcloud_managed_object_storage.example.id
+  name         = "artifacts"
+}
+
+resource "upcloud_managed_object_storage_user" "example" {
+  username     = "artifact-uploader"
+  service_uuid = upcloud_managed_object_storage.example.id
+}
+
+resource "upcloud_managed_object_storage_user_policy" "example" {
+  name         = "ECSS3FullAccess"
+  username     = upcloud_managed_object_storage_user.example.username
+  service_uuid = upcloud_managed_object_storage.example.id
+}
+
+# Upload a local file
+resource "upcloud_managed_object_storage_object" "file" {
+  access_key_username = upcloud_managed_object_storage_user.example.username
+
+  service_uuid = upcloud_managed_object_storage.example.id
+  bucket       = upcloud_managed_object_storage_bucket.example.name
+  key          = "releases/app.tar.gz"
+  source       = "${path.module}/app.tar.gz"
+
+  depends_on = [upcloud_managed_object_storage_user_policy.example]
+}
+
+# Upload inline content with custom headers and metadata
+resource "upcloud_managed_object_storage_object" "config" {
+  access_key_username = upcloud_managed_object_storage_user.example.username
+
+  service_uuid  = upcloud_managed_object_storage.example.id
+  bucket        = upcloud_managed_object_storage_bucket.example.name
+  key           = "config/app.json"
+  content       = jsonencode({ environment = "production" })
+  cache_control = "no-cache"
+
+  metadata = {
+    owner = "team-devex"
+  }
+
+  depends_on = [upcloud_managed_object_storage_user_policy.example]
+}
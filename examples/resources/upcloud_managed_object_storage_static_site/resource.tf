@@ -15,3 +15,35 @@ resource "upcloud_managed_object_storage_static_site" "this" {
     status_code    = 404
   }]
 }
+
+# Sync the content of the static site from a local directory. Changed files are uploaded and removed files are deleted
+# under the bucket prefix on each apply.
+resource "upcloud_managed_object_storage_user" "deployer" {
+  username     = "static-site-deployer"
+  service_uuid = upcloud_managed_object_storage.this.id
+}
+
+resource "upcloud_managed_object_storage_user_policy" "deployer" {
+  name         = "ECSS3FullAccess"
+  username     = upcloud_managed_object_storage_user.deployer.username
+  service_uuid = upcloud_managed_object_storage.this.id
+}
+
+resource "upcloud_managed_object_storage_bucket" "website" {
+  service_uuid = upcloud_managed_object_storage.this.id
+  name         = "website"
+}
+
+resource "upcloud_managed_object_storage_static_site" "synced" {
+  access_key_username = upcloud_managed_object_storage_user.deployer.username
+
+  service_uuid   = upcloud_managed_object_storage.this.id
+  bucket_name    = upcloud_managed_object_storage_bucket.website.name
+  bucket_prefix  = "dist/"
+  index_document = "index.html"
+  spa_mode       = true
+
+  source_dir = "${path.module}/dist"
+
+  depends_on = [upcloud_managed_object_storage_user_policy.deployer]
+}
@@ -0,0 +1,311 @@
+package database
+
+import (
+	"context"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &connectionPoolResource{}
+	_ resource.ResourceWithConfigure   = &connectionPoolResource{}
+	_ resource.ResourceWithImportState = &connectionPoolResource{}
+	_ resource.ResourceWithIdentity    = &connectionPoolResource{}
+)
+
+func NewConnectionPoolResource() resource.Resource {
+	return &connectionPoolResource{}
+}
+
+type connectionPoolResource struct {
+	client *service.Service
+}
+
+func (r *connectionPoolResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_managed_database_postgresql_connection_pool"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *connectionPoolResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = utils.GetClientFromProviderData(req.ProviderData)
+}
+
+type connectionPoolModel struct {
+	ID            types.String `tfsdk:"id"`
+	Service       types.String `tfsdk:"service"`
+	PoolName      types.String `tfsdk:"pool_name"`
+	Database      types.String `tfsdk:"database"`
+	Username      types.String `tfsdk:"username"`
+	PoolMode      types.String `tfsdk:"pool_mode"`
+	PoolSize      types.Int64  `tfsdk:"pool_size"`
+	ConnectionURI types.String `tfsdk:"connection_uri"`
+}
+
+func (r *connectionPoolResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource represents a PgBouncer connection pool in PostgreSQL managed database.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the connection pool. ID is in {service UUID}/{pool name} format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service": schema.StringAttribute{
+				Description: "UUID of the PostgreSQL managed database service the connection pool belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"pool_name": schema.StringAttribute{
+				Description: "Name of the connection pool. Clients connect to the pool by using the pool name as the database name.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+				},
+			},
+			"database": schema.StringAttribute{
+				Description: "Name of the logical database the connection pool connects to.",
+				Required:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "Name of the database user the connection pool uses to connect to the database. If not set, the credentials of the connecting client are used. Removing the username replaces the connection pool.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						usernameRemovedRequiresReplaceIfFunc,
+						"The username of the connection pool can not be removed without replacing the connection pool.",
+						"The username of the connection pool can not be removed without replacing the connection pool.",
+					),
+				},
+			},
+			"pool_mode": schema.StringAttribute{
+				MarkdownDescription: "Mode of the connection pool: `session`, `transaction`, or `statement`. Defaults to `transaction`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(upcloud.ManagedDatabaseConnectionPoolModeTransaction)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(upcloud.ManagedDatabaseConnectionPoolModeSession),
+						string(upcloud.ManagedDatabaseConnectionPoolModeTransaction),
+						string(upcloud.ManagedDatabaseConnectionPoolModeStatement),
+					),
+				},
+			},
+			"pool_size": schema.Int64Attribute{
+				Description: "Number of connections the connection pool keeps open to the database. Defaults to 10.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(10),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 10000),
+				},
+			},
+			"connection_uri": schema.StringAttribute{
+				Description: "URI for connecting to the database through the connection pool.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// usernameRemovedRequiresReplaceIfFunc requires replace when the username is removed, as omitting the username from the modify request leaves the current username unchanged.
+func usernameRemovedRequiresReplaceIfFunc(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !req.StateValue.IsNull() && req.PlanValue.IsNull()
+}
+
+func setConnectionPoolValues(data *connectionPoolModel, pool *upcloud.ManagedDatabaseConnectionPool) {
+	data.PoolName = types.StringValue(pool.PoolName)
+	data.Database = types.StringValue(pool.Database)
+	data.PoolMode = types.StringValue(string(pool.PoolMode))
+	data.PoolSize = types.Int64Value(int64(pool.PoolSize))
+	data.ConnectionURI = types.StringValue(pool.ConnectionURI)
+
+	data.Username = types.StringNull()
+	if pool.Username != "" {
+		data.Username = types.StringValue(pool.Username)
+	}
+}
+
+func (r *connectionPoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data connectionPoolModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	uuid := data.Service.ValueString()
+
+	resp.Diagnostics.Append(checkDatabaseIsRunning(ctx, r.client, uuid, "connection pool", "create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(utils.MarshalID(uuid, data.PoolName.ValueString()))
+
+	pool, err := r.client.CreateManagedDatabaseConnectionPool(ctx, &request.CreateManagedDatabaseConnectionPoolRequest{
+		ServiceUUID: uuid,
+		PoolName:    data.PoolName.ValueString(),
+		Database:    data.Database.ValueString(),
+		Username:    data.Username.ValueString(),
+		PoolMode:    upcloud.ManagedDatabaseConnectionPoolMode(data.PoolMode.ValueString()),
+		PoolSize:    int(data.PoolSize.ValueInt64()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create managed database connection pool",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	setConnectionPoolValues(&data, pool)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, connectionPoolIdentity, data.ID.ValueString())...)
+}
+
+func (r *connectionPoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data connectionPoolModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.ValueString() == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var uuid, name string
+	resp.Diagnostics.Append(utils.UnmarshalIDDiag(data.ID.ValueString(), &uuid, &name)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Service = types.StringValue(uuid)
+
+	pool, err := r.client.GetManagedDatabaseConnectionPool(ctx, &request.GetManagedDatabaseConnectionPoolRequest{
+		ServiceUUID: uuid,
+		PoolName:    name,
+	})
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError(
+				"Unable to read managed database connection pool details",
+				utils.ErrorDiagnosticDetail(err),
+			)
+		}
+		return
+	}
+
+	setConnectionPoolValues(&data, pool)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, connectionPoolIdentity, data.ID.ValueString())...)
+}
+
+func (r *connectionPoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data connectionPoolModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var uuid, name string
+	resp.Diagnostics.Append(utils.UnmarshalIDDiag(data.ID.ValueString(), &uuid, &name)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkDatabaseIsRunning(ctx, r.client, uuid, "connection pool", "update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	database := data.Database.ValueString()
+	poolSize := int(data.PoolSize.ValueInt64())
+
+	pool, err := r.client.ModifyManagedDatabaseConnectionPool(ctx, &request.ModifyManagedDatabaseConnectionPoolRequest{
+		ServiceUUID: uuid,
+		PoolName:    name,
+		Database:    &database,
+		Username:    data.Username.ValueStringPointer(),
+		PoolMode:    upcloud.ManagedDatabaseConnectionPoolMode(data.PoolMode.ValueString()),
+		PoolSize:    &poolSize,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to modify managed database connection pool",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	setConnectionPoolValues(&data, pool)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *connectionPoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data connectionPoolModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	var uuid, name string
+	resp.Diagnostics.Append(utils.UnmarshalIDDiag(data.ID.ValueString(), &uuid, &name)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkDatabaseIsRunning(ctx, r.client, uuid, "connection pool", "delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteManagedDatabaseConnectionPool(ctx, &request.DeleteManagedDatabaseConnectionPoolRequest{
+		ServiceUUID: uuid,
+		PoolName:    name,
+	}); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete managed database connection pool",
+			utils.ErrorDiagnosticDetail(err),
+		)
+	}
+}
+
+var connectionPoolIdentity = []utils.IdentityAttribute{
+	{Name: "service", Description: "UUID of the managed database service."},
+	{Name: "pool_name", Description: "Name of the connection pool."},
+}
+
+func (r *connectionPoolResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(connectionPoolIdentity)
+}
+
+func (r *connectionPoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	utils.ImportStateWithIdentity(ctx, connectionPoolIdentity, req, resp)
+}
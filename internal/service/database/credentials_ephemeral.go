@@ -0,0 +1,115 @@
+package database
+
+import (
+	"context"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewCredentialsEphemeral() ephemeral.EphemeralResource {
+	return &credentialsEphemeral{}
+}
+
+var (
+	_ ephemeral.EphemeralResource              = &credentialsEphemeral{}
+	_ ephemeral.EphemeralResourceWithConfigure = &credentialsEphemeral{}
+)
+
+type credentialsEphemeral struct {
+	client *service.Service
+}
+
+func (e *credentialsEphemeral) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_managed_database_credentials"
+}
+
+func (e *credentialsEphemeral) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.client, resp.Diagnostics = utils.GetClientFromProviderData(req.ProviderData)
+}
+
+type credentialsEphemeralModel struct {
+	ID              types.String `tfsdk:"id"`
+	Type            types.String `tfsdk:"type"`
+	ServiceURI      types.String `tfsdk:"service_uri"`
+	ServiceHost     types.String `tfsdk:"service_host"`
+	ServicePort     types.String `tfsdk:"service_port"`
+	ServiceUsername types.String `tfsdk:"service_username"`
+	ServicePassword types.String `tfsdk:"service_password"`
+	PrimaryDatabase types.String `tfsdk:"primary_database"`
+}
+
+func (e *credentialsEphemeral) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Connection details and primary credentials of a managed database. The values are not stored in the state or in the plan, so use this instead of the `service_*` attributes of the managed database resources to pass the credentials to, e.g., other providers or write-only attributes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "UUID of the managed database.",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the managed database, e.g. `pg` or `mysql`.",
+				Computed:            true,
+			},
+			"service_uri": schema.StringAttribute{
+				MarkdownDescription: "URI to the service instance",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"service_host": schema.StringAttribute{
+				MarkdownDescription: "Hostname to the service instance",
+				Computed:            true,
+			},
+			"service_port": schema.StringAttribute{
+				MarkdownDescription: "Port to the service instance",
+				Computed:            true,
+			},
+			"service_username": schema.StringAttribute{
+				MarkdownDescription: "Primary username to the service instance",
+				Computed:            true,
+			},
+			"service_password": schema.StringAttribute{
+				MarkdownDescription: "Primary password to the service instance",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"primary_database": schema.StringAttribute{
+				MarkdownDescription: "Primary database name",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (e *credentialsEphemeral) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data credentialsEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	db, err := e.client.GetManagedDatabase(ctx, &request.GetManagedDatabaseRequest{
+		UUID: data.ID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read managed database details",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	data.Type = types.StringValue(string(db.Type))
+	data.ServiceURI = types.StringValue(db.ServiceURI)
+	data.ServiceHost = types.StringValue(db.ServiceURIParams.Host)
+	data.ServicePort = types.StringValue(db.ServiceURIParams.Port)
+	data.ServiceUsername = types.StringValue(db.ServiceURIParams.User)
+	data.ServicePassword = types.StringValue(db.ServiceURIParams.Password)
+	data.PrimaryDatabase = types.StringValue(db.ServiceURIParams.DatabaseName)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,129 @@
+package database
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewBackupsDataSource() datasource.DataSource {
+	return &backupsDataSource{}
+}
+
+var (
+	_ datasource.DataSource              = &backupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &backupsDataSource{}
+)
+
+type backupsDataSource struct {
+	client *service.Service
+}
+
+func (d *backupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_managed_database_backups"
+}
+
+func (d *backupsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, resp.Diagnostics = utils.GetClientFromProviderData(req.ProviderData)
+}
+
+type backupsModel struct {
+	ID                   types.String  `tfsdk:"id"`
+	Service              types.String  `tfsdk:"service"`
+	Backups              []backupModel `tfsdk:"backups"`
+	EarliestRecoveryTime types.String  `tfsdk:"earliest_recovery_time"`
+}
+
+type backupModel struct {
+	BackupName types.String `tfsdk:"backup_name"`
+	BackupTime types.String `tfsdk:"backup_time"`
+	DataSize   types.Int64  `tfsdk:"data_size"`
+}
+
+func (d *backupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Returns a list of available backups of a managed database. To restore a backup into a new service, use the `fork` block of `upcloud_managed_database_postgresql` or `upcloud_managed_database_mysql` with `recovery_target_time` set to the `backup_time` of the backup, or to a later point in time.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"service": schema.StringAttribute{
+				MarkdownDescription: "UUID of the managed database.",
+				Required:            true,
+			},
+			"backups": schema.ListNestedAttribute{
+				MarkdownDescription: "Available backups of the managed database, ordered from the oldest to the newest.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"backup_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the backup.",
+							Computed:            true,
+						},
+						"backup_time": schema.StringAttribute{
+							MarkdownDescription: "Time when the backup was created.",
+							Computed:            true,
+						},
+						"data_size": schema.Int64Attribute{
+							MarkdownDescription: "Size of the backup in bytes.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"earliest_recovery_time": schema.StringAttribute{
+				MarkdownDescription: "Earliest point in time the managed database can be restored to, i.e. the time of the oldest backup. Empty if there are no backups available.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *backupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data backupsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	db, err := d.client.GetManagedDatabase(ctx, &request.GetManagedDatabaseRequest{
+		UUID: data.Service.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read managed database details",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	backups := db.Backups
+	slices.SortFunc(backups, func(a, b upcloud.ManagedDatabaseBackup) int {
+		return a.BackupTime.Compare(b.BackupTime)
+	})
+
+	data.Backups = make([]backupModel, 0, len(backups))
+	for _, backup := range backups {
+		data.Backups = append(data.Backups, backupModel{
+			BackupName: types.StringValue(backup.BackupName),
+			BackupTime: types.StringValue(backup.BackupTime.UTC().Format(time.RFC3339)),
+			DataSize:   types.Int64Value(int64(backup.DataSize)),
+		})
+	}
+
+	data.EarliestRecoveryTime = types.StringValue("")
+	if len(backups) > 0 {
+		data.EarliestRecoveryTime = types.StringValue(backups[0].BackupTime.UTC().Format(time.RFC3339))
+	}
+
+	data.ID = types.StringValue(db.UUID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
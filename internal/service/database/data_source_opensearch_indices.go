@@ -4,127 +4,148 @@ import (
 	"context"
 	"time"
 
-	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func DataSourceOpenSearchIndices() *schema.Resource {
-	return &schema.Resource{
+func NewOpenSearchIndicesDataSource() datasource.DataSource {
+	return &openSearchIndicesDataSource{}
+}
+
+var (
+	_ datasource.DataSource              = &openSearchIndicesDataSource{}
+	_ datasource.DataSourceWithConfigure = &openSearchIndicesDataSource{}
+)
+
+type openSearchIndicesDataSource struct {
+	client *service.Service
+}
+
+func (d *openSearchIndicesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_managed_database_opensearch_indices"
+}
+
+func (d *openSearchIndicesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, resp.Diagnostics = utils.GetClientFromProviderData(req.ProviderData)
+}
+
+type openSearchIndicesModel struct {
+	ID      types.String           `tfsdk:"id"`
+	Service types.String           `tfsdk:"service"`
+	Indices []openSearchIndexModel `tfsdk:"indices"`
+}
+
+type openSearchIndexModel struct {
+	CreateTime          types.String `tfsdk:"create_time"`
+	Docs                types.Int64  `tfsdk:"docs"`
+	Health              types.String `tfsdk:"health"`
+	IndexName           types.String `tfsdk:"index_name"`
+	NumberOfReplicas    types.Int64  `tfsdk:"number_of_replicas"`
+	NumberOfShards      types.Int64  `tfsdk:"number_of_shards"`
+	ReadOnlyAllowDelete types.Bool   `tfsdk:"read_only_allow_delete"`
+	Size                types.Int64  `tfsdk:"size"`
+	Status              types.String `tfsdk:"status"`
+}
+
+func (d *openSearchIndicesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
 		Description: "OpenSearch indices",
-		ReadContext: dataSourceOpenSearchIndicesRead,
-		Schema: map[string]*schema.Schema{
-			"indices": {
-				Description: "Available indices for OpenSearch",
-				Type:        schema.TypeSet,
-				Optional:    true,
-				Computed:    true,
-				Elem:        schemaOpenSearchIndex(),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
 			},
-			"service": {
+			"service": schema.StringAttribute{
 				Description: "Service's UUID for which these indices belongs to",
-				Type:        schema.TypeString,
 				Required:    true,
-				Computed:    false,
 			},
 		},
-	}
-}
-
-func schemaOpenSearchIndex() *schema.Resource {
-	return &schema.Resource{
-		Description: "OpenSearch index",
-		Schema: map[string]*schema.Schema{
-			"create_time": {
-				Description: "Timestamp indicating the creation time of the index.",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"docs": {
-				Description: "Number of documents stored in the index.",
-				Type:        schema.TypeInt,
-				Computed:    true,
-			},
-			"health": {
-				Description: "Health status of the index e.g. `green`, `yellow`, or `red`.",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"index_name": {
-				Description: "Name of the index.",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"number_of_replicas": {
-				Description: "Number of replicas configured for the index.",
-				Type:        schema.TypeInt,
-				Computed:    true,
-			},
-			"number_of_shards": {
-				Description: "Number of shards configured & used by the index.",
-				Type:        schema.TypeInt,
-				Computed:    true,
-			},
-			"read_only_allow_delete": {
-				Description: "Indicates whether the index is in a read-only state that permits deletion of the entire index. This attribute can be automatically set to true in certain scenarios where the node disk space exceeds the flood stage.",
-				Type:        schema.TypeBool,
-				Computed:    true,
-			},
-			"size": {
-				Description: "Size of the index in bytes.",
-				Type:        schema.TypeInt,
-				Computed:    true,
-			},
-			"status": {
-				Description: "Status of the index e.g. `open` or `closed`.",
-				Type:        schema.TypeString,
-				Computed:    true,
+		Blocks: map[string]schema.Block{
+			"indices": schema.SetNestedBlock{
+				Description: "Available indices for OpenSearch",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"create_time": schema.StringAttribute{
+							Description: "Timestamp indicating the creation time of the index.",
+							Computed:    true,
+						},
+						"docs": schema.Int64Attribute{
+							Description: "Number of documents stored in the index.",
+							Computed:    true,
+						},
+						"health": schema.StringAttribute{
+							MarkdownDescription: "Health status of the index e.g. `green`, `yellow`, or `red`.",
+							Computed:            true,
+						},
+						"index_name": schema.StringAttribute{
+							Description: "Name of the index.",
+							Computed:    true,
+						},
+						"number_of_replicas": schema.Int64Attribute{
+							Description: "Number of replicas configured for the index.",
+							Computed:    true,
+						},
+						"number_of_shards": schema.Int64Attribute{
+							Description: "Number of shards configured & used by the index.",
+							Computed:    true,
+						},
+						"read_only_allow_delete": schema.BoolAttribute{
+							Description: "Indicates whether the index is in a read-only state that permits deletion of the entire index. This attribute can be automatically set to true in certain scenarios where the node disk space exceeds the flood stage.",
+							Computed:    true,
+						},
+						"size": schema.Int64Attribute{
+							Description: "Size of the index in bytes.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Status of the index e.g. `open` or `closed`.",
+							Computed:            true,
+						},
+					},
+				},
 			},
 		},
 	}
 }
 
-func dataSourceOpenSearchIndicesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
-	client := meta.(*service.Service)
-	serviceID := d.Get("service").(string)
+func (d *openSearchIndicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data openSearchIndicesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID := data.Service.ValueString()
 
-	indices, err := client.GetManagedDatabaseIndices(ctx, &request.GetManagedDatabaseIndicesRequest{
+	indices, err := d.client.GetManagedDatabaseIndices(ctx, &request.GetManagedDatabaseIndicesRequest{
 		ServiceUUID: serviceID,
 	})
 	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	d.SetId(serviceID)
-
-	if err := d.Set("service", serviceID); err != nil {
-		return diag.FromErr(err)
+		resp.Diagnostics.AddError(
+			"Unable to read managed database indices",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
 	}
 
-	if err := d.Set("indices", buildOpenSearchIndices(indices)); err != nil {
-		return diag.FromErr(err)
-	}
-
-	return diags
-}
-
-func buildOpenSearchIndices(indices []upcloud.ManagedDatabaseIndex) []map[string]interface{} {
-	maps := make([]map[string]interface{}, 0)
+	data.Indices = make([]openSearchIndexModel, 0, len(indices))
 	for _, index := range indices {
-		maps = append(maps, map[string]interface{}{
-			"create_time":            index.CreateTime.UTC().Format(time.RFC3339Nano),
-			"docs":                   index.Docs,
-			"health":                 index.Health,
-			"index_name":             index.IndexName,
-			"status":                 index.Status,
-			"number_of_replicas":     index.NumberOfReplicas,
-			"number_of_shards":       index.NumberOfShards,
-			"read_only_allow_delete": index.ReadOnlyAllowDelete,
-			"size":                   index.Size,
+		data.Indices = append(data.Indices, openSearchIndexModel{
+			CreateTime:          types.StringValue(index.CreateTime.UTC().Format(time.RFC3339Nano)),
+			Docs:                types.Int64Value(int64(index.Docs)),
+			Health:              types.StringValue(index.Health),
+			IndexName:           types.StringValue(index.IndexName),
+			NumberOfReplicas:    types.Int64Value(int64(index.NumberOfReplicas)),
+			NumberOfShards:      types.Int64Value(int64(index.NumberOfShards)),
+			ReadOnlyAllowDelete: types.BoolPointerValue(index.ReadOnlyAllowDelete),
+			Size:                types.Int64Value(int64(index.Size)),
+			Status:              types.StringValue(index.Status),
 		})
 	}
 
-	return maps
+	data.ID = types.StringValue(serviceID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
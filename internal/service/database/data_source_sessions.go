@@ -2,527 +2,679 @@ package database
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
-
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	validatorutil "github.com/UpCloudLtd/terraform-provider-upcloud/internal/validator"
 )
 
-func DataSourceSessionsMySQL() *schema.Resource {
-	return &schema.Resource{
-		Description: "Current sessions of a MySQL managed database",
-		ReadContext: dataSourceSessionsMySQLRead,
-		Schema: utils.JoinSchemas(
-			schemaDataSourceSessionsCommon(),
-			schemaDataSourceSessionsMySQL(),
-		),
-	}
+const (
+	sessionsDefaultLimit = 10
+	sessionsDefaultOrder = "query_duration:desc"
+)
+
+func NewSessionsMySQLDataSource() datasource.DataSource {
+	return &sessionsDataSource{serviceType: upcloud.ManagedDatabaseServiceTypeMySQL, name: "mysql", title: "MySQL"}
 }
 
-func DataSourceSessionsPostgreSQL() *schema.Resource {
-	return &schema.Resource{
-		Description: "Current sessions of a PostgreSQL managed database",
-		ReadContext: dataSourceSessionsPostgreSQLRead,
-		Schema: utils.JoinSchemas(
-			schemaDataSourceSessionsCommon(),
-			schemaDataSourceSessionsPostgreSQL(),
-		),
-	}
+func NewSessionsPostgreSQLDataSource() datasource.DataSource {
+	return &sessionsDataSource{serviceType: upcloud.ManagedDatabaseServiceTypePostgreSQL, name: "postgresql", title: "PostgreSQL"}
 }
 
-func DataSourceSessionsValkey() *schema.Resource {
-	return &schema.Resource{
-		Description: "Current sessions of a Valkey managed database",
-		ReadContext: dataSourceSessionsValkeyRead,
-		Schema: utils.JoinSchemas(
-			schemaDataSourceSessionsCommon(),
-			schemaDataSourceSessionsValkey(),
-		),
-	}
+func NewSessionsValkeyDataSource() datasource.DataSource {
+	return &sessionsDataSource{serviceType: upcloud.ManagedDatabaseServiceTypeValkey, name: "valkey", title: "Valkey"}
+}
+
+var (
+	_ datasource.DataSource              = &sessionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &sessionsDataSource{}
+)
+
+type sessionsDataSource struct {
+	client      *service.Service
+	serviceType upcloud.ManagedDatabaseServiceType
+	name        string
+	title       string
+}
+
+func (d *sessionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_managed_database_%s_sessions", req.ProviderTypeName, d.name)
+}
+
+func (d *sessionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, resp.Diagnostics = utils.GetClientFromProviderData(req.ProviderData)
+}
+
+type sessionsCommonModel struct {
+	ID      types.String `tfsdk:"id"`
+	Service types.String `tfsdk:"service"`
+	Limit   types.Int64  `tfsdk:"limit"`
+	Offset  types.Int64  `tfsdk:"offset"`
+	Order   types.String `tfsdk:"order"`
+}
+
+type sessionsFilterModel struct {
+	Username         types.String `tfsdk:"username"`
+	Database         types.String `tfsdk:"database"`
+	State            types.String `tfsdk:"state"`
+	MinQueryDuration types.String `tfsdk:"min_query_duration"`
+}
+
+type sessionsMySQLModel struct {
+	sessionsCommonModel
+	sessionsFilterModel
+
+	Sessions []sessionMySQLModel `tfsdk:"sessions"`
+}
+
+type sessionsPostgreSQLModel struct {
+	sessionsCommonModel
+	sessionsFilterModel
+
+	Sessions []sessionPostgreSQLModel `tfsdk:"sessions"`
+}
+
+type sessionsValkeyModel struct {
+	sessionsCommonModel
+
+	Sessions []sessionValkeyModel `tfsdk:"sessions"`
+}
+
+type sessionMySQLModel struct {
+	ApplicationName types.String `tfsdk:"application_name"`
+	ClientAddr      types.String `tfsdk:"client_addr"`
+	Datname         types.String `tfsdk:"datname"`
+	ID              types.String `tfsdk:"id"`
+	Query           types.String `tfsdk:"query"`
+	QueryDuration   types.String `tfsdk:"query_duration"`
+	State           types.String `tfsdk:"state"`
+	Usename         types.String `tfsdk:"usename"`
+}
+
+type sessionPostgreSQLModel struct {
+	ApplicationName types.String `tfsdk:"application_name"`
+	BackendStart    types.String `tfsdk:"backend_start"`
+	BackendType     types.String `tfsdk:"backend_type"`
+	BackendXid      types.Int64  `tfsdk:"backend_xid"`
+	BackendXmin     types.Int64  `tfsdk:"backend_xmin"`
+	ClientAddr      types.String `tfsdk:"client_addr"`
+	ClientHostname  types.String `tfsdk:"client_hostname"`
+	ClientPort      types.Int64  `tfsdk:"client_port"`
+	Datid           types.Int64  `tfsdk:"datid"`
+	Datname         types.String `tfsdk:"datname"`
+	ID              types.String `tfsdk:"id"`
+	Query           types.String `tfsdk:"query"`
+	QueryDuration   types.String `tfsdk:"query_duration"`
+	QueryStart      types.String `tfsdk:"query_start"`
+	State           types.String `tfsdk:"state"`
+	StateChange     types.String `tfsdk:"state_change"`
+	Usename         types.String `tfsdk:"usename"`
+	Usesysid        types.Int64  `tfsdk:"usesysid"`
+	WaitEvent       types.String `tfsdk:"wait_event"`
+	WaitEventType   types.String `tfsdk:"wait_event_type"`
+	XactStart       types.String `tfsdk:"xact_start"`
+}
+
+type sessionValkeyModel struct {
+	ActiveChannelSubscriptions                types.Int64  `tfsdk:"active_channel_subscriptions"`
+	ActiveDatabase                            types.String `tfsdk:"active_database"`
+	ActivePatternMatchingChannelSubscriptions types.Int64  `tfsdk:"active_pattern_matching_channel_subscriptions"`
+	ApplicationName                           types.String `tfsdk:"application_name"`
+	ClientAddr                                types.String `tfsdk:"client_addr"`
+	ConnectionAge                             types.Int64  `tfsdk:"connection_age"`
+	ConnectionIdle                            types.Int64  `tfsdk:"connection_idle"`
+	Flags                                     types.Set    `tfsdk:"flags"`
+	FlagsRaw                                  types.String `tfsdk:"flags_raw"`
+	ID                                        types.String `tfsdk:"id"`
+	MultiExecCommands                         types.Int64  `tfsdk:"multi_exec_commands"`
+	OutputBuffer                              types.Int64  `tfsdk:"output_buffer"`
+	OutputBufferMemory                        types.Int64  `tfsdk:"output_buffer_memory"`
+	OutputListLength                          types.Int64  `tfsdk:"output_list_length"`
+	Query                                     types.String `tfsdk:"query"`
+	QueryBuffer                               types.Int64  `tfsdk:"query_buffer"`
+	QueryBufferFree                           types.Int64  `tfsdk:"query_buffer_free"`
 }
 
-func schemaDataSourceSessionsCommon() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
-		"limit": {
-			Description: "Number of entries to receive at most.",
-			Type:        schema.TypeInt,
-			Optional:    true,
-			Default:     10,
-		},
-		"offset": {
-			Description: "Offset for retrieved results based on sort order.",
-			Type:        schema.TypeInt,
-			Optional:    true,
-			Default:     0,
-		},
-		"order": {
-			Description: "Order by session field and sort retrieved results. Limited variables can be used for ordering.",
-			Type:        schema.TypeString,
-			Optional:    true,
-			Default:     "query_duration:desc",
-		},
-		"service": {
+func sessionsCommonAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"limit": schema.Int64Attribute{
+			MarkdownDescription: fmt.Sprintf("Number of entries to receive at most. Defaults to `%d`.", sessionsDefaultLimit),
+			Optional:            true,
+		},
+		"offset": schema.Int64Attribute{
+			MarkdownDescription: "Offset for retrieved results based on sort order. Defaults to `0`.",
+			Optional:            true,
+		},
+		"order": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Order by session field and sort retrieved results. Limited variables can be used for ordering. Defaults to `%s`.", sessionsDefaultOrder),
+			Optional:            true,
+		},
+		"service": schema.StringAttribute{
 			Description: "Service's UUID for which these sessions belongs to",
-			Type:        schema.TypeString,
 			Required:    true,
-			Computed:    false,
 		},
 	}
 }
 
-func schemaDataSourceSessionsMySQL() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
-		"sessions": {
-			Description: "Current sessions",
-			Type:        schema.TypeSet,
-			Optional:    true,
-			Computed:    true,
-			Elem:        schemaDatabaseSessionMySQL(),
+func sessionsFilterAttributes(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	const filterNote = " Filters are applied to the sessions returned by the API, i.e. after `limit` and `offset`."
+	attributes["username"] = schema.StringAttribute{
+		MarkdownDescription: "Only include sessions of the given user." + filterNote,
+		Optional:            true,
+	}
+	attributes["database"] = schema.StringAttribute{
+		MarkdownDescription: "Only include sessions connected to the given database." + filterNote,
+		Optional:            true,
+	}
+	attributes["state"] = schema.StringAttribute{
+		MarkdownDescription: "Only include sessions in the given state, e.g. `active` or `idle`." + filterNote,
+		Optional:            true,
+	}
+	attributes["min_query_duration"] = schema.StringAttribute{
+		MarkdownDescription: "Only include sessions where the duration of the current query is at least the given duration, e.g. `30s`." + filterNote,
+		Optional:            true,
+		Validators: []validator.String{
+			validatorutil.Duration(),
 		},
 	}
+	return attributes
 }
 
-func schemaDataSourceSessionsPostgreSQL() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
-		"sessions": {
-			Description: "Current sessions",
-			Type:        schema.TypeSet,
-			Optional:    true,
-			Computed:    true,
-			Elem:        schemaDatabaseSessionPostgreSQL(),
+func (d *sessionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := sessionsCommonAttributes()
+	var sessionAttributes map[string]schema.Attribute
+
+	switch d.serviceType {
+	case upcloud.ManagedDatabaseServiceTypeMySQL:
+		attributes = sessionsFilterAttributes(attributes)
+		sessionAttributes = sessionMySQLAttributes()
+	case upcloud.ManagedDatabaseServiceTypePostgreSQL:
+		attributes = sessionsFilterAttributes(attributes)
+		sessionAttributes = sessionPostgreSQLAttributes()
+	case upcloud.ManagedDatabaseServiceTypeValkey:
+		sessionAttributes = sessionValkeyAttributes()
+	}
+
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Current sessions of a %s managed database", d.title),
+		Attributes:  attributes,
+		Blocks: map[string]schema.Block{
+			"sessions": schema.SetNestedBlock{
+				Description: "Current sessions",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: sessionAttributes,
+				},
+			},
 		},
 	}
 }
 
-func schemaDataSourceSessionsValkey() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
-		"sessions": {
-			Description: "Current sessions",
-			Type:        schema.TypeSet,
-			Optional:    true,
+func sessionMySQLAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"application_name": schema.StringAttribute{
+			Description: "Name of the application that is connected to this service.",
+			Computed:    true,
+		},
+		"client_addr": schema.StringAttribute{
+			Description: "IP address of the client connected to this service.",
+			Computed:    true,
+		},
+		"datname": schema.StringAttribute{
+			Description: "Name of the database this service is connected to.",
+			Computed:    true,
+		},
+		"id": schema.StringAttribute{
+			Description: "Process ID of this service.",
+			Computed:    true,
+		},
+		"query": schema.StringAttribute{
+			Description: "Text of this service's most recent query. If state is active this field shows the currently executing query. In all other states, it shows an empty string.",
+			Computed:    true,
+		},
+		"query_duration": schema.StringAttribute{
+			Description: "The active query current duration.",
+			Computed:    true,
+		},
+		"state": schema.StringAttribute{
+			Description: "Current overall state of this service: active: The service is executing a query, idle: The service is waiting for a new client command.",
+			Computed:    true,
+		},
+		"usename": schema.StringAttribute{
+			Description: "Name of the user logged into this service.",
 			Computed:    true,
-			Elem:        schemaDatabaseSessionValkey(),
 		},
 	}
 }
 
-func schemaDatabaseSessionMySQL() *schema.Resource {
-	return &schema.Resource{
-		Description: "MySQL session",
-		Schema: map[string]*schema.Schema{
-			"application_name": {
-				Description: "Name of the application that is connected to this service.",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"client_addr": {
-				Description: "IP address of the client connected to this service.",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"datname": {
-				Description: "Name of the database this service is connected to.",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"id": {
-				Description: "Process ID of this service.",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"query": {
-				Description: "Text of this service's most recent query. If state is active this field shows the currently executing query. In all other states, it shows an empty string.",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"query_duration": {
-				Description: "The active query current duration.",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"state": {
-				Description: "Current overall state of this service: active: The service is executing a query, idle: The service is waiting for a new client command.",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"usename": {
-				Description: "Name of the user logged into this service.",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
+func sessionPostgreSQLAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"application_name": schema.StringAttribute{
+			Description: "Name of the application that is connected to this service.",
+			Computed:    true,
+		},
+		"backend_start": schema.StringAttribute{
+			Description: "Time when this process was started, i.e., when the client connected to the server.",
+			Computed:    true,
+		},
+		"backend_type": schema.StringAttribute{
+			Description: "Type of current service.",
+			Computed:    true,
+		},
+		"backend_xid": schema.Int64Attribute{
+			Description: "Top-level transaction identifier of this service, if any.",
+			Computed:    true,
+		},
+		"backend_xmin": schema.Int64Attribute{
+			Description: "The current service's xmin horizon.",
+			Computed:    true,
+		},
+		"client_addr": schema.StringAttribute{
+			Description: "IP address of the client connected to this service. If this field is null, it indicates either that the client is connected via a Unix socket on the server machine or that this is an internal process such as autovacuum.",
+			Computed:    true,
+		},
+		"client_hostname": schema.StringAttribute{
+			MarkdownDescription: "Host name of the connected client, as reported by a reverse DNS lookup of `client_addr`.",
+			Computed:            true,
+		},
+		"client_port": schema.Int64Attribute{
+			Description: "TCP port number that the client is using for communication with this service, or -1 if a Unix socket is used.",
+			Computed:    true,
+		},
+		"datid": schema.Int64Attribute{
+			Description: "OID of the database this service is connected to.",
+			Computed:    true,
+		},
+		"datname": schema.StringAttribute{
+			Description: "Name of the database this service is connected to.",
+			Computed:    true,
+		},
+		"id": schema.StringAttribute{
+			Description: "Process ID of this service.",
+			Computed:    true,
+		},
+		"query": schema.StringAttribute{
+			Description: "Text of this service's most recent query. If state is active this field shows the currently executing query. In all other states, it shows the last query that was executed.",
+			Computed:    true,
+		},
+		"query_duration": schema.StringAttribute{
+			Description: "The active query current duration.",
+			Computed:    true,
+		},
+		"query_start": schema.StringAttribute{
+			Description: "Time when the currently active query was started, or if state is not active, when the last query was started.",
+			Computed:    true,
+		},
+		"state": schema.StringAttribute{
+			Description: "Current overall state of this service: active: The service is executing a query, idle: The service is waiting for a new client command.",
+			Computed:    true,
+		},
+		"state_change": schema.StringAttribute{
+			Description: "Time when the state was last changed.",
+			Computed:    true,
+		},
+		"usename": schema.StringAttribute{
+			Description: "Name of the user logged into this service.",
+			Computed:    true,
+		},
+		"usesysid": schema.Int64Attribute{
+			Description: "OID of the user logged into this service.",
+			Computed:    true,
+		},
+		"wait_event": schema.StringAttribute{
+			Description: "Wait event name if service is currently waiting.",
+			Computed:    true,
+		},
+		"wait_event_type": schema.StringAttribute{
+			Description: "The type of event for which the service is waiting, if any; otherwise NULL.",
+			Computed:    true,
+		},
+		"xact_start": schema.StringAttribute{
+			Description: "Time when this process' current transaction was started, or null if no transaction is active.",
+			Computed:    true,
 		},
 	}
 }
 
-func schemaDatabaseSessionPostgreSQL() *schema.Resource {
-	return &schema.Resource{
-		Description: "PostgreSQL session",
-		Schema: map[string]*schema.Schema{
-			"application_name": {
-				Description: "Name of the application that is connected to this service.",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"backend_start": {
-				Description: "Time when this process was started, i.e., when the client connected to the server.",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"backend_type": {
-				Description: "Type of current service.",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"backend_xid": {
-				Description: "Top-level transaction identifier of this service, if any.",
-				Type:        schema.TypeInt,
-				Computed:    true,
-				Optional:    true,
-			},
-			"backend_xmin": {
-				Description: "The current service's xmin horizon.",
-				Type:        schema.TypeInt,
-				Computed:    true,
-				Optional:    true,
-			},
-			"client_addr": {
-				Description: "IP address of the client connected to this service. If this field is null, it indicates either that the client is connected via a Unix socket on the server machine or that this is an internal process such as autovacuum.",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"client_hostname": {
-				Description: "Host name of the connected client, as reported by a reverse DNS lookup of `client_addr`.",
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
-			},
-			"client_port": {
-				Description: "TCP port number that the client is using for communication with this service, or -1 if a Unix socket is used.",
-				Type:        schema.TypeInt,
-				Computed:    true,
-			},
-			"datid": {
-				Description: "OID of the database this service is connected to.",
-				Type:        schema.TypeInt,
-				Computed:    true,
-			},
-			"datname": {
-				Description: "Name of the database this service is connected to.",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"id": {
-				Description: "Process ID of this service.",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"query": {
-				Description: "Text of this service's most recent query. If state is active this field shows the currently executing query. In all other states, it shows the last query that was executed.",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"query_duration": {
-				Description: "The active query current duration.",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"query_start": {
-				Description: "Time when the currently active query was started, or if state is not active, when the last query was started.",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"state": {
-				Description: "Current overall state of this service: active: The service is executing a query, idle: The service is waiting for a new client command.",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"state_change": {
-				Description: "Time when the state was last changed.",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"usename": {
-				Description: "Name of the user logged into this service.",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"usesysid": {
-				Description: "OID of the user logged into this service.",
-				Type:        schema.TypeInt,
-				Computed:    true,
-			},
-			"wait_event": {
-				Description: "Wait event name if service is currently waiting.",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"wait_event_type": {
-				Description: "The type of event for which the service is waiting, if any; otherwise NULL.",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"xact_start": {
-				Description: "Time when this process' current transaction was started, or null if no transaction is active.",
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
-			},
+func sessionValkeyAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"active_channel_subscriptions": schema.Int64Attribute{
+			Description: "Number of active channel subscriptions",
+			Computed:    true,
+		},
+		"active_database": schema.StringAttribute{
+			Description: "Current database ID",
+			Computed:    true,
+		},
+		"active_pattern_matching_channel_subscriptions": schema.Int64Attribute{
+			Description: "Number of pattern matching subscriptions.",
+			Computed:    true,
+		},
+		"application_name": schema.StringAttribute{
+			Description: "Name of the application that is connected to this service.",
+			Computed:    true,
+		},
+		"client_addr": schema.StringAttribute{
+			Description: "Number of pattern matching subscriptions.",
+			Computed:    true,
+		},
+		"connection_age": schema.Int64Attribute{
+			Description: "Total duration of the connection in nanoseconds.",
+			Computed:    true,
+		},
+		"connection_idle": schema.Int64Attribute{
+			Description: "Idle time of the connection in nanoseconds.",
+			Computed:    true,
+		},
+		"flags": schema.SetAttribute{
+			Description: "A set containing flags' descriptions.",
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+		"flags_raw": schema.StringAttribute{
+			Description: "Client connection flags in raw string format.",
+			Computed:    true,
+		},
+		"id": schema.StringAttribute{
+			Description: "Process ID of this session.",
+			Computed:    true,
+		},
+		"multi_exec_commands": schema.Int64Attribute{
+			Description: "Number of commands in a MULTI/EXEC context.",
+			Computed:    true,
+		},
+		"output_buffer": schema.Int64Attribute{
+			Description: "Output buffer length.",
+			Computed:    true,
+		},
+		"output_buffer_memory": schema.Int64Attribute{
+			Description: "Output buffer memory usage.",
+			Computed:    true,
+		},
+		"output_list_length": schema.Int64Attribute{
+			Description: "Output list length (replies are queued in this list when the buffer is full).",
+			Computed:    true,
+		},
+		"query": schema.StringAttribute{
+			Description: "The last executed command.",
+			Computed:    true,
+		},
+		"query_buffer": schema.Int64Attribute{
+			Description: "Query buffer length (0 means no query pending).",
+			Computed:    true,
+		},
+		"query_buffer_free": schema.Int64Attribute{
+			Description: "Free space of the query buffer (0 means the buffer is full).",
+			Computed:    true,
 		},
 	}
 }
 
-func schemaDatabaseSessionValkey() *schema.Resource {
-	return &schema.Resource{
-		Description: "Valkey session",
-		Schema: map[string]*schema.Schema{
-			"active_channel_subscriptions": {
-				Description: "Number of active channel subscriptions",
-				Type:        schema.TypeInt,
-				Computed:    true,
-			},
-			"active_database": {
-				Description: "Current database ID",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"active_pattern_matching_channel_subscriptions": {
-				Description: "Number of pattern matching subscriptions.",
-				Type:        schema.TypeInt,
-				Computed:    true,
-			},
-			"application_name": {
-				Description: "Name of the application that is connected to this service.",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"client_addr": {
-				Description: "Number of pattern matching subscriptions.",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"connection_age": {
-				Description: "Total duration of the connection in nanoseconds.",
-				Type:        schema.TypeInt,
-				Computed:    true,
-			},
-			"connection_idle": {
-				Description: "Idle time of the connection in nanoseconds.",
-				Type:        schema.TypeInt,
-				Computed:    true,
-			},
-			"flags": {
-				Description: "A set containing flags' descriptions.",
-				Type:        schema.TypeSet,
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
-				},
-				Computed: true,
-			},
-			"flags_raw": {
-				Description: "Client connection flags in raw string format.",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"id": {
-				Description: "Process ID of this session.",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"multi_exec_commands": {
-				Description: "Number of commands in a MULTI/EXEC context.",
-				Type:        schema.TypeInt,
-				Computed:    true,
-			},
-			"output_buffer": {
-				Description: "Output buffer length.",
-				Type:        schema.TypeInt,
-				Computed:    true,
-			},
-			"output_buffer_memory": {
-				Description: "Output buffer memory usage.",
-				Type:        schema.TypeInt,
-				Computed:    true,
-			},
-			"output_list_length": {
-				Description: "Output list length (replies are queued in this list when the buffer is full).",
-				Type:        schema.TypeInt,
-				Computed:    true,
-			},
-			"query": {
-				Description: "The last executed command.",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"query_buffer": {
-				Description: "Query buffer length (0 means no query pending).",
-				Type:        schema.TypeInt,
-				Computed:    true,
-			},
-			"query_buffer_free": {
-				Description: "Free space of the query buffer (0 means the buffer is full).",
-				Type:        schema.TypeInt,
-				Computed:    true,
-			},
-		},
-	}
+// sessionFilter contains the parsed session filters. Empty values match all sessions.
+type sessionFilter struct {
+	username         string
+	database         string
+	state            string
+	minQueryDuration time.Duration
 }
 
-func dataSourceSessionsMySQLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
-	return dataSourceSessionsRead(ctx, d, meta, upcloud.ManagedDatabaseServiceTypeMySQL)
+func newSessionFilter(data sessionsFilterModel) (sessionFilter, error) {
+	f := sessionFilter{
+		username: data.Username.ValueString(),
+		database: data.Database.ValueString(),
+		state:    data.State.ValueString(),
+	}
+
+	if !data.MinQueryDuration.IsNull() {
+		var err error
+		if f.minQueryDuration, err = time.ParseDuration(data.MinQueryDuration.ValueString()); err != nil {
+			return f, err
+		}
+	}
+	return f, nil
 }
 
-func dataSourceSessionsPostgreSQLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
-	return dataSourceSessionsRead(ctx, d, meta, upcloud.ManagedDatabaseServiceTypePostgreSQL)
+func (f sessionFilter) matches(username, database, state string, queryDuration time.Duration) bool {
+	if f.username != "" && username != f.username {
+		return false
+	}
+	if f.database != "" && database != f.database {
+		return false
+	}
+	if f.state != "" && state != f.state {
+		return false
+	}
+	return queryDuration >= f.minQueryDuration
 }
 
-func dataSourceSessionsValkeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
-	return dataSourceSessionsRead(ctx, d, meta, upcloud.ManagedDatabaseServiceTypeValkey)
+// setSessionsDefaults sets the default values for the optional pagination attributes.
+func setSessionsDefaults(data *sessionsCommonModel) {
+	if data.Limit.IsNull() {
+		data.Limit = types.Int64Value(sessionsDefaultLimit)
+	}
+	if data.Offset.IsNull() {
+		data.Offset = types.Int64Value(0)
+	}
+	if data.Order.IsNull() {
+		data.Order = types.StringValue(sessionsDefaultOrder)
+	}
 }
 
-func dataSourceSessionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}, serviceType upcloud.ManagedDatabaseServiceType) (diags diag.Diagnostics) {
-	client := meta.(*service.Service)
-	serviceID := d.Get("service").(string)
+func (d *sessionsDataSource) getSessions(ctx context.Context, data *sessionsCommonModel) (*upcloud.ManagedDatabaseSessions, diag.Diagnostics) {
+	var diags diag.Diagnostics
 
-	limit := d.Get("limit").(int)
-	offset := d.Get("offset").(int)
-	order := d.Get("order").(string)
+	setSessionsDefaults(data)
+	serviceID := data.Service.ValueString()
 
-	db, err := client.GetManagedDatabase(ctx, &request.GetManagedDatabaseRequest{UUID: serviceID})
+	db, err := d.client.GetManagedDatabase(ctx, &request.GetManagedDatabaseRequest{UUID: serviceID})
 	if err != nil {
-		return diag.FromErr(err)
+		diags.AddError(
+			"Unable to read managed database details",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return nil, diags
 	}
 
-	if db.Type != serviceType {
-		return diag.Errorf("Getting sessions for Managed Database %s failed: database type %s is not valid for this data source", serviceID, db.Type)
+	if db.Type != d.serviceType {
+		diags.AddError(
+			"Invalid managed database type",
+			fmt.Sprintf("Getting sessions for Managed Database %s failed: database type %s is not valid for this data source", serviceID, db.Type),
+		)
+		return nil, diags
 	}
 
-	sessions, err := client.GetManagedDatabaseSessions(ctx, &request.GetManagedDatabaseSessionsRequest{
+	sessions, err := d.client.GetManagedDatabaseSessions(ctx, &request.GetManagedDatabaseSessionsRequest{
 		UUID:   serviceID,
-		Limit:  limit,
-		Offset: offset,
-		Order:  order,
+		Limit:  int(data.Limit.ValueInt64()),
+		Offset: int(data.Offset.ValueInt64()),
+		Order:  data.Order.ValueString(),
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		diags.AddError(
+			"Unable to read managed database sessions",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return nil, diags
 	}
 
-	d.SetId(serviceID)
-
-	if err := d.Set("service", serviceID); err != nil {
-		return diag.FromErr(err)
-	}
+	data.ID = types.StringValue(serviceID)
+	return &sessions, diags
+}
 
-	switch serviceType {
+func (d *sessionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	switch d.serviceType {
 	case upcloud.ManagedDatabaseServiceTypeMySQL:
-		if err := d.Set("sessions", buildSessionsMySQL(sessions.MySQL)); err != nil {
-			return diag.FromErr(err)
-		}
+		d.readMySQL(ctx, req, resp)
 	case upcloud.ManagedDatabaseServiceTypePostgreSQL:
-		if err := d.Set("sessions", buildSessionsPostgreSQL(sessions.PostgreSQL)); err != nil {
-			return diag.FromErr(err)
-		}
+		d.readPostgreSQL(ctx, req, resp)
 	case upcloud.ManagedDatabaseServiceTypeValkey:
-		err := d.Set("sessions", buildSessionsValkey(sessions.Valkey))
-		if err != nil {
-			return diag.FromErr(err)
-		}
+		d.readValkey(ctx, req, resp)
 	}
-
-	return diags
 }
 
-func buildSessionsMySQL(sessions []upcloud.ManagedDatabaseSessionMySQL) []map[string]interface{} {
-	maps := make([]map[string]interface{}, 0)
+func (d *sessionsDataSource) readMySQL(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data sessionsMySQLModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, err := newSessionFilter(data.sessionsFilterModel)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to parse min_query_duration",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
 
-	if len(sessions) == 0 {
-		return maps
+	sessions, diags := d.getSessions(ctx, &data.sessionsCommonModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	for _, session := range sessions {
-		maps = append(maps, map[string]interface{}{
-			"application_name": session.ApplicationName,
-			"client_addr":      session.ClientAddr,
-			"datname":          session.Datname,
-			"id":               session.Id,
-			"query":            session.Query,
-			"query_duration":   session.QueryDuration.String(),
-			"state":            session.State,
-			"usename":          session.Usename,
+	data.Sessions = make([]sessionMySQLModel, 0)
+	for _, session := range sessions.MySQL {
+		if !filter.matches(session.Usename, session.Datname, session.State, session.QueryDuration) {
+			continue
+		}
+
+		data.Sessions = append(data.Sessions, sessionMySQLModel{
+			ApplicationName: types.StringValue(session.ApplicationName),
+			ClientAddr:      types.StringValue(session.ClientAddr),
+			Datname:         types.StringValue(session.Datname),
+			ID:              types.StringValue(session.Id),
+			Query:           types.StringValue(session.Query),
+			QueryDuration:   types.StringValue(session.QueryDuration.String()),
+			State:           types.StringValue(session.State),
+			Usename:         types.StringValue(session.Usename),
 		})
 	}
 
-	return maps
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func timeStringPointerValue(t *time.Time) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(t.UTC().Format(time.RFC3339Nano))
+}
+
+func intPointerValue(i *int) types.Int64 {
+	if i == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*i))
 }
 
-func buildSessionsPostgreSQL(sessions []upcloud.ManagedDatabaseSessionPostgreSQL) []map[string]interface{} {
-	maps := make([]map[string]interface{}, 0)
-
-	if len(sessions) == 0 {
-		return maps
-	}
-
-	for _, session := range sessions {
-		maps = append(maps, map[string]interface{}{
-			"application_name": session.ApplicationName,
-			"backend_start":    session.BackendStart.UTC().Format(time.RFC3339Nano),
-			"backend_type":     session.BackendType,
-			"backend_xid":      session.BackendXid,
-			"backend_xmin":     session.BackendXmin,
-			"client_addr":      session.ClientAddr,
-			"client_hostname":  session.ClientHostname,
-			"client_port":      session.ClientPort,
-			"datid":            session.Datid,
-			"datname":          session.Datname,
-			"id":               session.Id,
-			"query":            session.Query,
-			"query_duration":   session.QueryDuration.String(),
-			"query_start":      session.QueryStart.UTC().Format(time.RFC3339Nano),
-			"state":            session.State,
-			"state_change":     session.StateChange.UTC().Format(time.RFC3339Nano),
-			"usename":          session.Usename,
-			"usesysid":         session.Usesysid,
-			"wait_event":       session.WaitEvent,
-			"wait_event_type":  session.WaitEventType,
-			"xact_start":       session.XactStart.UTC().Format(time.RFC3339Nano),
+func (d *sessionsDataSource) readPostgreSQL(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data sessionsPostgreSQLModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, err := newSessionFilter(data.sessionsFilterModel)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to parse min_query_duration",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	sessions, diags := d.getSessions(ctx, &data.sessionsCommonModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Sessions = make([]sessionPostgreSQLModel, 0)
+	for _, session := range sessions.PostgreSQL {
+		if !filter.matches(session.Usename, session.Datname, session.State, session.QueryDuration) {
+			continue
+		}
+
+		data.Sessions = append(data.Sessions, sessionPostgreSQLModel{
+			ApplicationName: types.StringValue(session.ApplicationName),
+			BackendStart:    types.StringValue(session.BackendStart.UTC().Format(time.RFC3339Nano)),
+			BackendType:     types.StringValue(session.BackendType),
+			BackendXid:      intPointerValue(session.BackendXid),
+			BackendXmin:     intPointerValue(session.BackendXmin),
+			ClientAddr:      types.StringValue(session.ClientAddr),
+			ClientHostname:  types.StringPointerValue(session.ClientHostname),
+			ClientPort:      types.Int64Value(int64(session.ClientPort)),
+			Datid:           types.Int64Value(int64(session.Datid)),
+			Datname:         types.StringValue(session.Datname),
+			ID:              types.StringValue(session.Id),
+			Query:           types.StringValue(session.Query),
+			QueryDuration:   types.StringValue(session.QueryDuration.String()),
+			QueryStart:      types.StringValue(session.QueryStart.UTC().Format(time.RFC3339Nano)),
+			State:           types.StringValue(session.State),
+			StateChange:     types.StringValue(session.StateChange.UTC().Format(time.RFC3339Nano)),
+			Usename:         types.StringValue(session.Usename),
+			Usesysid:        types.Int64Value(int64(session.Usesysid)),
+			WaitEvent:       types.StringValue(session.WaitEvent),
+			WaitEventType:   types.StringValue(session.WaitEventType),
+			XactStart:       timeStringPointerValue(session.XactStart),
 		})
 	}
 
-	return maps
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func buildSessionsValkey(sessions []upcloud.ManagedDatabaseSessionValkey) []map[string]interface{} {
-	maps := make([]map[string]interface{}, 0)
-
-	if len(sessions) == 0 {
-		return maps
-	}
-
-	for _, session := range sessions {
-		maps = append(maps, map[string]interface{}{
-			"active_channel_subscriptions":                  session.ActiveChannelSubscriptions,
-			"active_database":                               session.ActiveDatabase,
-			"active_pattern_matching_channel_subscriptions": session.ActivePatternMatchingChannelSubscriptions,
-			"application_name":                              session.ApplicationName,
-			"client_addr":                                   session.ClientAddr,
-			"connection_age":                                session.ConnectionAge.Nanoseconds(),
-			"connection_idle":                               session.ConnectionIdle.Nanoseconds(),
-			"flags":                                         session.Flags,
-			"flags_raw":                                     session.FlagsRaw,
-			"id":                                            session.Id,
-			"multi_exec_commands":                           session.MultiExecCommands,
-			"output_buffer":                                 session.OutputBuffer,
-			"output_buffer_memory":                          session.OutputBufferMemory,
-			"output_list_length":                            session.OutputListLength,
-			"query":                                         session.Query,
-			"query_buffer":                                  session.QueryBuffer,
-			"query_buffer_free":                             session.QueryBufferFree,
+func (d *sessionsDataSource) readValkey(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data sessionsValkeyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sessions, diags := d.getSessions(ctx, &data.sessionsCommonModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Sessions = make([]sessionValkeyModel, 0)
+	for _, session := range sessions.Valkey {
+		flags, diags := types.SetValueFrom(ctx, types.StringType, utils.NilAsEmptyList(session.Flags))
+		resp.Diagnostics.Append(diags...)
+
+		data.Sessions = append(data.Sessions, sessionValkeyModel{
+			ActiveChannelSubscriptions:                types.Int64Value(int64(session.ActiveChannelSubscriptions)),
+			ActiveDatabase:                            types.StringValue(session.ActiveDatabase),
+			ActivePatternMatchingChannelSubscriptions: types.Int64Value(int64(session.ActivePatternMatchingChannelSubscriptions)),
+			ApplicationName:                           types.StringValue(session.ApplicationName),
+			ClientAddr:                                types.StringValue(session.ClientAddr),
+			ConnectionAge:                             types.Int64Value(session.ConnectionAge.Nanoseconds()),
+			ConnectionIdle:                            types.Int64Value(session.ConnectionIdle.Nanoseconds()),
+			Flags:                                     flags,
+			FlagsRaw:                                  types.StringValue(session.FlagsRaw),
+			ID:                                        types.StringValue(session.Id),
+			MultiExecCommands:                         types.Int64Value(int64(session.MultiExecCommands)),
+			OutputBuffer:                              types.Int64Value(int64(session.OutputBuffer)),
+			OutputBufferMemory:                        types.Int64Value(int64(session.OutputBufferMemory)),
+			OutputListLength:                          types.Int64Value(int64(session.OutputListLength)),
+			Query:                                     types.StringValue(session.Query),
+			QueryBuffer:                               types.Int64Value(int64(session.QueryBuffer)),
+			QueryBufferFree:                           types.Int64Value(int64(session.QueryBufferFree)),
 		})
 	}
 
-	return maps
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -220,7 +220,8 @@ func setDatabaseProperties(ctx context.Context, data *databaseCommonModel, db *u
 	return diags
 }
 
-func createDatabase(ctx context.Context, data *databaseCommonModel, client *service.Service) (*upcloud.ManagedDatabase, diag.Diagnostics) {
+// createDatabase creates a new managed database. The createOnlyProperties, e.g. fork settings, are added to the properties defined in the plan.
+func createDatabase(ctx context.Context, data *databaseCommonModel, createOnlyProperties map[upcloud.ManagedDatabasePropertyKey]interface{}, client *service.Service) (*upcloud.ManagedDatabase, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	req, d := buildManagedDatabaseRequestFromPlan(ctx, data)
@@ -229,6 +230,21 @@ func createDatabase(ctx context.Context, data *databaseCommonModel, client *serv
 		return nil, diags
 	}
 
+	if len(createOnlyProperties) > 0 && req.Properties == nil {
+		req.Properties = make(map[upcloud.ManagedDatabasePropertyKey]interface{})
+	}
+	for key, value := range createOnlyProperties {
+		req.Properties[key] = value
+	}
+
+	// The source service can not be forked while it is in pending state, e.g. during plan or version upgrade.
+	if source, ok := createOnlyProperties[forkServicePropertyKey].(string); ok {
+		diags.Append(waitForNonPendingState(ctx, client, source)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
 	db, err := client.CreateManagedDatabase(ctx, &req)
 	if err != nil {
 		diags.AddError(
@@ -243,7 +259,7 @@ func createDatabase(ctx context.Context, data *databaseCommonModel, client *serv
 	if db, err = client.WaitForManagedDatabaseState(ctx, &request.WaitForManagedDatabaseStateRequest{UUID: db.UUID, DesiredState: upcloud.ManagedDatabaseStateRunning}); err != nil {
 		diags.AddError(
 			"Error while waiting for database to be in running state",
-			utils.ErrorDiagnosticDetail(err),
+			utils.WaitErrorDiagnosticDetail(ctx, err),
 		)
 		return nil, diags
 	}
@@ -500,7 +516,7 @@ func updateVersion(ctx context.Context, uuid, newVersion string, powered bool, c
 	if err != nil {
 		diags.AddError(
 			"Error while waiting for database to be in running state",
-			utils.ErrorDiagnosticDetail(err),
+			utils.WaitErrorDiagnosticDetail(ctx, err),
 		)
 		return
 	}
@@ -541,7 +557,7 @@ func waitForPoweredState(ctx context.Context, svc *service.Service, id string, p
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error while waiting for database to be in %s state", expectedState),
-			utils.ErrorDiagnosticDetail(err),
+			utils.WaitErrorDiagnosticDetail(ctx, err),
 		)
 	}
 	return db, diags
@@ -552,7 +568,7 @@ func waitForDatabaseToBeDeleted(ctx context.Context, svc *service.Service, id st
 	if err != nil {
 		diags.AddError(
 			"Error while waiting for database to be deleted",
-			utils.ErrorDiagnosticDetail(err),
+			utils.WaitErrorDiagnosticDetail(ctx, err),
 		)
 	}
 	return
@@ -588,7 +604,7 @@ func waitForNonPendingState(ctx context.Context, svc *service.Service, uuid stri
 		case <-ctx.Done():
 			diags.AddError(
 				"Context cancelled",
-				utils.ErrorDiagnosticDetail(ctx.Err()),
+				utils.WaitErrorDiagnosticDetail(ctx, ctx.Err()),
 			)
 			return diags
 		default:
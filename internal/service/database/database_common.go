@@ -8,6 +8,7 @@ import (
 	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/service/database/properties"
 	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -20,29 +21,30 @@ import (
 )
 
 type databaseCommonModel struct {
-	ID                     types.String `tfsdk:"id"`
-	Name                   types.String `tfsdk:"name"`
-	Labels                 types.Map    `tfsdk:"labels"`
-	Components             types.List   `tfsdk:"components"`
-	MaintenanceWindowDow   types.String `tfsdk:"maintenance_window_dow"`
-	MaintenanceWindowTime  types.String `tfsdk:"maintenance_window_time"`
-	AdditionalDiskSpaceGiB types.Int64  `tfsdk:"additional_disk_space_gib"`
-	Network                types.Set    `tfsdk:"network"`
-	NodeStates             types.List   `tfsdk:"node_states"`
-	Plan                   types.String `tfsdk:"plan"`
-	Powered                types.Bool   `tfsdk:"powered"`
-	ServiceURI             types.String `tfsdk:"service_uri"`
-	ServiceHost            types.String `tfsdk:"service_host"`
-	ServicePort            types.String `tfsdk:"service_port"`
-	ServiceUsername        types.String `tfsdk:"service_username"`
-	ServicePassword        types.String `tfsdk:"service_password"`
-	State                  types.String `tfsdk:"state"`
-	TerminationProtection  types.Bool   `tfsdk:"termination_protection"`
-	Title                  types.String `tfsdk:"title"`
-	Type                   types.String `tfsdk:"type"`
-	Zone                   types.String `tfsdk:"zone"`
-	PrimaryDatabase        types.String `tfsdk:"primary_database"`
-	Properties             types.List   `tfsdk:"properties"`
+	ID                     types.String   `tfsdk:"id"`
+	Name                   types.String   `tfsdk:"name"`
+	Labels                 types.Map      `tfsdk:"labels"`
+	Components             types.List     `tfsdk:"components"`
+	MaintenanceWindowDow   types.String   `tfsdk:"maintenance_window_dow"`
+	MaintenanceWindowTime  types.String   `tfsdk:"maintenance_window_time"`
+	AdditionalDiskSpaceGiB types.Int64    `tfsdk:"additional_disk_space_gib"`
+	Network                types.Set      `tfsdk:"network"`
+	NodeStates             types.List     `tfsdk:"node_states"`
+	Plan                   types.String   `tfsdk:"plan"`
+	Powered                types.Bool     `tfsdk:"powered"`
+	ServiceURI             types.String   `tfsdk:"service_uri"`
+	ServiceHost            types.String   `tfsdk:"service_host"`
+	ServicePort            types.String   `tfsdk:"service_port"`
+	ServiceUsername        types.String   `tfsdk:"service_username"`
+	ServicePassword        types.String   `tfsdk:"service_password"`
+	State                  types.String   `tfsdk:"state"`
+	TerminationProtection  types.Bool     `tfsdk:"termination_protection"`
+	Title                  types.String   `tfsdk:"title"`
+	Type                   types.String   `tfsdk:"type"`
+	Zone                   types.String   `tfsdk:"zone"`
+	PrimaryDatabase        types.String   `tfsdk:"primary_database"`
+	Properties             types.List     `tfsdk:"properties"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
 }
 
 type databaseComponentModel struct {
@@ -66,7 +68,9 @@ type databaseNodeStateModel struct {
 	State types.String `tfsdk:"state"`
 }
 
-func defineCommonAttributesAndBlocks(s *schema.Schema, dbType upcloud.ManagedDatabaseServiceType) {
+var databaseIdentity = utils.UUIDIdentity("managed database")
+
+func defineCommonAttributesAndBlocks(ctx context.Context, s *schema.Schema, dbType upcloud.ManagedDatabaseServiceType) {
 	planDescription := fmt.Sprintf("Service plan to use. This determines how much resources the instance will have. You can list available plans with `upctl database plans %s`.", dbType)
 	additionalDiskDescription := "Additional disk space in GiB. Note that changes in additional disk space might require disk maintenance. This pending maintenance blocks some operations, such as version upgrades, until the maintenance is completed."
 	if dbType == upcloud.ManagedDatabaseServiceTypeValkey {
@@ -269,6 +273,7 @@ func defineCommonAttributesAndBlocks(s *schema.Schema, dbType upcloud.ManagedDat
 		},
 	}
 	s.Blocks["properties"] = properties.GetBlock(dbType)
+	s.Blocks["timeouts"] = utils.TimeoutsBlock(ctx)
 }
 
 func networksFromPlan(ctx context.Context, data *databaseCommonModel) ([]upcloud.ManagedDatabaseNetwork, diag.Diagnostics) {
@@ -0,0 +1,121 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &databaseListResource{}
+	_ list.ListResourceWithConfigure = &databaseListResource{}
+)
+
+func NewMySQLListResource() list.ListResource {
+	return &databaseListResource{serviceType: upcloud.ManagedDatabaseServiceTypeMySQL, typeName: "mysql", displayType: "MySQL"}
+}
+
+func NewOpenSearchListResource() list.ListResource {
+	return &databaseListResource{serviceType: upcloud.ManagedDatabaseServiceTypeOpenSearch, typeName: "opensearch", displayType: "OpenSearch"}
+}
+
+func NewPostgresListResource() list.ListResource {
+	return &databaseListResource{serviceType: upcloud.ManagedDatabaseServiceTypePostgreSQL, typeName: "postgresql", displayType: "PostgreSQL"}
+}
+
+func NewValkeyListResource() list.ListResource {
+	return &databaseListResource{serviceType: upcloud.ManagedDatabaseServiceTypeValkey, typeName: "valkey", displayType: "Valkey"}
+}
+
+// databaseListResource lists managed database services of a single service type.
+type databaseListResource struct {
+	client      *service.Service
+	serviceType upcloud.ManagedDatabaseServiceType
+	typeName    string
+	displayType string
+}
+
+func (r *databaseListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_managed_database_" + r.typeName
+}
+
+// Configure adds the provider configured client to the list resource.
+func (r *databaseListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = utils.GetClientFromProviderData(req.ProviderData)
+}
+
+type databaseListModel struct {
+	Zone   types.String `tfsdk:"zone"`
+	Labels types.Map    `tfsdk:"labels"`
+}
+
+func (r *databaseListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resource := fmt.Sprintf("%s services", r.displayType)
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Lists %s managed database services, e.g., for generating import blocks with `terraform query`.", r.displayType),
+		Attributes: map[string]schema.Attribute{
+			"zone":   utils.ListResourceZoneAttribute(resource),
+			"labels": utils.ListResourceLabelsAttribute(resource),
+		},
+	}
+}
+
+func (r *databaseListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data databaseListModel
+	diags := utils.GetListResourceConfig(ctx, req.Config, &data)
+
+	labels, labelsDiags := utils.ListResourceLabelsFilter(ctx, data.Labels)
+	diags.Append(labelsDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	dbs, err := r.client.GetManagedDatabases(ctx, &request.GetManagedDatabasesRequest{})
+	if err != nil {
+		diags.AddError(
+			"Unable to list managed databases",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, db := range dbs {
+			if db.Type != r.serviceType {
+				continue
+			}
+
+			if !data.Zone.IsNull() && db.Zone != data.Zone.ValueString() {
+				continue
+			}
+
+			if !utils.LabelsMatch(labels, db.Labels) {
+				continue
+			}
+
+			result := utils.NewListResult(ctx, req, databaseIdentity, db.UUID, db.Name)
+			if req.IncludeResource {
+				result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("name"), db.Name)...)
+				result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("title"), db.Title)...)
+				result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("zone"), db.Zone)...)
+				result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("plan"), db.Plan)...)
+				result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("labels"), utils.LabelsSliceToMap(db.Labels))...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
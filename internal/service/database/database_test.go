@@ -3,6 +3,8 @@ package database
 import (
 	"context"
 	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -150,3 +152,151 @@ func TestSetPropertyValue(t *testing.T) {
 		})
 	}
 }
+
+func TestSessionFilter(t *testing.T) {
+	tests := []struct {
+		name          string
+		filter        sessionsFilterModel
+		username      string
+		database      string
+		state         string
+		queryDuration time.Duration
+		expected      bool
+	}{
+		{
+			name:          "no filters",
+			filter:        sessionsFilterModel{},
+			username:      "upadmin",
+			database:      "defaultdb",
+			state:         "idle",
+			queryDuration: 0,
+			expected:      true,
+		},
+		{
+			name:          "matching filters",
+			filter:        sessionsFilterModel{Username: types.StringValue("upadmin"), Database: types.StringValue("defaultdb"), State: types.StringValue("active"), MinQueryDuration: types.StringValue("1s")},
+			username:      "upadmin",
+			database:      "defaultdb",
+			state:         "active",
+			queryDuration: time.Second,
+			expected:      true,
+		},
+		{
+			name:          "different username",
+			filter:        sessionsFilterModel{Username: types.StringValue("upadmin")},
+			username:      "app",
+			database:      "defaultdb",
+			state:         "active",
+			queryDuration: time.Second,
+			expected:      false,
+		},
+		{
+			name:          "different database",
+			filter:        sessionsFilterModel{Database: types.StringValue("defaultdb")},
+			username:      "upadmin",
+			database:      "app",
+			state:         "active",
+			queryDuration: time.Second,
+			expected:      false,
+		},
+		{
+			name:          "different state",
+			filter:        sessionsFilterModel{State: types.StringValue("active")},
+			username:      "upadmin",
+			database:      "defaultdb",
+			state:         "idle",
+			queryDuration: time.Second,
+			expected:      false,
+		},
+		{
+			name:          "too short query duration",
+			filter:        sessionsFilterModel{MinQueryDuration: types.StringValue("1m")},
+			username:      "upadmin",
+			database:      "defaultdb",
+			state:         "active",
+			queryDuration: 59 * time.Second,
+			expected:      false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filter, err := newSessionFilter(test.filter)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, filter.matches(test.username, test.database, test.state, test.queryDuration))
+		})
+	}
+
+	_, err := newSessionFilter(sessionsFilterModel{MinQueryDuration: types.StringValue("1 minute")})
+	assert.Error(t, err)
+}
+
+func TestOpenSearchAPIDo(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "upadmin" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/_index_template/logs":
+			_, _ = w.Write([]byte(`{"index_templates": [{"name": "logs", "index_template": {"index_patterns": ["logs-*"]}}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error": "not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	api := &openSearchAPI{
+		baseURL:  server.URL,
+		username: "upadmin",
+		password: "secret",
+		client:   server.Client(),
+	}
+
+	var templates openSearchIndexTemplatesResponse
+	err := api.do(context.Background(), http.MethodGet, "/_index_template/logs", nil, &templates)
+	assert.NoError(t, err)
+	assert.Len(t, templates.IndexTemplates, 1)
+	assert.Equal(t, "logs", templates.IndexTemplates[0].Name)
+
+	template, err := openSearchDocumentFromAPI("", templates.IndexTemplates[0].IndexTemplate, normalizeOpenSearchIndexTemplate)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"index_patterns":["logs-*"]}`, template)
+
+	err = api.do(context.Background(), http.MethodGet, "/_index_template/metrics", nil, &templates)
+	assert.True(t, isOpenSearchNotFoundError(err))
+
+	api.password = "invalid"
+	err = api.do(context.Background(), http.MethodGet, "/_index_template/logs", nil, &templates)
+	assert.Error(t, err)
+	assert.False(t, isOpenSearchNotFoundError(err))
+}
+
+func TestOpenSearchDocumentFromAPI(t *testing.T) {
+	fromAPI := []byte(`{
+		"index_patterns": ["logs-*"],
+		"template": {"settings": {"index": {"number_of_replicas": "1", "refresh_interval": "30s"}}},
+		"composed_of": []
+	}`)
+
+	// Values added by the API are ignored.
+	current := `{"index_patterns":["logs-*"],"template":{"settings":{"number_of_replicas":1,"index.refresh_interval":"30s"}}}`
+	document, err := openSearchDocumentFromAPI(current, fromAPI, normalizeOpenSearchIndexTemplate)
+	assert.NoError(t, err)
+	assert.Equal(t, current, document)
+
+	// Changed values are detected.
+	current = `{"index_patterns":["logs-*"],"template":{"settings":{"number_of_replicas":2}}}`
+	document, err = openSearchDocumentFromAPI(current, fromAPI, normalizeOpenSearchIndexTemplate)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"composed_of":[],"index_patterns":["logs-*"],"template":{"settings":{"index":{"number_of_replicas":"1","refresh_interval":"30s"}}}}`, document)
+
+	// Removed array items are detected.
+	current = `{"index_patterns":["logs-*","metrics-*"]}`
+	document, err = openSearchDocumentFromAPI(current, fromAPI, nil)
+	assert.NoError(t, err)
+	assert.NotEqual(t, current, document)
+}
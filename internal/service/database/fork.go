@@ -0,0 +1,90 @@
+package database
+
+import (
+	"context"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	validatorutil "github.com/UpCloudLtd/terraform-provider-upcloud/internal/validator"
+)
+
+// Create-only properties used to create the service as a copy of another service. These are not included in the service type properties, as they can not be read or modified after the service has been created.
+const (
+	forkServicePropertyKey            upcloud.ManagedDatabasePropertyKey = "service_to_fork_from"
+	forkRecoveryTargetTimePropertyKey upcloud.ManagedDatabasePropertyKey = "recovery_target_time"
+)
+
+// forkLimitationsDescription is appended to the descriptions of the service types that support the fork block.
+const forkLimitationsDescription = "Services can be created as forks of existing services with the `fork` block. Creating a service as a read replica of an existing service is not supported, as the UpCloud API does not provide read replicas as separate services. To restore a specific backup, use the `backup_time` of the backup as `fork.recovery_target_time`."
+
+type databaseForkModel struct {
+	Service            types.String `tfsdk:"service"`
+	RecoveryTargetTime types.String `tfsdk:"recovery_target_time"`
+}
+
+func forkBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		MarkdownDescription: "Create the service as a fork of an existing service. The fork is an independent copy of the source service data and can be created in a different zone than the source service. The fork settings can only be defined when creating the service: changing them will replace the service. Adding fork settings to an imported service does not replace the service. Read replicas are not supported, as the UpCloud API does not provide read replicas as separate services.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"service": schema.StringAttribute{
+					MarkdownDescription: "UUID of the managed database to fork. The source service must be of the same type.",
+					Required:            true,
+				},
+				"recovery_target_time": schema.StringAttribute{
+					MarkdownDescription: "Restore the data of the source service to the given point in time, e.g. `2026-01-02T15:04:05Z`. The time must not be earlier than the oldest backup of the source service, see `earliest_recovery_time` of `upcloud_managed_database_backups` data source. To restore a specific backup, use the `backup_time` of the backup. Defaults to the latest available state.",
+					Optional:            true,
+					Validators: []validator.String{
+						validatorutil.NewFrameworkStringValidator(validation.IsRFC3339Time),
+					},
+				},
+			},
+		},
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.RequiresReplaceIf(
+				forkChangedRequiresReplaceIfFunc,
+				"Changing the fork settings replaces the service.",
+				"Changing the fork settings replaces the service.",
+			),
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+// forkChangedRequiresReplaceIfFunc requires replace when the fork settings change. The fork settings can not be read from the API, so they are null after import: adding them to the configuration of an imported service does not replace the service.
+func forkChangedRequiresReplaceIfFunc(_ context.Context, req planmodifier.ListRequest, resp *listplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !req.StateValue.IsNull()
+}
+
+func forkProperties(ctx context.Context, fork types.List) (map[upcloud.ManagedDatabasePropertyKey]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if fork.IsNull() || fork.IsUnknown() {
+		return nil, diags
+	}
+
+	var forks []databaseForkModel
+	diags.Append(fork.ElementsAs(ctx, &forks, false)...)
+	if diags.HasError() || len(forks) == 0 {
+		return nil, diags
+	}
+
+	f := forks[0]
+	props := map[upcloud.ManagedDatabasePropertyKey]interface{}{
+		forkServicePropertyKey: f.Service.ValueString(),
+	}
+	if !f.RecoveryTargetTime.IsNull() {
+		props[forkRecoveryTargetTimePropertyKey] = f.RecoveryTargetTime.ValueString()
+	}
+	return props, diags
+}
@@ -0,0 +1,261 @@
+package database
+
+import (
+	"context"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	validatorutil "github.com/UpCloudLtd/terraform-provider-upcloud/internal/validator"
+)
+
+var (
+	_ resource.Resource                = &integrationResource{}
+	_ resource.ResourceWithConfigure   = &integrationResource{}
+	_ resource.ResourceWithImportState = &integrationResource{}
+	_ resource.ResourceWithIdentity    = &integrationResource{}
+)
+
+func NewIntegrationResource() resource.Resource {
+	return &integrationResource{}
+}
+
+type integrationResource struct {
+	client *service.Service
+}
+
+func (r *integrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_managed_database_integration"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *integrationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = utils.GetClientFromProviderData(req.ProviderData)
+}
+
+type integrationModel struct {
+	ID                  types.String `tfsdk:"id"`
+	UUID                types.String `tfsdk:"uuid"`
+	Type                types.String `tfsdk:"type"`
+	SourceService       types.String `tfsdk:"source_service"`
+	DestinationService  types.String `tfsdk:"destination_service"`
+	DestinationEndpoint types.String `tfsdk:"destination_endpoint"`
+	Active              types.Bool   `tfsdk:"active"`
+}
+
+func (r *integrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource represents an integration between a managed database and another managed database or an external endpoint, e.g. for shipping logs of a PostgreSQL service to an OpenSearch service or for exposing metrics to Prometheus. Integrations can not be modified: changing any of the fields will replace the integration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the integration. ID is in {source service UUID}/{integration UUID} format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"uuid": schema.StringAttribute{
+				Description: "UUID of the integration.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the integration: `logs` ships the logs of the source service to the destination OpenSearch service, `metrics` sends the metrics of the source service to the destination service or endpoint, `prometheus` exposes the metrics of the source service to the destination Prometheus endpoint, and `datasource` makes the source service available as a datasource in the destination service.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(upcloud.ManagedDatabaseIntegrationTypeLogs),
+						string(upcloud.ManagedDatabaseIntegrationTypeMetrics),
+						string(upcloud.ManagedDatabaseIntegrationTypePrometheus),
+						string(upcloud.ManagedDatabaseIntegrationTypeDatasource),
+					),
+				},
+			},
+			"source_service": schema.StringAttribute{
+				Description: "UUID of the managed database service the integration is created for.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"destination_service": schema.StringAttribute{
+				Description: "UUID of the managed database service the source service is connected to.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("destination_endpoint")),
+				},
+			},
+			"destination_endpoint": schema.StringAttribute{
+				Description: "URL of the external endpoint the source service is connected to, e.g. a Prometheus or a metrics endpoint.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validatorutil.NewFrameworkStringValidator(validation.IsURLWithHTTPorHTTPS),
+				},
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the integration is active.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func setIntegrationValues(data *integrationModel, integration *upcloud.ManagedDatabaseIntegration) {
+	data.UUID = types.StringValue(integration.UUID)
+	data.Type = types.StringValue(string(integration.Type))
+	data.SourceService = types.StringValue(integration.SourceServiceUUID)
+	data.Active = types.BoolValue(integration.Active)
+
+	data.DestinationService = types.StringNull()
+	if integration.DestinationServiceUUID != "" {
+		data.DestinationService = types.StringValue(integration.DestinationServiceUUID)
+	}
+
+	data.DestinationEndpoint = types.StringNull()
+	if integration.DestinationEndpoint != "" {
+		data.DestinationEndpoint = types.StringValue(integration.DestinationEndpoint)
+	}
+}
+
+func (r *integrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data integrationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	uuid := data.SourceService.ValueString()
+
+	resp.Diagnostics.Append(checkDatabaseIsRunning(ctx, r.client, uuid, "integration", "create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integration, err := r.client.CreateManagedDatabaseIntegration(ctx, &request.CreateManagedDatabaseIntegrationRequest{
+		ServiceUUID:            uuid,
+		Type:                   upcloud.ManagedDatabaseIntegrationType(data.Type.ValueString()),
+		DestinationServiceUUID: data.DestinationService.ValueString(),
+		DestinationEndpoint:    data.DestinationEndpoint.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create managed database integration",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(utils.MarshalID(uuid, integration.UUID))
+
+	setIntegrationValues(&data, integration)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, integrationIdentity, data.ID.ValueString())...)
+}
+
+func (r *integrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data integrationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.ValueString() == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var serviceUUID, uuid string
+	resp.Diagnostics.Append(utils.UnmarshalIDDiag(data.ID.ValueString(), &serviceUUID, &uuid)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integration, err := r.client.GetManagedDatabaseIntegration(ctx, &request.GetManagedDatabaseIntegrationRequest{
+		ServiceUUID: serviceUUID,
+		UUID:        uuid,
+	})
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError(
+				"Unable to read managed database integration details",
+				utils.ErrorDiagnosticDetail(err),
+			)
+		}
+		return
+	}
+
+	setIntegrationValues(&data, integration)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, integrationIdentity, data.ID.ValueString())...)
+}
+
+func (r *integrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes require replace, so Update method is only required to satisfy the interface.
+}
+
+func (r *integrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data integrationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	var serviceUUID, uuid string
+	resp.Diagnostics.Append(utils.UnmarshalIDDiag(data.ID.ValueString(), &serviceUUID, &uuid)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkDatabaseIsRunning(ctx, r.client, serviceUUID, "integration", "delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteManagedDatabaseIntegration(ctx, &request.DeleteManagedDatabaseIntegrationRequest{
+		ServiceUUID: serviceUUID,
+		UUID:        uuid,
+	}); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete managed database integration",
+			utils.ErrorDiagnosticDetail(err),
+		)
+	}
+}
+
+var integrationIdentity = []utils.IdentityAttribute{
+	{Name: "source_service", Description: "UUID of the managed database service the integration is created for."},
+	{Name: "uuid", Description: "UUID of the integration."},
+}
+
+func (r *integrationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(integrationIdentity)
+}
+
+func (r *integrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	utils.ImportStateWithIdentity(ctx, integrationIdentity, req, resp)
+}
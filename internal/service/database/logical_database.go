@@ -2,212 +2,268 @@ package database
 
 import (
 	"context"
-	"fmt"
-	"net/http"
 	"regexp"
 
 	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	validatorutil "github.com/UpCloudLtd/terraform-provider-upcloud/internal/validator"
 )
 
-func ResourceLogicalDatabase() *schema.Resource {
-	return &schema.Resource{
-		Description:   "This resource represents a logical database in managed database",
-		CreateContext: resourceLogicalDatabaseCreate,
-		ReadContext:   resourceLogicalDatabaseRead,
-		DeleteContext: resourceLogicalDatabaseDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-		Schema: schemaLogicalDatabase(),
-	}
+var (
+	_ resource.Resource                = &logicalDatabaseResource{}
+	_ resource.ResourceWithConfigure   = &logicalDatabaseResource{}
+	_ resource.ResourceWithImportState = &logicalDatabaseResource{}
+	_ resource.ResourceWithIdentity    = &logicalDatabaseResource{}
+)
+
+func NewLogicalDatabaseResource() resource.Resource {
+	return &logicalDatabaseResource{}
 }
 
-func schemaLogicalDatabase() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
-		"service": {
-			Description: "Service's UUID for which this user belongs to",
-			Type:        schema.TypeString,
-			Required:    true,
-			ForceNew:    true,
-		},
-		"name": {
-			Description: "Name of the logical database",
-			Type:        schema.TypeString,
-			Required:    true,
-			ForceNew:    true,
-		},
-		"character_set": {
-			Description:      "Default character set for the database (LC_CTYPE)",
-			Type:             schema.TypeString,
-			Optional:         true,
-			Computed:         true,
-			ForceNew:         true,
-			ValidateDiagFunc: validation.ToDiagFunc(validateManagedDatabaseLocale),
-		},
-		"collation": {
-			Description:      "Default collation for the database (LC_COLLATE)",
-			Type:             schema.TypeString,
-			Optional:         true,
-			Computed:         true,
-			ForceNew:         true,
-			ValidateDiagFunc: validation.ToDiagFunc(validateManagedDatabaseLocale),
+type logicalDatabaseResource struct {
+	client *service.Service
+}
+
+func (r *logicalDatabaseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_managed_database_logical_database"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *logicalDatabaseResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = utils.GetClientFromProviderData(req.ProviderData)
+}
+
+type logicalDatabaseModel struct {
+	ID           types.String `tfsdk:"id"`
+	Service      types.String `tfsdk:"service"`
+	Name         types.String `tfsdk:"name"`
+	CharacterSet types.String `tfsdk:"character_set"`
+	Collation    types.String `tfsdk:"collation"`
+}
+
+var validateManagedDatabaseLocale = validation.StringMatch(
+	regexp.MustCompile(`^[a-z]{2}_[A-Z]{2}\.[A-z0-9-]+$`),
+	"invalid locale; must be in form en_US.UTF8 (language_TERRITORY.CODEPOINT)",
+)
+
+func (r *logicalDatabaseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This resource represents a logical database in managed database",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the logical database. ID is in {service UUID}/{name} format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service": schema.StringAttribute{
+				Description: "Service's UUID for which this user belongs to",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the logical database",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"character_set": schema.StringAttribute{
+				Description: "Default character set for the database (LC_CTYPE)",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validatorutil.NewFrameworkStringValidator(validateManagedDatabaseLocale),
+				},
+			},
+			"collation": schema.StringAttribute{
+				Description: "Default collation for the database (LC_COLLATE)",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validatorutil.NewFrameworkStringValidator(validateManagedDatabaseLocale),
+				},
+			},
 		},
 	}
 }
 
-func resourceLogicalDatabaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*service.Service)
+func setLogicalDatabaseValues(data *logicalDatabaseModel, ldb *upcloud.ManagedDatabaseLogicalDatabase) {
+	data.Name = types.StringValue(ldb.Name)
+	data.CharacterSet = types.StringValue(ldb.LCCType)
+	data.Collation = types.StringValue(ldb.LCCollate)
+}
 
-	serviceID := d.Get("service").(string)
-	serviceDetails, err := client.GetManagedDatabase(ctx, &request.GetManagedDatabaseRequest{UUID: serviceID})
-	if err != nil {
-		return diag.FromErr(err)
-	}
-	if !serviceDetails.Powered {
-		return diag.FromErr(fmt.Errorf("cannot create a logical database while managed database %v (%v) is powered off", serviceDetails.Name, serviceID))
+func (r *logicalDatabaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data logicalDatabaseModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if d.HasChanges("character_set", "collation") && serviceDetails.Type != upcloud.ManagedDatabaseServiceTypePostgreSQL {
-		return diag.FromErr(fmt.Errorf("setting character_set or collation is only possible for PostgreSQL service"))
+	uuid := data.Service.ValueString()
+
+	if !data.CharacterSet.IsUnknown() || !data.Collation.IsUnknown() {
+		db, err := r.client.GetManagedDatabase(ctx, &request.GetManagedDatabaseRequest{UUID: uuid})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to read managed database details",
+				utils.ErrorDiagnosticDetail(err),
+			)
+			return
+		}
+
+		if db.Type != upcloud.ManagedDatabaseServiceTypePostgreSQL {
+			resp.Diagnostics.AddError(
+				"Invalid managed database type",
+				"Setting character_set or collation is only possible for PostgreSQL service",
+			)
+			return
+		}
 	}
 
-	serviceDetails, err = client.WaitForManagedDatabaseState(ctx, &request.WaitForManagedDatabaseStateRequest{
-		UUID:         serviceID,
-		DesiredState: upcloud.ManagedDatabaseStateRunning,
-	})
-	if err != nil {
-		return diag.FromErr(err)
+	resp.Diagnostics.Append(checkDatabaseIsRunning(ctx, r.client, uuid, "logical database", "create")...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	_, err = client.CreateManagedDatabaseLogicalDatabase(ctx, &request.CreateManagedDatabaseLogicalDatabaseRequest{
-		ServiceUUID: serviceID,
-		Name:        d.Get("name").(string),
-		LCCType:     d.Get("character_set").(string),
-		LCCollate:   d.Get("collation").(string),
+
+	ldb, err := r.client.CreateManagedDatabaseLogicalDatabase(ctx, &request.CreateManagedDatabaseLogicalDatabaseRequest{
+		ServiceUUID: uuid,
+		Name:        data.Name.ValueString(),
+		LCCType:     data.CharacterSet.ValueString(),
+		LCCollate:   data.Collation.ValueString(),
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		resp.Diagnostics.AddError(
+			"Unable to create managed database logical database",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
 	}
 
-	d.SetId(utils.MarshalID(serviceID, d.Get("name").(string)))
-
-	tflog.Info(ctx, "managed database logical database created", map[string]interface{}{
-		"service_name": serviceDetails.Name, "name": d.Get("name").(string), "service_uuid": serviceID,
-	})
+	data.ID = types.StringValue(utils.MarshalID(uuid, data.Name.ValueString()))
 
-	return resourceLogicalDatabaseRead(ctx, d, meta)
+	setLogicalDatabaseValues(&data, ldb)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, logicalDatabaseIdentity, data.ID.ValueString())...)
 }
 
-func resourceLogicalDatabaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*service.Service)
+func (r *logicalDatabaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data logicalDatabaseModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
-	var serviceID, name string
-	if err := utils.UnmarshalID(d.Id(), &serviceID, &name); err != nil {
-		return diag.FromErr(err)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	serviceDetails, err := client.GetManagedDatabase(ctx, &request.GetManagedDatabaseRequest{UUID: serviceID})
-	if err != nil {
-		return utils.HandleResourceError(d.Get("name").(string), d, err)
+	if data.ID.ValueString() == "" {
+		resp.State.RemoveResource(ctx)
+		return
 	}
 
-	// If service UUID is not set already set it based on the Id. This is the case for example when importing existing user.
-	if _, ok := d.GetOk("service"); !ok {
-		err := d.Set("service", serviceID)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	var uuid, name string
+	resp.Diagnostics.Append(utils.UnmarshalIDDiag(data.ID.ValueString(), &uuid, &name)...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	ldbs, err := client.GetManagedDatabaseLogicalDatabases(ctx, &request.GetManagedDatabaseLogicalDatabasesRequest{
-		ServiceUUID: serviceID,
+	data.Service = types.StringValue(uuid)
+
+	ldbs, err := r.client.GetManagedDatabaseLogicalDatabases(ctx, &request.GetManagedDatabaseLogicalDatabasesRequest{
+		ServiceUUID: uuid,
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		if utils.IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError(
+				"Unable to read managed database logical database details",
+				utils.ErrorDiagnosticDetail(err),
+			)
+		}
+		return
 	}
-	var details *upcloud.ManagedDatabaseLogicalDatabase
-	for i, ldb := range ldbs {
-		if ldb.Name == name {
-			details = &ldbs[i]
+
+	var ldb *upcloud.ManagedDatabaseLogicalDatabase
+	for i := range ldbs {
+		if ldbs[i].Name == name {
+			ldb = &ldbs[i]
 			break
 		}
 	}
-	if details == nil {
-		// We need to manually construct the error here as the actual `err` value is not relevant here
-		return utils.HandleResourceError(d.Get("name").(string), d, &upcloud.Problem{Status: http.StatusNotFound})
+	if ldb == nil {
+		resp.State.RemoveResource(ctx)
+		return
 	}
 
-	tflog.Info(ctx, "managed database logical database read", map[string]interface{}{
-		"service_name": serviceDetails.Name, "name": name, "service_uuid": serviceID,
-	})
+	setLogicalDatabaseValues(&data, ldb)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, logicalDatabaseIdentity, data.ID.ValueString())...)
+}
 
-	return copyLogicalDatabaseDetailsToResource(d, details)
+func (r *logicalDatabaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes require replace, so Update method is only required to satisfy the interface.
 }
 
-func resourceLogicalDatabaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*service.Service)
+func (r *logicalDatabaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data logicalDatabaseModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
-	serviceID := d.Get("service").(string)
-	serviceDetails, err := client.GetManagedDatabase(ctx, &request.GetManagedDatabaseRequest{UUID: serviceID})
-	if err != nil {
-		return diag.FromErr(err)
-	}
-	if !serviceDetails.Powered {
-		return diag.FromErr(fmt.Errorf("cannot delete a logical database while managed database %v (%v) is powered off", serviceDetails.Name, serviceID))
-	}
+	var uuid, name string
+	resp.Diagnostics.Append(utils.UnmarshalIDDiag(data.ID.ValueString(), &uuid, &name)...)
 
-	var name string
-	if err := utils.UnmarshalID(d.Id(), &serviceID, &name); err != nil {
-		return diag.FromErr(err)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	serviceDetails, err = client.WaitForManagedDatabaseState(ctx, &request.WaitForManagedDatabaseStateRequest{
-		UUID:         serviceID,
-		DesiredState: upcloud.ManagedDatabaseStateRunning,
-	})
-	if err != nil {
-		return diag.FromErr(err)
+
+	resp.Diagnostics.Append(checkDatabaseIsRunning(ctx, r.client, uuid, "logical database", "delete")...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	err = client.DeleteManagedDatabaseLogicalDatabase(ctx, &request.DeleteManagedDatabaseLogicalDatabaseRequest{
-		ServiceUUID: serviceID,
+	if err := r.client.DeleteManagedDatabaseLogicalDatabase(ctx, &request.DeleteManagedDatabaseLogicalDatabaseRequest{
+		ServiceUUID: uuid,
 		Name:        name,
-	})
-	if err != nil {
-		return diag.FromErr(err)
+	}); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete managed database logical database",
+			utils.ErrorDiagnosticDetail(err),
+		)
 	}
-	tflog.Info(ctx, "managed database logical database deleted", map[string]interface{}{
-		"service_name": serviceDetails.Name, "name": name, "service_uuid": serviceID,
-	})
-
-	return nil
 }
 
-func copyLogicalDatabaseDetailsToResource(d *schema.ResourceData, details *upcloud.ManagedDatabaseLogicalDatabase) diag.Diagnostics {
-	setFields := []struct {
-		name string
-		val  interface{}
-	}{
-		{name: "name", val: details.Name},
-		{name: "character_set", val: details.LCCType},
-		{name: "collation", val: details.LCCollate},
-	}
-
-	for _, sf := range setFields {
-		if err := d.Set(sf.name, sf.val); err != nil {
-			return diag.FromErr(err)
-		}
-	}
+var logicalDatabaseIdentity = []utils.IdentityAttribute{
+	{Name: "service", Description: "UUID of the managed database service."},
+	{Name: "name", Description: "Name of the logical database."},
+}
 
-	return nil
+func (r *logicalDatabaseResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(logicalDatabaseIdentity)
 }
 
-var validateManagedDatabaseLocale = validation.StringMatch(
-	regexp.MustCompile(`^[a-z]{2}_[A-Z]{2}\.[A-z0-9-]+$`),
-	"invalid locale; must be in form en_US.UTF8 (language_TERRITORY.CODEPOINT)",
-)
+func (r *logicalDatabaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	utils.ImportStateWithIdentity(ctx, logicalDatabaseIdentity, req, resp)
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -18,6 +17,7 @@ var (
 	_ resource.Resource                = &mysqlResource{}
 	_ resource.ResourceWithConfigure   = &mysqlResource{}
 	_ resource.ResourceWithImportState = &mysqlResource{}
+	_ resource.ResourceWithIdentity    = &mysqlResource{}
 )
 
 func NewMySQLResource() resource.Resource {
@@ -37,54 +37,77 @@ func (r *mysqlResource) Configure(_ context.Context, req resource.ConfigureReque
 	r.client, resp.Diagnostics = utils.GetClientFromProviderData(req.ProviderData)
 }
 
-func (r *mysqlResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+type mysqlModel struct {
+	databaseCommonModel
+
+	Fork types.List `tfsdk:"fork"`
+}
+
+func (r *mysqlResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: serviceDescription("MySQL"),
+		MarkdownDescription: serviceDescription("MySQL") + " " + forkLimitationsDescription,
 		Attributes:          map[string]schema.Attribute{},
-		Blocks:              map[string]schema.Block{},
+		Blocks: map[string]schema.Block{
+			"fork": forkBlock(),
+		},
 	}
 
-	defineCommonAttributesAndBlocks(&resp.Schema, upcloud.ManagedDatabaseServiceTypeMySQL)
+	defineCommonAttributesAndBlocks(ctx, &resp.Schema, upcloud.ManagedDatabaseServiceTypeMySQL)
 }
 
 func (r *mysqlResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data databaseCommonModel
+	var data mysqlModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, diags := utils.TimeoutContext(ctx, data.Timeouts, utils.TimeoutCreate)
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	data.Type = types.StringValue(string(upcloud.ManagedDatabaseServiceTypeMySQL))
 
-	_, diags := createDatabase(ctx, &data, r.client)
+	forkProps, diags := forkProperties(ctx, data.Fork)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, diags = createDatabase(ctx, &data.databaseCommonModel, forkProps, r.client)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, databaseIdentity, data.ID.ValueString())...)
 }
 
 func (r *mysqlResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data databaseCommonModel
+	var data mysqlModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	db, diags := readDatabase(ctx, &data, r.client, resp.State.RemoveResource)
+	db, diags := readDatabase(ctx, &data.databaseCommonModel, r.client, resp.State.RemoveResource)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() || db == nil {
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, databaseIdentity, data.ID.ValueString())...)
 }
 
 func (r *mysqlResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan, state databaseCommonModel
+	var plan, state mysqlModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
@@ -92,22 +115,40 @@ func (r *mysqlResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	_, _, d := updateDatabase(ctx, &state, &plan, r.client)
+	ctx, cancel, diags := utils.TimeoutContext(ctx, plan.Timeouts, utils.TimeoutUpdate)
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, d := updateDatabase(ctx, &state.databaseCommonModel, &plan.databaseCommonModel, r.client)
 	resp.Diagnostics.Append(d...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	_, diags := readDatabase(ctx, &plan, r.client, resp.State.RemoveResource)
+	_, diags = readDatabase(ctx, &plan.databaseCommonModel, r.client, resp.State.RemoveResource)
 	resp.Diagnostics.Append(diags...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *mysqlResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data databaseCommonModel
+	var data mysqlModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := utils.TimeoutContext(ctx, data.Timeouts, utils.TimeoutDelete)
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := r.client.DeleteManagedDatabase(ctx, &request.DeleteManagedDatabaseRequest{
 		UUID: data.ID.ValueString(),
 	}); err != nil {
@@ -121,6 +162,10 @@ func (r *mysqlResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	resp.Diagnostics.Append(waitForDatabaseToBeDeleted(ctx, r.client, data.ID.ValueString())...)
 }
 
+func (r *mysqlResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(databaseIdentity)
+}
+
 func (r *mysqlResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, databaseIdentity, req, resp)
 }
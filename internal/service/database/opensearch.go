@@ -9,7 +9,6 @@ import (
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -19,6 +18,7 @@ var (
 	_ resource.Resource                = &opensearchResource{}
 	_ resource.ResourceWithConfigure   = &opensearchResource{}
 	_ resource.ResourceWithImportState = &opensearchResource{}
+	_ resource.ResourceWithIdentity    = &opensearchResource{}
 )
 
 func NewOpenSearchResource() resource.Resource {
@@ -45,7 +45,7 @@ type opensearchModel struct {
 	ExtendedAccessControl types.Bool `tfsdk:"extended_access_control"`
 }
 
-func (r *opensearchResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *opensearchResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: serviceDescription("OpenSearch"),
 		Attributes: map[string]schema.Attribute{
@@ -63,7 +63,7 @@ func (r *opensearchResource) Schema(_ context.Context, _ resource.SchemaRequest,
 		Blocks: map[string]schema.Block{},
 	}
 
-	defineCommonAttributesAndBlocks(&resp.Schema, upcloud.ManagedDatabaseServiceTypeOpenSearch)
+	defineCommonAttributesAndBlocks(ctx, &resp.Schema, upcloud.ManagedDatabaseServiceTypeOpenSearch)
 }
 
 func readAccessControl(ctx context.Context, client *service.Service, data *opensearchModel) (diags diag.Diagnostics) {
@@ -116,9 +116,16 @@ func (r *opensearchResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	ctx, cancel, diags := utils.TimeoutContext(ctx, data.Timeouts, utils.TimeoutCreate)
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	data.Type = types.StringValue(string(upcloud.ManagedDatabaseServiceTypeOpenSearch))
 
-	_, diags := createDatabase(ctx, &data.databaseCommonModel, r.client)
+	_, diags = createDatabase(ctx, &data.databaseCommonModel, nil, r.client)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -126,6 +133,7 @@ func (r *opensearchResource) Create(ctx context.Context, req resource.CreateRequ
 
 	resp.Diagnostics.Append(updateAccessControlIfNeeded(ctx, r.client, &opensearchModel{}, &data)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, databaseIdentity, data.ID.ValueString())...)
 }
 
 func (r *opensearchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -144,6 +152,7 @@ func (r *opensearchResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	resp.Diagnostics.Append(readAccessControl(ctx, r.client, &data)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, databaseIdentity, data.ID.ValueString())...)
 }
 
 func (r *opensearchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -155,6 +164,13 @@ func (r *opensearchResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	ctx, cancel, diags := utils.TimeoutContext(ctx, plan.Timeouts, utils.TimeoutUpdate)
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(updateAccessControlIfNeeded(ctx, r.client, &state, &plan)...)
 
 	_, _, d := updateDatabase(ctx, &state.databaseCommonModel, &plan.databaseCommonModel, r.client)
@@ -163,7 +179,7 @@ func (r *opensearchResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	_, diags := readDatabase(ctx, &plan.databaseCommonModel, r.client, resp.State.RemoveResource)
+	_, diags = readDatabase(ctx, &plan.databaseCommonModel, r.client, resp.State.RemoveResource)
 	resp.Diagnostics.Append(diags...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -173,6 +189,17 @@ func (r *opensearchResource) Delete(ctx context.Context, req resource.DeleteRequ
 	var data opensearchModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := utils.TimeoutContext(ctx, data.Timeouts, utils.TimeoutDelete)
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := r.client.DeleteManagedDatabase(ctx, &request.DeleteManagedDatabaseRequest{
 		UUID: data.ID.ValueString(),
 	}); err != nil {
@@ -186,6 +213,10 @@ func (r *opensearchResource) Delete(ctx context.Context, req resource.DeleteRequ
 	resp.Diagnostics.Append(waitForDatabaseToBeDeleted(ctx, r.client, data.ID.ValueString())...)
 }
 
+func (r *opensearchResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(databaseIdentity)
+}
+
 func (r *opensearchResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, databaseIdentity, req, resp)
 }
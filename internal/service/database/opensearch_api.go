@@ -0,0 +1,193 @@
+package database
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+const (
+	openSearchAPITimeout = 60 * time.Second
+
+	// openSearchAPIConnectivityDescription is included in the descriptions of the resources that are managed through the OpenSearch API.
+	openSearchAPIConnectivityDescription = "The resource is managed by connecting directly to the OpenSearch API of the service with the primary credentials of the service, so the service must be powered on and reachable from the machine running Terraform: if the service is not publicly accessible (`public_access` property), Terraform must be run in a network that has access to the service, and the `ip_filter` property of the service must allow connections from that address."
+)
+
+// openSearchAPI is a minimal client for the REST API of an OpenSearch managed database. It is used to manage resources that are not available through the UpCloud API, e.g. index templates and ISM policies.
+type openSearchAPI struct {
+	baseURL  string
+	username string
+	password string
+	client   *http.Client
+}
+
+// openSearchAPIError is returned when the OpenSearch API responds with a non-successful status code.
+type openSearchAPIError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *openSearchAPIError) Error() string {
+	return fmt.Sprintf("OpenSearch API responded with status %d: %s", e.StatusCode, e.Body)
+}
+
+func isOpenSearchNotFoundError(err error) bool {
+	var apiErr *openSearchAPIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// newOpenSearchAPI waits for the OpenSearch service to be running and returns a client that uses the service's primary credentials.
+func newOpenSearchAPI(ctx context.Context, client *service.Service, serviceUUID, subject, action string) (*openSearchAPI, diag.Diagnostics) {
+	diags := checkDatabaseIsRunning(ctx, client, serviceUUID, subject, action)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	db, err := client.GetManagedDatabase(ctx, &request.GetManagedDatabaseRequest{UUID: serviceUUID})
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Unable to read managed database details during %s %s", subject, action),
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return nil, diags
+	}
+
+	if db.Type != upcloud.ManagedDatabaseServiceTypeOpenSearch {
+		diags.AddError(
+			"Invalid managed database type",
+			fmt.Sprintf("Managed database service with UUID %s is %s service, but OpenSearch %ss can only be managed in OpenSearch services", serviceUUID, db.Type, subject),
+		)
+		return nil, diags
+	}
+
+	baseURL := url.URL{
+		Scheme: "https",
+		Host:   net.JoinHostPort(db.ServiceURIParams.Host, db.ServiceURIParams.Port),
+	}
+
+	return &openSearchAPI{
+		baseURL:  baseURL.String(),
+		username: db.ServiceURIParams.User,
+		password: db.ServiceURIParams.Password,
+		client:   &http.Client{Timeout: openSearchAPITimeout},
+	}, diags
+}
+
+// do sends a request to the OpenSearch API. If body is not nil, it is sent as JSON. If out is not nil, the response body is decoded into it.
+func (c *openSearchAPI) do(ctx context.Context, method, path string, body, out interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.username, c.password)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("unable to connect to the OpenSearch API of the service at %s, check that the service is reachable from the machine running Terraform and that the IP filter of the service allows the connection: %w", c.baseURL, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return &openSearchAPIError{StatusCode: resp.StatusCode, Body: string(respBody)}
+	}
+
+	if out != nil {
+		return json.Unmarshal(respBody, out)
+	}
+	return nil
+}
+
+// openSearchDocumentFromAPI returns the document to store in the state. If the document returned by the API contains all
+// the values defined in the current document, the current document is returned as is, as the API adds default values and
+// metadata to the stored documents. Otherwise, the normalized document returned by the API is returned to show the
+// changes made outside of Terraform. The normalize function, if defined, is applied to both documents before comparing
+// them.
+func openSearchDocumentFromAPI(current string, fromAPI []byte, normalize func(interface{}) interface{}) (string, error) {
+	var remote interface{}
+	if err := json.Unmarshal(fromAPI, &remote); err != nil {
+		return "", err
+	}
+
+	if current != "" {
+		var local interface{}
+		if err := json.Unmarshal([]byte(current), &local); err == nil {
+			if normalize != nil {
+				local, remote = normalize(local), normalize(remote)
+			}
+			if openSearchJSONContains(remote, local) {
+				return current, nil
+			}
+		}
+	}
+
+	b, err := json.Marshal(remote)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// openSearchJSONContains reports whether actual contains all values of expected. Objects in actual may contain
+// additional keys, but arrays must have the same length.
+func openSearchJSONContains(actual, expected interface{}) bool {
+	switch e := expected.(type) {
+	case map[string]interface{}:
+		a, ok := actual.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range e {
+			if v == nil {
+				continue
+			}
+			if !openSearchJSONContains(a[k], v) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		a, ok := actual.([]interface{})
+		if !ok || len(a) != len(e) {
+			return false
+		}
+		for i := range e {
+			if !openSearchJSONContains(a[i], e[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return actual == expected
+	}
+}
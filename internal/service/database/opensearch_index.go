@@ -0,0 +1,345 @@
+package database
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	openSearchIndexNumberOfShardsSetting   = "index.number_of_shards"
+	openSearchIndexNumberOfReplicasSetting = "index.number_of_replicas"
+	openSearchIndexRefreshIntervalSetting  = "index.refresh_interval"
+)
+
+var (
+	_ resource.Resource                = &openSearchIndexResource{}
+	_ resource.ResourceWithConfigure   = &openSearchIndexResource{}
+	_ resource.ResourceWithImportState = &openSearchIndexResource{}
+	_ resource.ResourceWithIdentity    = &openSearchIndexResource{}
+)
+
+func NewOpenSearchIndexResource() resource.Resource {
+	return &openSearchIndexResource{}
+}
+
+type openSearchIndexResource struct {
+	client *service.Service
+}
+
+func (r *openSearchIndexResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_managed_database_opensearch_index"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *openSearchIndexResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = utils.GetClientFromProviderData(req.ProviderData)
+}
+
+type openSearchIndexResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Service          types.String `tfsdk:"service"`
+	IndexName        types.String `tfsdk:"index_name"`
+	NumberOfShards   types.Int64  `tfsdk:"number_of_shards"`
+	NumberOfReplicas types.Int64  `tfsdk:"number_of_replicas"`
+	RefreshInterval  types.String `tfsdk:"refresh_interval"`
+}
+
+// openSearchIndexSettingsResponse is the response of the get index settings API when using flat_settings and include_defaults parameters.
+type openSearchIndexSettingsResponse map[string]struct {
+	Settings map[string]interface{} `json:"settings"`
+	Defaults map[string]interface{} `json:"defaults"`
+}
+
+func (r *openSearchIndexResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource represents an index and its index-level settings in OpenSearch managed database. Use `upcloud_managed_database_opensearch_indices` data source to list all indices of the service. Deleting the resource deletes the index and all documents stored in it. " + openSearchAPIConnectivityDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the index. ID is in {service UUID}/{index name} format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service": schema.StringAttribute{
+				Description: "UUID of the OpenSearch managed database service the index belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"index_name": schema.StringAttribute{
+				Description: "Name of the index.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"number_of_shards": schema.Int64Attribute{
+				Description: "Number of primary shards of the index. The number of shards can only be defined when creating the index: changing it will replace the index.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"number_of_replicas": schema.Int64Attribute{
+				Description: "Number of replicas each primary shard has.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"refresh_interval": schema.StringAttribute{
+				MarkdownDescription: "How often the index is refreshed to make recent changes visible to search, e.g. `1s` or `30s`. Use `-1` to disable refreshing.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// openSearchIndexSettings returns the configured index settings that should be sent to the API.
+func openSearchIndexSettings(data *openSearchIndexResourceModel, includeStatic bool) map[string]interface{} {
+	settings := make(map[string]interface{})
+	if includeStatic && !data.NumberOfShards.IsNull() && !data.NumberOfShards.IsUnknown() {
+		settings[openSearchIndexNumberOfShardsSetting] = data.NumberOfShards.ValueInt64()
+	}
+	if !data.NumberOfReplicas.IsNull() && !data.NumberOfReplicas.IsUnknown() {
+		settings[openSearchIndexNumberOfReplicasSetting] = data.NumberOfReplicas.ValueInt64()
+	}
+	if !data.RefreshInterval.IsNull() && !data.RefreshInterval.IsUnknown() {
+		settings[openSearchIndexRefreshIntervalSetting] = data.RefreshInterval.ValueString()
+	}
+	return settings
+}
+
+func setOpenSearchIndexValues(ctx context.Context, api *openSearchAPI, data *openSearchIndexResourceModel, name string) (found bool, err error) {
+	var resp openSearchIndexSettingsResponse
+	if err := api.do(ctx, http.MethodGet, "/"+url.PathEscape(name)+"/_settings?flat_settings=true&include_defaults=true", nil, &resp); err != nil {
+		if isOpenSearchNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	index, ok := resp[name]
+	if !ok {
+		return false, nil
+	}
+
+	// Settings are returned as strings, but some of the default settings are lists, so only the settings used by this resource are converted.
+	setting := func(key string) string {
+		value, ok := index.Settings[key]
+		if !ok {
+			value = index.Defaults[key]
+		}
+		s, _ := value.(string)
+		return s
+	}
+
+	shards, err := strconv.ParseInt(setting(openSearchIndexNumberOfShardsSetting), 10, 64)
+	if err != nil {
+		return true, err
+	}
+	replicas, err := strconv.ParseInt(setting(openSearchIndexNumberOfReplicasSetting), 10, 64)
+	if err != nil {
+		return true, err
+	}
+
+	data.IndexName = types.StringValue(name)
+	data.NumberOfShards = types.Int64Value(shards)
+	data.NumberOfReplicas = types.Int64Value(replicas)
+	data.RefreshInterval = types.StringValue(setting(openSearchIndexRefreshIntervalSetting))
+	return true, nil
+}
+
+func (r *openSearchIndexResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data openSearchIndexResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	uuid := data.Service.ValueString()
+	name := data.IndexName.ValueString()
+
+	api, diags := newOpenSearchAPI(ctx, r.client, uuid, "index", "create")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := map[string]interface{}{
+		"settings": openSearchIndexSettings(&data, true),
+	}
+	if err := api.do(ctx, http.MethodPut, "/"+url.PathEscape(name), body, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create OpenSearch index",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(utils.MarshalID(uuid, name))
+
+	if _, err := setOpenSearchIndexValues(ctx, api, &data, name); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read OpenSearch index settings",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, openSearchIndexIdentity, data.ID.ValueString())...)
+}
+
+func (r *openSearchIndexResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data openSearchIndexResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.ValueString() == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var uuid, name string
+	resp.Diagnostics.Append(utils.UnmarshalIDDiag(data.ID.ValueString(), &uuid, &name)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, diags := newOpenSearchAPI(ctx, r.client, uuid, "index", "read")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Service = types.StringValue(uuid)
+
+	found, err := setOpenSearchIndexValues(ctx, api, &data, name)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read OpenSearch index settings",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, openSearchIndexIdentity, data.ID.ValueString())...)
+}
+
+func (r *openSearchIndexResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data openSearchIndexResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.IndexName.ValueString()
+
+	api, diags := newOpenSearchAPI(ctx, r.client, data.Service.ValueString(), "index", "update")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if settings := openSearchIndexSettings(&data, false); len(settings) > 0 {
+		if err := api.do(ctx, http.MethodPut, "/"+url.PathEscape(name)+"/_settings", settings, nil); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to modify OpenSearch index settings",
+				utils.ErrorDiagnosticDetail(err),
+			)
+			return
+		}
+	}
+
+	if _, err := setOpenSearchIndexValues(ctx, api, &data, name); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read OpenSearch index settings",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *openSearchIndexResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data openSearchIndexResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	var uuid, name string
+	resp.Diagnostics.Append(utils.UnmarshalIDDiag(data.ID.ValueString(), &uuid, &name)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, diags := newOpenSearchAPI(ctx, r.client, uuid, "index", "delete")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := api.do(ctx, http.MethodDelete, "/"+url.PathEscape(name), nil, nil); err != nil && !isOpenSearchNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Unable to delete OpenSearch index",
+			utils.ErrorDiagnosticDetail(err),
+		)
+	}
+}
+
+var openSearchIndexIdentity = []utils.IdentityAttribute{
+	{Name: "service", Description: "UUID of the managed database service."},
+	{Name: "index_name", Description: "Name of the index."},
+}
+
+func (r *openSearchIndexResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(openSearchIndexIdentity)
+}
+
+func (r *openSearchIndexResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	utils.ImportStateWithIdentity(ctx, openSearchIndexIdentity, req, resp)
+}
@@ -0,0 +1,311 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	validatorutil "github.com/UpCloudLtd/terraform-provider-upcloud/internal/validator"
+)
+
+var (
+	_ resource.Resource                = &openSearchIndexTemplateResource{}
+	_ resource.ResourceWithConfigure   = &openSearchIndexTemplateResource{}
+	_ resource.ResourceWithImportState = &openSearchIndexTemplateResource{}
+	_ resource.ResourceWithIdentity    = &openSearchIndexTemplateResource{}
+)
+
+func NewOpenSearchIndexTemplateResource() resource.Resource {
+	return &openSearchIndexTemplateResource{}
+}
+
+type openSearchIndexTemplateResource struct {
+	client *service.Service
+}
+
+func (r *openSearchIndexTemplateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_managed_database_opensearch_index_template"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *openSearchIndexTemplateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = utils.GetClientFromProviderData(req.ProviderData)
+}
+
+type openSearchIndexTemplateModel struct {
+	ID       types.String `tfsdk:"id"`
+	Service  types.String `tfsdk:"service"`
+	Name     types.String `tfsdk:"name"`
+	Template types.String `tfsdk:"template"`
+}
+
+type openSearchIndexTemplatesResponse struct {
+	IndexTemplates []struct {
+		Name          string          `json:"name"`
+		IndexTemplate json.RawMessage `json:"index_template"`
+	} `json:"index_templates"`
+}
+
+func (r *openSearchIndexTemplateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource represents an index template in OpenSearch managed database. Index templates define the settings, mappings, and aliases that are applied to new indices matching the template's index patterns. " + openSearchAPIConnectivityDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the index template. ID is in {service UUID}/{name} format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service": schema.StringAttribute{
+				Description: "UUID of the OpenSearch managed database service the index template belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the index template.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"template": schema.StringAttribute{
+				MarkdownDescription: "Index template definition as JSON, e.g. `jsonencode({ index_patterns = [\"logs-*\"], template = { settings = { number_of_replicas = 1 } } })`. See OpenSearch documentation for the available fields. OpenSearch adds default values to the stored template, so only changes to the values defined in the configuration are detected.",
+				Required:            true,
+				Validators: []validator.String{
+					validatorutil.JSONObject(),
+				},
+			},
+		},
+	}
+}
+
+// normalizeOpenSearchIndexTemplate converts the index settings of the template to the format returned by the API, i.e.
+// nested settings with the index prefix and string values, e.g. {"index": {"number_of_replicas": "1"}}.
+func normalizeOpenSearchIndexTemplate(v interface{}) interface{} {
+	document, ok := v.(map[string]interface{})
+	if !ok {
+		return v
+	}
+	template, ok := document["template"].(map[string]interface{})
+	if !ok {
+		return v
+	}
+	settings, ok := template["settings"].(map[string]interface{})
+	if !ok {
+		return v
+	}
+
+	flat := make(map[string]interface{})
+	flattenOpenSearchSettings(flat, "", settings)
+
+	normalized := make(map[string]interface{})
+	for key, value := range flat {
+		if !strings.HasPrefix(key, "index.") {
+			key = "index." + key
+		}
+		parts := strings.Split(key, ".")
+		m := normalized
+		for _, part := range parts[:len(parts)-1] {
+			next, ok := m[part].(map[string]interface{})
+			if !ok {
+				next = make(map[string]interface{})
+				m[part] = next
+			}
+			m = next
+		}
+		m[parts[len(parts)-1]] = value
+	}
+
+	template["settings"] = normalized
+	return document
+}
+
+// flattenOpenSearchSettings adds the settings to flat with dot separated keys. Scalar values are converted to strings as OpenSearch stores settings as strings.
+func flattenOpenSearchSettings(flat map[string]interface{}, prefix string, settings map[string]interface{}) {
+	for key, value := range settings {
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+		switch v := value.(type) {
+		case map[string]interface{}:
+			flattenOpenSearchSettings(flat, key, v)
+		case []interface{}, string, nil:
+			flat[key] = v
+		default:
+			flat[key] = fmt.Sprint(v)
+		}
+	}
+}
+
+func putOpenSearchIndexTemplate(ctx context.Context, api *openSearchAPI, data *openSearchIndexTemplateModel) error {
+	return api.do(ctx, http.MethodPut, "/_index_template/"+url.PathEscape(data.Name.ValueString()), json.RawMessage(data.Template.ValueString()), nil)
+}
+
+func (r *openSearchIndexTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data openSearchIndexTemplateModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	uuid := data.Service.ValueString()
+
+	api, diags := newOpenSearchAPI(ctx, r.client, uuid, "index template", "create")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := putOpenSearchIndexTemplate(ctx, api, &data); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create OpenSearch index template",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(utils.MarshalID(uuid, data.Name.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, openSearchIndexTemplateIdentity, data.ID.ValueString())...)
+}
+
+func (r *openSearchIndexTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data openSearchIndexTemplateModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.ValueString() == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var uuid, name string
+	resp.Diagnostics.Append(utils.UnmarshalIDDiag(data.ID.ValueString(), &uuid, &name)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, diags := newOpenSearchAPI(ctx, r.client, uuid, "index template", "read")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var templates openSearchIndexTemplatesResponse
+	if err := api.do(ctx, http.MethodGet, "/_index_template/"+url.PathEscape(name), nil, &templates); err != nil {
+		if isOpenSearchNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError(
+				"Unable to read OpenSearch index template details",
+				utils.ErrorDiagnosticDetail(err),
+			)
+		}
+		return
+	}
+
+	if len(templates.IndexTemplates) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Service = types.StringValue(uuid)
+	data.Name = types.StringValue(templates.IndexTemplates[0].Name)
+
+	template, err := openSearchDocumentFromAPI(data.Template.ValueString(), templates.IndexTemplates[0].IndexTemplate, normalizeOpenSearchIndexTemplate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to parse OpenSearch index template",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+	data.Template = types.StringValue(template)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, openSearchIndexTemplateIdentity, data.ID.ValueString())...)
+}
+
+func (r *openSearchIndexTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data openSearchIndexTemplateModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, diags := newOpenSearchAPI(ctx, r.client, data.Service.ValueString(), "index template", "update")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := putOpenSearchIndexTemplate(ctx, api, &data); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to modify OpenSearch index template",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *openSearchIndexTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data openSearchIndexTemplateModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	var uuid, name string
+	resp.Diagnostics.Append(utils.UnmarshalIDDiag(data.ID.ValueString(), &uuid, &name)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, diags := newOpenSearchAPI(ctx, r.client, uuid, "index template", "delete")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := api.do(ctx, http.MethodDelete, "/_index_template/"+url.PathEscape(name), nil, nil); err != nil && !isOpenSearchNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Unable to delete OpenSearch index template",
+			utils.ErrorDiagnosticDetail(err),
+		)
+	}
+}
+
+var openSearchIndexTemplateIdentity = []utils.IdentityAttribute{
+	{Name: "service", Description: "UUID of the managed database service."},
+	{Name: "name", Description: "Name of the index template."},
+}
+
+func (r *openSearchIndexTemplateResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(openSearchIndexTemplateIdentity)
+}
+
+func (r *openSearchIndexTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	utils.ImportStateWithIdentity(ctx, openSearchIndexTemplateIdentity, req, resp)
+}
@@ -0,0 +1,268 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	validatorutil "github.com/UpCloudLtd/terraform-provider-upcloud/internal/validator"
+)
+
+var (
+	_ resource.Resource                = &openSearchISMPolicyResource{}
+	_ resource.ResourceWithConfigure   = &openSearchISMPolicyResource{}
+	_ resource.ResourceWithImportState = &openSearchISMPolicyResource{}
+	_ resource.ResourceWithIdentity    = &openSearchISMPolicyResource{}
+)
+
+func NewOpenSearchISMPolicyResource() resource.Resource {
+	return &openSearchISMPolicyResource{}
+}
+
+type openSearchISMPolicyResource struct {
+	client *service.Service
+}
+
+func (r *openSearchISMPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_managed_database_opensearch_ism_policy"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *openSearchISMPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = utils.GetClientFromProviderData(req.ProviderData)
+}
+
+type openSearchISMPolicyModel struct {
+	ID       types.String `tfsdk:"id"`
+	Service  types.String `tfsdk:"service"`
+	PolicyID types.String `tfsdk:"policy_id"`
+	Policy   types.String `tfsdk:"policy"`
+}
+
+type openSearchISMPolicyResponse struct {
+	ID          string          `json:"_id"`
+	SeqNo       int64           `json:"_seq_no"`
+	PrimaryTerm int64           `json:"_primary_term"`
+	Policy      json.RawMessage `json:"policy"`
+}
+
+func (r *openSearchISMPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource represents an Index State Management (ISM) policy in OpenSearch managed database. ISM policies automate index lifecycle operations, e.g. rolling over or deleting indices after a given time. " + openSearchAPIConnectivityDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the ISM policy. ID is in {service UUID}/{policy ID} format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service": schema.StringAttribute{
+				Description: "UUID of the OpenSearch managed database service the ISM policy belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy_id": schema.StringAttribute{
+				Description: "ID of the ISM policy.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy": schema.StringAttribute{
+				MarkdownDescription: "ISM policy definition as JSON, i.e. the value of the `policy` field in OpenSearch ISM API, e.g. `jsonencode({ description = \"Delete old logs\", default_state = \"hot\", states = [...] })`. OpenSearch adds default values and metadata to the stored policy, so only changes to the values defined in the configuration are detected.",
+				Required:            true,
+				Validators: []validator.String{
+					validatorutil.JSONObject(),
+				},
+			},
+		},
+	}
+}
+
+func getOpenSearchISMPolicy(ctx context.Context, api *openSearchAPI, policyID string) (*openSearchISMPolicyResponse, error) {
+	var policy openSearchISMPolicyResponse
+	if err := api.do(ctx, http.MethodGet, "/_plugins/_ism/policies/"+url.PathEscape(policyID), nil, &policy); err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+func openSearchISMPolicyBody(data *openSearchISMPolicyModel) map[string]interface{} {
+	return map[string]interface{}{
+		"policy": json.RawMessage(data.Policy.ValueString()),
+	}
+}
+
+func (r *openSearchISMPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data openSearchISMPolicyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	uuid := data.Service.ValueString()
+
+	api, diags := newOpenSearchAPI(ctx, r.client, uuid, "ISM policy", "create")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := api.do(ctx, http.MethodPut, "/_plugins/_ism/policies/"+url.PathEscape(data.PolicyID.ValueString()), openSearchISMPolicyBody(&data), nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create OpenSearch ISM policy",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(utils.MarshalID(uuid, data.PolicyID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, openSearchISMPolicyIdentity, data.ID.ValueString())...)
+}
+
+func (r *openSearchISMPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data openSearchISMPolicyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.ValueString() == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var uuid, policyID string
+	resp.Diagnostics.Append(utils.UnmarshalIDDiag(data.ID.ValueString(), &uuid, &policyID)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, diags := newOpenSearchAPI(ctx, r.client, uuid, "ISM policy", "read")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := getOpenSearchISMPolicy(ctx, api, policyID)
+	if err != nil {
+		if isOpenSearchNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError(
+				"Unable to read OpenSearch ISM policy details",
+				utils.ErrorDiagnosticDetail(err),
+			)
+		}
+		return
+	}
+
+	data.Service = types.StringValue(uuid)
+	data.PolicyID = types.StringValue(policy.ID)
+
+	document, err := openSearchDocumentFromAPI(data.Policy.ValueString(), policy.Policy, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to parse OpenSearch ISM policy",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+	data.Policy = types.StringValue(document)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, openSearchISMPolicyIdentity, data.ID.ValueString())...)
+}
+
+func (r *openSearchISMPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data openSearchISMPolicyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, diags := newOpenSearchAPI(ctx, r.client, data.Service.ValueString(), "ISM policy", "update")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// OpenSearch uses optimistic concurrency control for ISM policies, so the current sequence number and primary term are required for updating the policy.
+	current, err := getOpenSearchISMPolicy(ctx, api, data.PolicyID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read OpenSearch ISM policy details",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	path := fmt.Sprintf("/_plugins/_ism/policies/%s?if_seq_no=%d&if_primary_term=%d", url.PathEscape(data.PolicyID.ValueString()), current.SeqNo, current.PrimaryTerm)
+	if err := api.do(ctx, http.MethodPut, path, openSearchISMPolicyBody(&data), nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to modify OpenSearch ISM policy",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *openSearchISMPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data openSearchISMPolicyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	var uuid, policyID string
+	resp.Diagnostics.Append(utils.UnmarshalIDDiag(data.ID.ValueString(), &uuid, &policyID)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, diags := newOpenSearchAPI(ctx, r.client, uuid, "ISM policy", "delete")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := api.do(ctx, http.MethodDelete, "/_plugins/_ism/policies/"+url.PathEscape(policyID), nil, nil); err != nil && !isOpenSearchNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Unable to delete OpenSearch ISM policy",
+			utils.ErrorDiagnosticDetail(err),
+		)
+	}
+}
+
+var openSearchISMPolicyIdentity = []utils.IdentityAttribute{
+	{Name: "service", Description: "UUID of the managed database service."},
+	{Name: "policy_id", Description: "ID of the ISM policy."},
+}
+
+func (r *openSearchISMPolicyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(openSearchISMPolicyIdentity)
+}
+
+func (r *openSearchISMPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	utils.ImportStateWithIdentity(ctx, openSearchISMPolicyIdentity, req, resp)
+}
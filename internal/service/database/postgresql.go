@@ -8,7 +8,6 @@ import (
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -18,6 +17,7 @@ var (
 	_ resource.Resource                = &postgresResource{}
 	_ resource.ResourceWithConfigure   = &postgresResource{}
 	_ resource.ResourceWithImportState = &postgresResource{}
+	_ resource.ResourceWithIdentity    = &postgresResource{}
 )
 
 func NewPostgresResource() resource.Resource {
@@ -40,22 +40,25 @@ func (r *postgresResource) Configure(_ context.Context, req resource.ConfigureRe
 type postgresModel struct {
 	databaseCommonModel
 
+	Fork    types.List   `tfsdk:"fork"`
 	SSLMode types.String `tfsdk:"sslmode"`
 }
 
-func (r *postgresResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *postgresResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: serviceDescription("PostgreSQL"),
+		MarkdownDescription: serviceDescription("PostgreSQL") + " " + forkLimitationsDescription,
 		Attributes: map[string]schema.Attribute{
 			"sslmode": schema.StringAttribute{
 				MarkdownDescription: "SSL Connection Mode for PostgreSQL",
 				Computed:            true,
 			},
 		},
-		Blocks: map[string]schema.Block{},
+		Blocks: map[string]schema.Block{
+			"fork": forkBlock(),
+		},
 	}
 
-	defineCommonAttributesAndBlocks(&resp.Schema, upcloud.ManagedDatabaseServiceTypePostgreSQL)
+	defineCommonAttributesAndBlocks(ctx, &resp.Schema, upcloud.ManagedDatabaseServiceTypePostgreSQL)
 }
 
 func (r *postgresResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -66,9 +69,22 @@ func (r *postgresResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	ctx, cancel, diags := utils.TimeoutContext(ctx, data.Timeouts, utils.TimeoutCreate)
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	data.Type = types.StringValue(string(upcloud.ManagedDatabaseServiceTypePostgreSQL))
 
-	db, diags := createDatabase(ctx, &data.databaseCommonModel, r.client)
+	forkProps, diags := forkProperties(ctx, data.Fork)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	db, diags := createDatabase(ctx, &data.databaseCommonModel, forkProps, r.client)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -77,6 +93,7 @@ func (r *postgresResource) Create(ctx context.Context, req resource.CreateReques
 	data.SSLMode = types.StringValue(db.ServiceURIParams.SSLMode)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, databaseIdentity, data.ID.ValueString())...)
 }
 
 func (r *postgresResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -96,6 +113,7 @@ func (r *postgresResource) Read(ctx context.Context, req resource.ReadRequest, r
 	data.SSLMode = types.StringValue(db.ServiceURIParams.SSLMode)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, databaseIdentity, data.ID.ValueString())...)
 }
 
 func (r *postgresResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -107,6 +125,13 @@ func (r *postgresResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	ctx, cancel, diags := utils.TimeoutContext(ctx, plan.Timeouts, utils.TimeoutUpdate)
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	db, newVersion, d := updateDatabase(ctx, &state.databaseCommonModel, &plan.databaseCommonModel, r.client)
 	resp.Diagnostics.Append(d...)
 	if resp.Diagnostics.HasError() {
@@ -121,7 +146,7 @@ func (r *postgresResource) Update(ctx context.Context, req resource.UpdateReques
 		}
 	}
 
-	db, diags := readDatabase(ctx, &plan.databaseCommonModel, r.client, resp.State.RemoveResource)
+	db, diags = readDatabase(ctx, &plan.databaseCommonModel, r.client, resp.State.RemoveResource)
 	resp.Diagnostics.Append(diags...)
 
 	plan.SSLMode = types.StringValue(db.ServiceURIParams.SSLMode)
@@ -133,6 +158,17 @@ func (r *postgresResource) Delete(ctx context.Context, req resource.DeleteReques
 	var data postgresModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := utils.TimeoutContext(ctx, data.Timeouts, utils.TimeoutDelete)
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := r.client.DeleteManagedDatabase(ctx, &request.DeleteManagedDatabaseRequest{
 		UUID: data.ID.ValueString(),
 	}); err != nil {
@@ -146,6 +182,10 @@ func (r *postgresResource) Delete(ctx context.Context, req resource.DeleteReques
 	resp.Diagnostics.Append(waitForDatabaseToBeDeleted(ctx, r.client, data.ID.ValueString())...)
 }
 
+func (r *postgresResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(databaseIdentity)
+}
+
 func (r *postgresResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, databaseIdentity, req, resp)
 }
@@ -2,12 +2,14 @@ package database
 
 import (
 	"context"
+	"crypto/rand"
 	"fmt"
 
 	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -20,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -27,6 +30,7 @@ var (
 	_ resource.Resource                = &databaseUserResource{}
 	_ resource.ResourceWithConfigure   = &databaseUserResource{}
 	_ resource.ResourceWithImportState = &databaseUserResource{}
+	_ resource.ResourceWithIdentity    = &databaseUserResource{}
 )
 
 func NewUserResource() resource.Resource {
@@ -51,6 +55,9 @@ type databaseUserModel struct {
 	Service                 types.String `tfsdk:"service"`
 	Username                types.String `tfsdk:"username"`
 	Password                types.String `tfsdk:"password"`
+	PasswordWO              types.String `tfsdk:"password_wo"`
+	PasswordWOVersion       types.Int64  `tfsdk:"password_wo_version"`
+	PasswordRotationTrigger types.String `tfsdk:"password_rotation_trigger"`
 	Type                    types.String `tfsdk:"type"`
 	Authentication          types.String `tfsdk:"authentication"`
 	PgAccessControl         types.List   `tfsdk:"pg_access_control"`
@@ -82,7 +89,10 @@ func (r *databaseUserResource) Schema(_ context.Context, _ resource.SchemaReques
 	emptyStringList := types.ListValueMust(types.StringType, []attr.Value{})
 
 	resp.Schema = schema.Schema{
-		MarkdownDescription: `This resource represents a user in managed database.`,
+		MarkdownDescription: "This resource represents a user in managed database.\n\n" +
+			"Changing the `password`, `password_wo_version`, or `password_rotation_trigger` rotates the password of the user in place without replacing the user, but the previous password stops working immediately. " +
+			"To rotate credentials without downtime, e.g. for Valkey clients, use two users with the same access control and switch the clients to the other user before rotating its password. " +
+			"Valkey per-user enable/disable, multiple passwords per user, and ACL selectors are not supported by the UpCloud API, and thus can not be managed with this resource.",
 		Attributes: map[string]schema.Attribute{
 			"service": schema.StringAttribute{
 				Description: "Service's UUID for which this user belongs to",
@@ -106,14 +116,36 @@ func (r *databaseUserResource) Schema(_ context.Context, _ resource.SchemaReques
 				},
 			},
 			"password": schema.StringAttribute{
-				Description: "Password for the database user. Defaults to a random value",
-				Optional:    true,
-				Computed:    true,
-				Sensitive:   true,
+				MarkdownDescription: "Password for the database user. Defaults to a random value. The password is stored in the state, unless `password_wo` is used.",
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           true,
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(8, 256),
 				},
 			},
+			"password_wo": schema.StringAttribute{
+				MarkdownDescription: "Password for the database user. This value is write-only: it is not stored in the state and `password` is left empty. To change the password, change the value and increment `password_wo_version`. Requires Terraform 1.11 or later.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(8, 256),
+					stringvalidator.ConflictsWith(path.MatchRoot("password")),
+					stringvalidator.AlsoRequires(path.MatchRoot("password_wo_version")),
+				},
+			},
+			"password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the write-only password. As `password_wo` is not stored in the state, changes to it are not detected. Change this value to update the password of the user to the current `password_wo` value.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password_wo")),
+				},
+			},
+			"password_rotation_trigger": schema.StringAttribute{
+				MarkdownDescription: "Arbitrary value that triggers the rotation of a generated password when changed, e.g. `formatdate(\"YYYY-MM\", timestamp())` for monthly rotation. The user is modified in place with a new random password. Has no effect when `password` or `password_wo` is defined in the configuration.",
+				Optional:            true,
+			},
 			"type": schema.StringAttribute{
 				Description: "Type of the user. Only normal type users can be created",
 				Computed:    true,
@@ -251,7 +283,12 @@ func setDatabaseUserValues(ctx context.Context, data *databaseUserModel, user *u
 	isImport := data.Username.ValueString() == ""
 
 	data.Username = types.StringValue(user.Username)
-	data.Password = types.StringValue(user.Password)
+	// Password is not stored in the state when it is managed with the write-only attribute.
+	if data.PasswordWOVersion.IsNull() {
+		data.Password = types.StringValue(user.Password)
+	} else {
+		data.Password = types.StringNull()
+	}
 	data.Type = types.StringValue(string(user.Type))
 
 	if !data.Authentication.IsNull() || (isImport && user.Authentication != "") {
@@ -429,11 +466,11 @@ func getOpensearchAccessControl(ctx context.Context, data *databaseUserModel) (*
 	}, nil
 }
 
-func checkDatabaseIsRunning(ctx context.Context, client *service.Service, uuid, action string) (diags diag.Diagnostics) {
+func checkDatabaseIsRunning(ctx context.Context, client *service.Service, uuid, subject, action string) (diags diag.Diagnostics) {
 	db, err := client.GetManagedDatabase(ctx, &request.GetManagedDatabaseRequest{UUID: uuid})
 	if err != nil {
 		diags.AddError(
-			fmt.Sprintf("Unable to read managed database details during user %s", action),
+			fmt.Sprintf("Unable to read managed database details during %s %s", subject, action),
 			utils.ErrorDiagnosticDetail(err),
 		)
 		return
@@ -442,7 +479,7 @@ func checkDatabaseIsRunning(ctx context.Context, client *service.Service, uuid,
 	if !db.Powered {
 		diags.AddError(
 			"Managed database service is not powered on",
-			fmt.Sprintf("Managed database service with UUID %s must be powered on to %s users", uuid, action),
+			fmt.Sprintf("Managed database service with UUID %s must be powered on to %s %ss", uuid, action, subject),
 		)
 		return
 	}
@@ -453,7 +490,7 @@ func checkDatabaseIsRunning(ctx context.Context, client *service.Service, uuid,
 	})
 	if err != nil {
 		diags.AddError(
-			fmt.Sprintf("Error waiting for managed database to be running during user %s", action),
+			fmt.Sprintf("Error waiting for managed database to be running during %s %s", subject, action),
 			utils.ErrorDiagnosticDetail(err),
 		)
 	}
@@ -470,17 +507,23 @@ func (r *databaseUserResource) Create(ctx context.Context, req resource.CreateRe
 
 	uuid := data.Service.ValueString()
 
-	resp.Diagnostics.Append(checkDatabaseIsRunning(ctx, r.client, uuid, "create")...)
+	resp.Diagnostics.Append(checkDatabaseIsRunning(ctx, r.client, uuid, "user", "create")...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	data.ID = types.StringValue(utils.MarshalID(uuid, data.Username.ValueString()))
 
+	password, d := getDatabaseUserPassword(ctx, req.Config, &data)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	apiReq := &request.CreateManagedDatabaseUserRequest{
 		ServiceUUID:    uuid,
 		Username:       data.Username.ValueString(),
-		Password:       data.Password.ValueString(),
+		Password:       password,
 		Authentication: upcloud.ManagedDatabaseUserAuthenticationType(data.Authentication.ValueString()),
 	}
 
@@ -511,6 +554,7 @@ func (r *databaseUserResource) Create(ctx context.Context, req resource.CreateRe
 
 	setDatabaseUserValues(ctx, &data, user)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, databaseUserIdentity, data.ID.ValueString())...)
 }
 
 func (r *databaseUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -553,10 +597,32 @@ func (r *databaseUserResource) Read(ctx context.Context, req resource.ReadReques
 
 	setDatabaseUserValues(ctx, &data, user)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, databaseUserIdentity, data.ID.ValueString())...)
+}
+
+// getDatabaseUserPassword returns the password to send to the API: either the write-only password from the configuration or the password from the plan.
+func getDatabaseUserPassword(ctx context.Context, config tfsdk.Config, data *databaseUserModel) (string, diag.Diagnostics) {
+	if data.PasswordWOVersion.IsNull() {
+		return data.Password.ValueString(), nil
+	}
+
+	var password types.String
+	diags := config.GetAttribute(ctx, path.Root("password_wo"), &password)
+	return password.ValueString(), diags
+}
+
+// shouldRotatePassword reports whether a new password should be generated for the user. Generated passwords are rotated
+// when the rotation trigger changes and the password is not defined in the configuration, i.e. it is unknown in the plan.
+func shouldRotatePassword(plan, state databaseUserModel) bool {
+	return !plan.PasswordRotationTrigger.Equal(state.PasswordRotationTrigger) && plan.Password.IsUnknown() && plan.PasswordWOVersion.IsNull()
 }
 
 func shouldModify(plan, state databaseUserModel) bool {
-	if !plan.Password.Equal(state.Password) && !plan.Password.IsUnknown() {
+	if !plan.Password.Equal(state.Password) && !plan.Password.IsUnknown() && !plan.Password.IsNull() {
+		return true
+	}
+
+	if !plan.PasswordWOVersion.Equal(state.PasswordWOVersion) && !plan.PasswordWOVersion.IsNull() {
 		return true
 	}
 
@@ -583,18 +649,28 @@ func (r *databaseUserResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
-	resp.Diagnostics.Append(checkDatabaseIsRunning(ctx, r.client, uuid, "update")...)
+	resp.Diagnostics.Append(checkDatabaseIsRunning(ctx, r.client, uuid, "user", "update")...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	var user *upcloud.ManagedDatabaseUser
 	var err error
-	if shouldModify(data, state) {
+	if rotate := shouldRotatePassword(data, state); rotate || shouldModify(data, state) {
+		password, d := getDatabaseUserPassword(ctx, req.Config, &data)
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if rotate {
+			password = rand.Text()
+		}
+
 		userReq := &request.ModifyManagedDatabaseUserRequest{
 			ServiceUUID:    uuid,
 			Username:       name,
-			Password:       data.Password.ValueString(),
+			Password:       password,
 			Authentication: upcloud.ManagedDatabaseUserAuthenticationType(data.Authentication.ValueString()),
 		}
 
@@ -681,7 +757,7 @@ func (r *databaseUserResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	resp.Diagnostics.Append(checkDatabaseIsRunning(ctx, r.client, uuid, "delete")...)
+	resp.Diagnostics.Append(checkDatabaseIsRunning(ctx, r.client, uuid, "user", "delete")...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -697,6 +773,15 @@ func (r *databaseUserResource) Delete(ctx context.Context, req resource.DeleteRe
 	}
 }
 
+var databaseUserIdentity = []utils.IdentityAttribute{
+	{Name: "service", Description: "UUID of the managed database service."},
+	{Name: "username", Description: "Name of the database user."},
+}
+
+func (r *databaseUserResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(databaseUserIdentity)
+}
+
 func (r *databaseUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, databaseUserIdentity, req, resp)
 }
@@ -8,7 +8,6 @@ import (
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -18,6 +17,7 @@ var (
 	_ resource.Resource                = &valkeyResource{}
 	_ resource.ResourceWithConfigure   = &valkeyResource{}
 	_ resource.ResourceWithImportState = &valkeyResource{}
+	_ resource.ResourceWithIdentity    = &valkeyResource{}
 )
 
 func NewValkeyResource() resource.Resource {
@@ -37,14 +37,14 @@ func (r *valkeyResource) Configure(_ context.Context, req resource.ConfigureRequ
 	r.client, resp.Diagnostics = utils.GetClientFromProviderData(req.ProviderData)
 }
 
-func (r *valkeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *valkeyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: serviceDescription("Valkey"),
 		Attributes:          map[string]schema.Attribute{},
 		Blocks:              map[string]schema.Block{},
 	}
 
-	defineCommonAttributesAndBlocks(&resp.Schema, upcloud.ManagedDatabaseServiceTypeValkey)
+	defineCommonAttributesAndBlocks(ctx, &resp.Schema, upcloud.ManagedDatabaseServiceTypeValkey)
 }
 
 func (r *valkeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -55,15 +55,23 @@ func (r *valkeyResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx, cancel, diags := utils.TimeoutContext(ctx, data.Timeouts, utils.TimeoutCreate)
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	data.Type = types.StringValue(string(upcloud.ManagedDatabaseServiceTypeValkey))
 
-	_, diags := createDatabase(ctx, &data, r.client)
+	_, diags = createDatabase(ctx, &data, nil, r.client)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, databaseIdentity, data.ID.ValueString())...)
 }
 
 func (r *valkeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -81,6 +89,7 @@ func (r *valkeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, databaseIdentity, data.ID.ValueString())...)
 }
 
 func (r *valkeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -92,13 +101,20 @@ func (r *valkeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	ctx, cancel, diags := utils.TimeoutContext(ctx, plan.Timeouts, utils.TimeoutUpdate)
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, _, d := updateDatabase(ctx, &state, &plan, r.client)
 	resp.Diagnostics.Append(d...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	_, diags := readDatabase(ctx, &plan, r.client, resp.State.RemoveResource)
+	_, diags = readDatabase(ctx, &plan, r.client, resp.State.RemoveResource)
 	resp.Diagnostics.Append(diags...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -108,6 +124,17 @@ func (r *valkeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	var data databaseCommonModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := utils.TimeoutContext(ctx, data.Timeouts, utils.TimeoutDelete)
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := r.client.DeleteManagedDatabase(ctx, &request.DeleteManagedDatabaseRequest{
 		UUID: data.ID.ValueString(),
 	}); err != nil {
@@ -121,6 +148,10 @@ func (r *valkeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	resp.Diagnostics.Append(waitForDatabaseToBeDeleted(ctx, r.client, data.ID.ValueString())...)
 }
 
+func (r *valkeyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(databaseIdentity)
+}
+
 func (r *valkeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, databaseIdentity, req, resp)
 }
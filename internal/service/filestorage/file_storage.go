@@ -9,12 +9,12 @@ import (
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -28,6 +28,7 @@ var (
 	_ resource.Resource                = &fileStorageResource{}
 	_ resource.ResourceWithConfigure   = &fileStorageResource{}
 	_ resource.ResourceWithImportState = &fileStorageResource{}
+	_ resource.ResourceWithIdentity    = &fileStorageResource{}
 
 	resourceNameRegexp = regexp.MustCompile(resourceNameRegexpStr)
 )
@@ -54,14 +55,15 @@ func (r *fileStorageResource) Configure(_ context.Context, req resource.Configur
 }
 
 type fileStorageModel struct {
-	ID               types.String `tfsdk:"id"`
-	Encrypt          types.Bool   `tfsdk:"encrypt"`
-	Name             types.String `tfsdk:"name"`
-	Size             types.Int64  `tfsdk:"size"`
-	Zone             types.String `tfsdk:"zone"`
-	ConfiguredStatus types.String `tfsdk:"configured_status"`
-	Networks         types.Set    `tfsdk:"network"`
-	Labels           types.Map    `tfsdk:"labels"`
+	ID               types.String   `tfsdk:"id"`
+	Encrypt          types.Bool     `tfsdk:"encrypt"`
+	Name             types.String   `tfsdk:"name"`
+	Size             types.Int64    `tfsdk:"size"`
+	Zone             types.String   `tfsdk:"zone"`
+	ConfiguredStatus types.String   `tfsdk:"configured_status"`
+	Networks         types.Set      `tfsdk:"network"`
+	Labels           types.Map      `tfsdk:"labels"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
 type networkAttachmentModel struct {
@@ -78,7 +80,7 @@ var networkAttrTypes = map[string]attr.Type{
 	"ip_address": types.StringType,
 }
 
-func (r *fileStorageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *fileStorageResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Resource for managing UpCloud file storages. See UpCloud [File Storage](https://upcloud.com/products/file-storage/) product page for more details about the service.",
 		Attributes: map[string]schema.Attribute{
@@ -134,6 +136,7 @@ func (r *fileStorageResource) Schema(_ context.Context, _ resource.SchemaRequest
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
 			"network": schema.SetNestedBlock{
 				Description: "Network attached to this file storage (currently supports at most one of these blocks).",
 				NestedObject: schema.NestedBlockObject{
@@ -215,6 +218,13 @@ func (r *fileStorageResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	ctx, cancel, diags := utils.TimeoutContext(ctx, data.Timeouts, utils.TimeoutCreate)
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if data.Name.IsUnknown() || data.Zone.IsUnknown() || data.Size.IsUnknown() || data.ConfiguredStatus.IsUnknown() {
 		resp.Diagnostics.AddError("Invalid plan", "One or more required fields are unknown at apply time.")
 		return
@@ -276,11 +286,12 @@ func (r *fileStorageResource) Create(ctx context.Context, req resource.CreateReq
 
 	fileStorage, err = r.client.WaitForFileStorageOperationalState(ctx, waitReq)
 	if err != nil {
-		resp.Diagnostics.AddError(fmt.Sprintf("Error while waiting for File Storage to be in %s state", waitReq.DesiredState), err.Error())
+		resp.Diagnostics.AddError(fmt.Sprintf("Error while waiting for File Storage to be in %s state", waitReq.DesiredState), utils.WaitErrorDiagnosticDetail(ctx, err))
 	}
 
 	resp.Diagnostics.Append(setFileStorageModel(ctx, &data, fileStorage)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, fileStorageIdentity, data.ID.ValueString())...)
 }
 
 func (r *fileStorageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -313,6 +324,7 @@ func (r *fileStorageResource) Read(ctx context.Context, req resource.ReadRequest
 
 	resp.Diagnostics.Append(setFileStorageModel(ctx, &data, fileStorage)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, fileStorageIdentity, data.ID.ValueString())...)
 }
 
 func (r *fileStorageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -323,6 +335,13 @@ func (r *fileStorageResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	ctx, cancel, diags := utils.TimeoutContext(ctx, plan.Timeouts, utils.TimeoutUpdate)
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if plan.Name.IsUnknown() || plan.Zone.IsUnknown() || plan.Size.IsUnknown() || plan.ConfiguredStatus.IsUnknown() {
 		resp.Diagnostics.AddError("Invalid plan", "One or more required fields are unknown at apply time.")
 		return
@@ -401,7 +420,7 @@ func (r *fileStorageResource) Update(ctx context.Context, req resource.UpdateReq
 
 	fileStorage, err = r.client.WaitForFileStorageOperationalState(ctx, waitReq)
 	if err != nil {
-		resp.Diagnostics.AddError(fmt.Sprintf("Error while waiting for File Storage to be in %s state", waitReq.DesiredState), err.Error())
+		resp.Diagnostics.AddError(fmt.Sprintf("Error while waiting for File Storage to be in %s state", waitReq.DesiredState), utils.WaitErrorDiagnosticDetail(ctx, err))
 	}
 
 	resp.Diagnostics.Append(setFileStorageModel(ctx, &plan, fileStorage)...)
@@ -416,6 +435,13 @@ func (r *fileStorageResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	ctx, cancel, diags := utils.TimeoutContext(ctx, data.Timeouts, utils.TimeoutDelete)
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.client.DeleteFileStorage(ctx, &request.DeleteFileStorageRequest{
 		UUID: data.ID.ValueString(),
 	})
@@ -426,11 +452,17 @@ func (r *fileStorageResource) Delete(ctx context.Context, req resource.DeleteReq
 
 	err = r.client.WaitForFileStorageDeletion(ctx, &request.WaitForFileStorageDeletionRequest{UUID: data.ID.ValueString()})
 	if err != nil {
-		resp.Diagnostics.AddError("File storage deletion did not complete on time, please check the resource", err.Error())
+		resp.Diagnostics.AddError("File storage deletion did not complete on time, please check the resource", utils.WaitErrorDiagnosticDetail(ctx, err))
 		return
 	}
 }
 
+var fileStorageIdentity = utils.UUIDIdentity("file storage")
+
+func (r *fileStorageResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(fileStorageIdentity)
+}
+
 func (r *fileStorageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, fileStorageIdentity, req, resp)
 }
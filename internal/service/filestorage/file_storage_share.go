@@ -11,7 +11,6 @@ import (
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -24,6 +23,7 @@ var (
 	_ resource.Resource                = &fileStorageResource{}
 	_ resource.ResourceWithConfigure   = &fileStorageResource{}
 	_ resource.ResourceWithImportState = &fileStorageResource{}
+	_ resource.ResourceWithIdentity    = &fileStorageShareResource{}
 
 	sharePathRegexp = regexp.MustCompile(sharePathRegexpStr)
 )
@@ -139,6 +139,7 @@ func (r *fileStorageShareResource) Create(ctx context.Context, req resource.Crea
 
 	resp.Diagnostics.Append(setFileStorageShareModel(ctx, &data, fileStorageShare)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, shareIdentity, data.ID.ValueString())...)
 }
 
 func (r *fileStorageShareResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -184,6 +185,7 @@ func (r *fileStorageShareResource) Read(ctx context.Context, req resource.ReadRe
 
 	resp.Diagnostics.Append(setFileStorageShareModel(ctx, &data, fileStorageShare)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, shareIdentity, data.ID.ValueString())...)
 }
 
 func (r *fileStorageShareResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -237,6 +239,15 @@ func (r *fileStorageShareResource) Delete(ctx context.Context, req resource.Dele
 	}
 }
 
+var shareIdentity = []utils.IdentityAttribute{
+	{Name: "file_storage", Description: "UUID of the file storage."},
+	{Name: "name", Description: "Name of the share."},
+}
+
+func (r *fileStorageShareResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(shareIdentity)
+}
+
 func (r *fileStorageShareResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, shareIdentity, req, resp)
 }
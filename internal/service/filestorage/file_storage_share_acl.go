@@ -10,7 +10,6 @@ import (
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -23,6 +22,7 @@ var (
 	_ resource.Resource                = &fileStorageShareACLResource{}
 	_ resource.ResourceWithConfigure   = &fileStorageShareACLResource{}
 	_ resource.ResourceWithImportState = &fileStorageShareACLResource{}
+	_ resource.ResourceWithIdentity    = &fileStorageShareACLResource{}
 )
 
 func NewFileStorageShareACLResource() resource.Resource {
@@ -153,6 +153,7 @@ func (r *fileStorageShareACLResource) Create(ctx context.Context, req resource.C
 
 	resp.Diagnostics.Append(setFileStorageShareACLModel(ctx, &data, fileStorageShareACL)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, shareACLIdentity, data.ID.ValueString())...)
 }
 
 func (r *fileStorageShareACLResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -198,6 +199,7 @@ func (r *fileStorageShareACLResource) Read(ctx context.Context, req resource.Rea
 
 	resp.Diagnostics.Append(setFileStorageShareACLModel(ctx, &data, fileStorageShareACL)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, shareACLIdentity, data.ID.ValueString())...)
 }
 
 func (r *fileStorageShareACLResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -258,6 +260,16 @@ func (r *fileStorageShareACLResource) Delete(ctx context.Context, req resource.D
 	}
 }
 
+var shareACLIdentity = []utils.IdentityAttribute{
+	{Name: "file_storage", Description: "UUID of the file storage."},
+	{Name: "share_name", Description: "Name of the share."},
+	{Name: "name", Description: "Name of the ACL entry."},
+}
+
+func (r *fileStorageShareACLResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(shareACLIdentity)
+}
+
 func (r *fileStorageShareACLResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, shareACLIdentity, req, resp)
 }
@@ -11,7 +11,6 @@ import (
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -39,6 +38,7 @@ var (
 	_ resource.Resource                = &firewallRulesResource{}
 	_ resource.ResourceWithConfigure   = &firewallRulesResource{}
 	_ resource.ResourceWithImportState = &firewallRulesResource{}
+	_ resource.ResourceWithIdentity    = &firewallRulesResource{}
 )
 
 func NewFirewallRulesResource() resource.Resource {
@@ -329,6 +329,7 @@ func (r *firewallRulesResource) Create(ctx context.Context, req resource.CreateR
 
 	resp.Diagnostics.Append(setValues(ctx, &data, &upcloud.FirewallRules{FirewallRules: apiFirewallRules})...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, firewallRulesIdentity, data.ID.ValueString())...)
 }
 
 func (r *firewallRulesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -364,6 +365,7 @@ func (r *firewallRulesResource) Read(ctx context.Context, req resource.ReadReque
 
 	resp.Diagnostics.Append(setValues(ctx, &data, firewallRules)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, firewallRulesIdentity, data.ID.ValueString())...)
 }
 
 func (r *firewallRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -420,6 +422,14 @@ func (r *firewallRulesResource) Delete(ctx context.Context, req resource.DeleteR
 	}
 }
 
+var firewallRulesIdentity = []utils.IdentityAttribute{
+	{Name: "server_id", Description: "UUID of the server."},
+}
+
+func (r *firewallRulesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(firewallRulesIdentity)
+}
+
 func (r *firewallRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, firewallRulesIdentity, req, resp)
 }
@@ -12,7 +12,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -25,6 +24,7 @@ var (
 	_ resource.Resource                = &firewallRulesetResource{}
 	_ resource.ResourceWithConfigure   = &firewallRulesetResource{}
 	_ resource.ResourceWithImportState = &firewallRulesetResource{}
+	_ resource.ResourceWithIdentity    = &firewallRulesetResource{}
 )
 
 func NewFirewallRulesetResource() resource.Resource {
@@ -629,6 +629,7 @@ func (r *firewallRulesetResource) Create(ctx context.Context, req resource.Creat
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, firewallRulesetIdentity, plan.ID.ValueString())...)
 }
 
 func (r *firewallRulesetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -678,6 +679,7 @@ func (r *firewallRulesetResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, firewallRulesetIdentity, state.ID.ValueString())...)
 }
 
 func (r *firewallRulesetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -783,6 +785,12 @@ func (r *firewallRulesetResource) Delete(ctx context.Context, req resource.Delet
 	}
 }
 
+var firewallRulesetIdentity = utils.UUIDIdentity("firewall ruleset")
+
+func (r *firewallRulesetResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(firewallRulesetIdentity)
+}
+
 func (r *firewallRulesetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, firewallRulesetIdentity, req, resp)
 }
@@ -3,348 +3,474 @@ package gateway
 import (
 	"context"
 	"fmt"
+	"regexp"
 
 	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	validatorutil "github.com/UpCloudLtd/terraform-provider-upcloud/internal/validator"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-func ResourceConnection() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceConnectionCreate,
-		ReadContext:   resourceConnectionRead,
-		UpdateContext: resourceConnectionUpdate,
-		DeleteContext: resourceConnectionDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+var nameValidators = []validator.String{
+	stringvalidator.LengthBetween(1, 64),
+	stringvalidator.RegexMatches(regexp.MustCompile("^[a-zA-Z0-9_-]+$"), "must contain only alphanumeric characters, hyphens, and underscores"),
+}
+
+var (
+	_ resource.Resource                   = &connectionResource{}
+	_ resource.ResourceWithConfigure      = &connectionResource{}
+	_ resource.ResourceWithImportState    = &connectionResource{}
+	_ resource.ResourceWithIdentity       = &connectionResource{}
+	_ resource.ResourceWithUpgradeState   = &connectionResource{}
+	_ resource.ResourceWithValidateConfig = &connectionResource{}
+)
+
+func NewConnectionResource() resource.Resource {
+	return &connectionResource{}
+}
+
+type connectionResource struct {
+	client *service.Service
+}
+
+func (r *connectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gateway_connection"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *connectionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = utils.GetClientFromProviderData(req.ProviderData)
+}
+
+type connectionModel struct {
+	ID          types.String `tfsdk:"id"`
+	UUID        types.String `tfsdk:"uuid"`
+	Name        types.String `tfsdk:"name"`
+	Gateway     types.String `tfsdk:"gateway"`
+	Type        types.String `tfsdk:"type"`
+	LocalRoute  types.Set    `tfsdk:"local_route"`
+	RemoteRoute types.Set    `tfsdk:"remote_route"`
+	Tunnels     types.List   `tfsdk:"tunnels"`
+}
+
+type connectionModelV0 struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Gateway     types.String `tfsdk:"gateway"`
+	Type        types.String `tfsdk:"type"`
+	LocalRoute  types.Set    `tfsdk:"local_route"`
+	RemoteRoute types.Set    `tfsdk:"remote_route"`
+	Tunnels     types.List   `tfsdk:"tunnels"`
+}
+
+type routeModel struct {
+	Name          types.String `tfsdk:"name"`
+	StaticNetwork types.String `tfsdk:"static_network"`
+	Type          types.String `tfsdk:"type"`
+}
+
+func (r *connectionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = connectionSchemaV1()
+}
+
+func connectionSchemaV1() schema.Schema {
+	s := connectionSchemaV0()
+	s.Attributes["uuid"] = schema.StringAttribute{
+		MarkdownDescription: "The UUID of the connection",
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
 		},
-		Schema: map[string]*schema.Schema{
-			"uuid": {
-				Description: "The UUID of the connection",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"name": {
-				Description:      "The name of the connection, should be unique within the gateway.",
-				Type:             schema.TypeString,
-				Required:         true,
-				ForceNew:         true,
-				ValidateDiagFunc: validateName,
-			},
-			"gateway": {
-				Description: "The ID of the upcloud_gateway resource to which the connection belongs.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-			},
-			"type": {
-				Description: "The type of the connection; currently the only supported type is 'ipsec'.",
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Default:     "ipsec",
-				ValidateDiagFunc: validation.ToDiagFunc(
-					validation.StringInSlice([]string{"ipsec"}, false),
-				),
+	}
+	s.Attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "ID of the connection. ID is in `{gateway UUID}/{connection UUID}` format.",
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	s.Version = 1
+
+	return s
+}
+
+func connectionSchemaV0() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "This resource represents a connection in a network gateway.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the connection.",
+				Computed:            true,
 			},
-			"local_route": {
-				Description:  "Route for the UpCloud side of the network.",
-				Type:         schema.TypeSet,
-				Optional:     true,
-				AtLeastOneOf: []string{"local_route", "remote_route"},
-				Elem:         gatewayRouteSchema(),
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the connection, should be unique within the gateway.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: nameValidators,
 			},
-			"remote_route": {
-				Description:  "Route for the remote side of the network.",
-				Type:         schema.TypeSet,
-				Optional:     true,
-				AtLeastOneOf: []string{"local_route", "remote_route"},
-				Elem:         gatewayRouteSchema(),
+			"gateway": schema.StringAttribute{
+				MarkdownDescription: "The ID of the upcloud_gateway resource to which the connection belongs.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-			"tunnels": {
-				Description: "List of connection's tunnels names. Note that this field can have outdated information as connections are created by a separate resource. To make sure that you have the most recent data run 'terrafrom refresh'.",
-				Type:        schema.TypeList,
-				Computed:    true,
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the connection; currently the only supported type is 'ipsec'.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(upcloud.GatewayConnectionTypeIPSec)),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(upcloud.GatewayConnectionTypeIPSec)),
 				},
 			},
-		},
-		SchemaVersion: 1,
-		StateUpgraders: []schema.StateUpgrader{
-			{
-				Type:    resourceConnectionResourceV0().CoreConfigSchema().ImpliedType(),
-				Upgrade: resourceConnectionStateUpgradeV0,
-				Version: 0,
+			"tunnels": schema.ListAttribute{
+				MarkdownDescription: "List of connection's tunnels names. Note that this field can have outdated information as connections are created by a separate resource. To make sure that you have the most recent data run 'terrafrom refresh'.",
+				Computed:            true,
+				ElementType:         types.StringType,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"local_route":  routeBlock("Route for the UpCloud side of the network."),
+			"remote_route": routeBlock("Route for the remote side of the network."),
+		},
 	}
 }
 
-func resourceConnectionResourceV0() *schema.Resource {
-	return &schema.Resource{
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Description:      "The name of the connection, should be unique within the gateway.",
-				Type:             schema.TypeString,
-				Required:         true,
-				ForceNew:         true,
-				ValidateDiagFunc: validateName,
-			},
-			"gateway": {
-				Description: "The ID of the upcloud_gateway resource to which the connection belongs.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-			},
-			"type": {
-				Description: "The type of the connection; currently the only supported type is 'ipsec'.",
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Default:     "ipsec",
-				ValidateDiagFunc: validation.ToDiagFunc(
-					validation.StringInSlice([]string{"ipsec"}, false),
-				),
-			},
-			"local_route": {
-				Description:  "Route for the UpCloud side of the network.",
-				Type:         schema.TypeSet,
-				Optional:     true,
-				AtLeastOneOf: []string{"local_route", "remote_route"},
-				Elem:         gatewayRouteSchema(),
-			},
-			"remote_route": {
-				Description:  "Route for the remote side of the network.",
-				Type:         schema.TypeSet,
-				Optional:     true,
-				AtLeastOneOf: []string{"local_route", "remote_route"},
-				Elem:         gatewayRouteSchema(),
-			},
-			"tunnels": {
-				Description: "List of connection's tunnels names. Note that this field can have outdated information as connections are created by a separate resource. To make sure that you have the most recent data run 'terrafrom refresh'.",
-				Type:        schema.TypeList,
-				Computed:    true,
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
+func routeBlock(description string) schema.SetNestedBlock {
+	return schema.SetNestedBlock{
+		MarkdownDescription: description,
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					MarkdownDescription: "Type of route; currently the only supported type is 'static'",
+					Optional:            true,
+					Computed:            true,
+					Default:             stringdefault.StaticString(string(upcloud.GatewayRouteTypeStatic)),
+					Validators: []validator.String{
+						stringvalidator.OneOf(string(upcloud.GatewayRouteTypeStatic)),
+					},
+				},
+				"static_network": schema.StringAttribute{
+					MarkdownDescription: "Destination prefix of the route; needs to be a valid IPv4 prefix",
+					Required:            true,
+					Validators: []validator.String{
+						validatorutil.NewFrameworkStringValidator(validation.IsCIDR),
+					},
+				},
+				"name": schema.StringAttribute{
+					MarkdownDescription: "Name of the route",
+					Required:            true,
+					Validators:          nameValidators,
 				},
 			},
 		},
 	}
 }
 
-func resourceConnectionStateUpgradeV0(ctx context.Context, rawState map[string]any, meta any) (map[string]any, error) {
-	svc := meta.(*service.Service)
-	conns, err := svc.GetGatewayConnections(ctx, &request.GetGatewayConnectionsRequest{ServiceUUID: rawState["gateway"].(string)})
-	if err != nil {
-		return rawState, err
+func (r *connectionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data connectionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	for _, conn := range conns {
-		if conn.Name == rawState["name"].(string) {
-			rawState["uuid"] = conn.UUID
-			rawState["id"] = utils.MarshalID(rawState["gateway"].(string), conn.UUID)
-
-			return rawState, nil
-		}
+	if data.LocalRoute.IsUnknown() || data.RemoteRoute.IsUnknown() {
+		return
 	}
 
-	return rawState, fmt.Errorf("connection by name %s not found", rawState["name"].(string))
+	if len(data.LocalRoute.Elements()) == 0 && len(data.RemoteRoute.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("local_route"),
+			"Missing route",
+			"At least one `local_route` or `remote_route` block must be defined.",
+		)
+	}
 }
 
-func resourceConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
-	svc := meta.(*service.Service)
-	serviceID := d.Get("gateway").(string)
-
-	conn, err := svc.CreateGatewayConnection(ctx, &request.CreateGatewayConnectionRequest{
-		ServiceUUID: serviceID,
-		Connection: request.GatewayConnection{
-			Name:         d.Get("name").(string),
-			Type:         upcloud.GatewayConnectionType(d.Get("type").(string)),
-			LocalRoutes:  expandRoutes(d.Get("local_route")),
-			RemoteRoutes: expandRoutes(d.Get("remote_route")),
+func (r *connectionResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	schemaV0 := connectionSchemaV0()
+	return map[int64]resource.StateUpgrader{
+		// State upgrade implementation from 0 to 1. Schema version 0 did not include connection UUID and used connection name in the resource ID.
+		0: {
+			PriorSchema: &schemaV0,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var priorStateData connectionModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &priorStateData)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				conns, err := r.client.GetGatewayConnections(ctx, &request.GetGatewayConnectionsRequest{ServiceUUID: priorStateData.Gateway.ValueString()})
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Unable to read gateway connections",
+						utils.ErrorDiagnosticDetail(err),
+					)
+					return
+				}
+
+				for _, conn := range conns {
+					if conn.Name == priorStateData.Name.ValueString() {
+						upgradedStateData := connectionModel{
+							ID:          types.StringValue(utils.MarshalID(priorStateData.Gateway.ValueString(), conn.UUID)),
+							UUID:        types.StringValue(conn.UUID),
+							Name:        priorStateData.Name,
+							Gateway:     priorStateData.Gateway,
+							Type:        priorStateData.Type,
+							LocalRoute:  priorStateData.LocalRoute,
+							RemoteRoute: priorStateData.RemoteRoute,
+							Tunnels:     priorStateData.Tunnels,
+						}
+
+						resp.Diagnostics.Append(resp.State.Set(ctx, upgradedStateData)...)
+						return
+					}
+				}
+
+				resp.Diagnostics.AddError(
+					"Unable to upgrade gateway connection state",
+					fmt.Sprintf("connection by name %s not found", priorStateData.Name.ValueString()),
+				)
+			},
 		},
-	})
-	if err != nil {
-		return diag.FromErr(err)
 	}
+}
 
-	d.SetId(utils.MarshalID(serviceID, conn.UUID))
+func setConnectionValues(ctx context.Context, data *connectionModel, serviceUUID string, conn *upcloud.GatewayConnection) diag.Diagnostics {
+	var diags, respDiagnostics diag.Diagnostics
 
-	diags = append(diags, setConnectionResourceData(d, conn)...)
-	if len(diags) > 0 {
-		return diags
-	}
+	data.ID = types.StringValue(utils.MarshalID(serviceUUID, conn.UUID))
+	data.UUID = types.StringValue(conn.UUID)
+	data.Gateway = types.StringValue(serviceUUID)
+	data.Name = types.StringValue(conn.Name)
+	data.Type = types.StringValue(string(conn.Type))
 
-	tflog.Info(ctx, "gateway connection created", map[string]interface{}{"uuid": conn.UUID, "service_uuid": serviceID})
-	return diags
-}
+	data.LocalRoute, diags = types.SetValueFrom(ctx, data.LocalRoute.ElementType(ctx), flattenRoutes(conn.LocalRoutes))
+	respDiagnostics.Append(diags...)
 
-func resourceConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
-	var (
-		svc         = meta.(*service.Service)
-		serviceUUID string
-		uuid        string
-	)
+	data.RemoteRoute, diags = types.SetValueFrom(ctx, data.RemoteRoute.ElementType(ctx), flattenRoutes(conn.RemoteRoutes))
+	respDiagnostics.Append(diags...)
 
-	if err := utils.UnmarshalID(d.Id(), &serviceUUID, &uuid); err != nil {
-		return diag.FromErr(err)
+	tunnels := make([]string, 0)
+	for _, tunnel := range conn.Tunnels {
+		tunnels = append(tunnels, tunnel.Name)
 	}
 
-	conn, err := svc.GetGatewayConnection(ctx, &request.GetGatewayConnectionRequest{
-		ServiceUUID: serviceUUID,
-		UUID:        uuid,
-	})
-	if err != nil {
-		return utils.HandleResourceError(uuid, d, err)
-	}
+	data.Tunnels, diags = types.ListValueFrom(ctx, types.StringType, tunnels)
+	respDiagnostics.Append(diags...)
 
-	d.SetId(utils.MarshalID(serviceUUID, conn.UUID))
+	return respDiagnostics
+}
 
-	if err = d.Set("gateway", serviceUUID); err != nil {
-		return diag.FromErr(err)
+func flattenRoutes(routes []upcloud.GatewayRoute) []routeModel {
+	data := make([]routeModel, len(routes))
+	for i, route := range routes {
+		data[i] = routeModel{
+			Name:          types.StringValue(route.Name),
+			StaticNetwork: types.StringValue(route.StaticNetwork),
+			Type:          types.StringValue(string(route.Type)),
+		}
 	}
+	return data
+}
 
-	diags = append(diags, setConnectionResourceData(d, conn)...)
-	return diags
+func expandRoutes(ctx context.Context, dataRoutes types.Set) ([]upcloud.GatewayRoute, diag.Diagnostics) {
+	var planRoutes []routeModel
+	diags := dataRoutes.ElementsAs(ctx, &planRoutes, false)
+
+	routes := make([]upcloud.GatewayRoute, len(planRoutes))
+	for i, route := range planRoutes {
+		routes[i] = upcloud.GatewayRoute{
+			Type:          upcloud.GatewayRouteType(route.Type.ValueString()),
+			StaticNetwork: route.StaticNetwork.ValueString(),
+			Name:          route.Name.ValueString(),
+		}
+	}
+	return routes, diags
 }
 
-func resourceConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
-	var (
-		svc         = meta.(*service.Service)
-		serviceUUID string
-		uuid        string
-	)
+func (r *connectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data connectionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	localRoutes, diags := expandRoutes(ctx, data.LocalRoute)
+	resp.Diagnostics.Append(diags...)
 
-	if err := utils.UnmarshalID(d.Id(), &serviceUUID, &uuid); err != nil {
-		return diag.FromErr(err)
+	remoteRoutes, diags := expandRoutes(ctx, data.RemoteRoute)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	conn, err := svc.ModifyGatewayConnection(ctx, &request.ModifyGatewayConnectionRequest{
+	serviceUUID := data.Gateway.ValueString()
+	conn, err := r.client.CreateGatewayConnection(ctx, &request.CreateGatewayConnectionRequest{
 		ServiceUUID: serviceUUID,
-		UUID:        uuid,
-		Connection: request.ModifyGatewayConnection{
-			LocalRoutes:  expandRoutes(d.Get("local_route")),
-			RemoteRoutes: expandRoutes(d.Get("remote_route")),
+		Connection: request.GatewayConnection{
+			Name:         data.Name.ValueString(),
+			Type:         upcloud.GatewayConnectionType(data.Type.ValueString()),
+			LocalRoutes:  localRoutes,
+			RemoteRoutes: remoteRoutes,
 		},
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		resp.Diagnostics.AddError(
+			"Unable to create gateway connection",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
 	}
 
-	d.SetId(utils.MarshalID(serviceUUID, conn.UUID))
+	resp.Diagnostics.Append(setConnectionValues(ctx, &data, serviceUUID, conn)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, connectionIdentity, data.ID.ValueString())...)
 
-	diags = append(diags, setConnectionResourceData(d, conn)...)
-	return diags
+	if !resp.Diagnostics.HasError() {
+		tflog.Info(ctx, "gateway connection created", map[string]interface{}{"uuid": conn.UUID, "service_uuid": serviceUUID})
+	}
 }
 
-func resourceConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
-	var (
-		svc         = meta.(*service.Service)
-		serviceUUID string
-		uuid        string
-	)
+func (r *connectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data connectionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
-	if err := utils.UnmarshalID(d.Id(), &serviceUUID, &uuid); err != nil {
-		return diag.FromErr(err)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	tflog.Info(ctx, "deleting gateway connection", map[string]interface{}{"uuid": uuid, "service_uuid": serviceUUID})
+	if data.ID.ValueString() == "" {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	var serviceUUID, uuid string
+	if err := utils.UnmarshalID(data.ID.ValueString(), &serviceUUID, &uuid); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to unmarshal gateway connection ID",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
 
-	return diag.FromErr(svc.DeleteGatewayConnection(ctx, &request.DeleteGatewayConnectionRequest{
+	conn, err := r.client.GetGatewayConnection(ctx, &request.GetGatewayConnectionRequest{
 		ServiceUUID: serviceUUID,
 		UUID:        uuid,
-	}))
-}
-
-func gatewayRouteSchema() *schema.Resource {
-	return &schema.Resource{
-		Schema: map[string]*schema.Schema{
-			"type": {
-				Description: "Type of route; currently the only supported type is 'static'",
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "static",
-				ValidateDiagFunc: validation.ToDiagFunc(
-					validation.StringInSlice([]string{"static"}, false),
-				),
-			},
-			"static_network": {
-				Description:      "Destination prefix of the route; needs to be a valid IPv4 prefix",
-				Type:             schema.TypeString,
-				Required:         true,
-				ValidateDiagFunc: validation.ToDiagFunc(validation.IsCIDR),
-			},
-			"name": {
-				Description:      "Name of the route",
-				Type:             schema.TypeString,
-				Required:         true,
-				ValidateDiagFunc: validateName,
-			},
-		},
+	})
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError(
+				"Unable to read gateway connection details",
+				utils.ErrorDiagnosticDetail(err),
+			)
+		}
+		return
 	}
+
+	resp.Diagnostics.Append(setConnectionValues(ctx, &data, serviceUUID, conn)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, connectionIdentity, data.ID.ValueString())...)
 }
 
-func setConnectionResourceData(d *schema.ResourceData, conn *upcloud.GatewayConnection) (diags diag.Diagnostics) {
-	if err := d.Set("uuid", conn.UUID); err != nil {
-		return diag.FromErr(err)
+func (r *connectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data connectionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	var serviceUUID, uuid string
+	if err := utils.UnmarshalID(data.ID.ValueString(), &serviceUUID, &uuid); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to unmarshal gateway connection ID",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
 	}
 
-	if err := d.Set("name", conn.Name); err != nil {
-		return diag.FromErr(err)
-	}
+	localRoutes, diags := expandRoutes(ctx, data.LocalRoute)
+	resp.Diagnostics.Append(diags...)
 
-	if err := d.Set("type", conn.Type); err != nil {
-		return diag.FromErr(err)
-	}
+	remoteRoutes, diags := expandRoutes(ctx, data.RemoteRoute)
+	resp.Diagnostics.Append(diags...)
 
-	if err := d.Set("local_route", flattenRoutes(conn.LocalRoutes)); err != nil {
-		return diag.FromErr(err)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if err := d.Set("remote_route", flattenRoutes(conn.RemoteRoutes)); err != nil {
-		return diag.FromErr(err)
+	conn, err := r.client.ModifyGatewayConnection(ctx, &request.ModifyGatewayConnectionRequest{
+		ServiceUUID: serviceUUID,
+		UUID:        uuid,
+		Connection: request.ModifyGatewayConnection{
+			LocalRoutes:  localRoutes,
+			RemoteRoutes: remoteRoutes,
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to modify gateway connection",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
 	}
 
-	tunnels := []string{}
-	for _, tunnel := range conn.Tunnels {
-		tunnels = append(tunnels, tunnel.Name)
+	resp.Diagnostics.Append(setConnectionValues(ctx, &data, serviceUUID, conn)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *connectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data connectionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	var serviceUUID, uuid string
+	if err := utils.UnmarshalID(data.ID.ValueString(), &serviceUUID, &uuid); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to unmarshal gateway connection ID",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
 	}
 
-	if err := d.Set("tunnels", tunnels); err != nil {
-		return diag.FromErr(err)
+	tflog.Info(ctx, "deleting gateway connection", map[string]interface{}{"uuid": uuid, "service_uuid": serviceUUID})
+
+	if err := r.client.DeleteGatewayConnection(ctx, &request.DeleteGatewayConnectionRequest{
+		ServiceUUID: serviceUUID,
+		UUID:        uuid,
+	}); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete gateway connection",
+			utils.ErrorDiagnosticDetail(err),
+		)
 	}
+}
 
-	return diags
+var connectionIdentity = []utils.IdentityAttribute{
+	{Name: "gateway", Description: "UUID of the gateway."},
+	{Name: "uuid", Description: "UUID of the connection."},
 }
 
-func flattenRoutes(routes []upcloud.GatewayRoute) []interface{} {
-	data := make([]interface{}, len(routes))
-	for i, route := range routes {
-		data[i] = map[string]interface{}{
-			"type":           route.Type,
-			"static_network": route.StaticNetwork,
-			"name":           route.Name,
-		}
-	}
-	return data
+func (r *connectionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(connectionIdentity)
 }
 
-func expandRoutes(d interface{}) []upcloud.GatewayRoute {
-	routes := d.(*schema.Set).List()
-	data := make([]upcloud.GatewayRoute, len(routes))
-	for i, route := range routes {
-		route := route.(map[string]interface{})
-		data[i] = upcloud.GatewayRoute{
-			Type:          upcloud.GatewayRouteType(route["type"].(string)),
-			StaticNetwork: route["static_network"].(string),
-			Name:          route["name"].(string),
-		}
-	}
-	return data
+func (r *connectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	utils.ImportStateWithIdentity(ctx, connectionIdentity, req, resp)
 }
@@ -32,6 +32,9 @@ const (
 	cleanupWaitTimeSeconds = 15
 )
 
+// ResourceGateway is implemented with SDKv2 as the `address` block is both optional and computed. Plugin framework
+// does not support computed blocks, so migrating the resource would require changing the block into a nested
+// attribute, which would break existing configurations.
 func ResourceGateway() *schema.Resource {
 	return &schema.Resource{
 		Description:   "Network gateways connect SDN Private Networks to external IP networks.",
@@ -40,8 +43,9 @@ func ResourceGateway() *schema.Resource {
 		UpdateContext: resourceGatewayUpdate,
 		DeleteContext: resourceGatewayDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: utils.SDKv2ImportStateWithIdentity(gatewayIdentity),
 		},
+		Identity: utils.SDKv2IdentitySchema(gatewayIdentity),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Description:      nameDescription,
@@ -340,9 +344,15 @@ func setGatewayResourceData(d *schema.ResourceData, gw *upcloud.Gateway) (diags
 		return diag.FromErr(err)
 	}
 
+	if err := utils.SetSDKv2Identity(d, gatewayIdentity); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return diags
 }
 
+var gatewayIdentity = utils.UUIDIdentity("gateway")
+
 func waitForGatewayToBeRunning(ctx context.Context, svc *service.Service, id string) (*upcloud.Gateway, error) {
 	const maxRetries int = 500
 
@@ -9,6 +9,7 @@ import (
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -38,15 +39,28 @@ var allowedIntegrityAlgorithms = []string{
 
 var allowedDHGroups = []int{2, 5, 14, 15, 16, 18, 19, 20, 21, 24}
 
+var tunnelIdentity = []utils.IdentityAttribute{
+	{Name: "gateway", Description: "UUID of the gateway."},
+	{Name: "connection", Description: "UUID of the connection."},
+	{Name: "uuid", Description: "UUID of the tunnel."},
+}
+
+// ResourceTunnel is implemented with SDKv2 as the `ipsec_properties` block is both optional and computed. Plugin
+// framework does not support computed blocks, so migrating the resource would require changing the block into a
+// nested attribute, which would break existing configurations.
+const tunnelDescription = "Network gateway connection tunnel. The pre-shared key in `ipsec_auth_psk.psk` is write-only and is not stored in the state, which requires Terraform 1.11 or later. As changes to a write-only value are not detected, rotate the pre-shared key by setting the new `psk` and incrementing `psk_version` in the same apply. This replaces the tunnel with one using the new key."
+
 func ResourceTunnel() *schema.Resource {
 	return &schema.Resource{
+		Description:   tunnelDescription,
 		CreateContext: resourceTunnelCreate,
 		ReadContext:   resourceTunnelRead,
 		UpdateContext: resourceTunnelUpdate,
 		DeleteContext: resourceTunnelDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: utils.SDKv2ImportStateWithIdentity(tunnelIdentity),
 		},
+		Identity: utils.SDKv2IdentitySchema(tunnelIdentity),
 		Schema: map[string]*schema.Schema{
 			"uuid": {
 				Description: "The UUID of the tunnel",
@@ -225,9 +239,9 @@ func resourceTunnelCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.FromErr(err)
 	}
 
-	ipsecAuth, err := getIPSecAuthenticationFromSchema(d)
-	if err != nil {
-		return diag.FromErr(err)
+	ipsecAuth, diags := getIPSecAuthenticationFromSchema(d)
+	if diags.HasError() {
+		return diags
 	}
 
 	ipsec.Authentication = ipsecAuth
@@ -424,18 +438,24 @@ func getIPSecRequestFieldsFromSchema(d *schema.ResourceData) (upcloud.GatewayTun
 	}, nil
 }
 
-func getIPSecAuthenticationFromSchema(d *schema.ResourceData) (upcloud.GatewayTunnelIPSecAuth, error) {
+func getIPSecAuthenticationFromSchema(d *schema.ResourceData) (upcloud.GatewayTunnelIPSecAuth, diag.Diagnostics) {
 	result := upcloud.GatewayTunnelIPSecAuth{}
 
-	if psk, authMethodIsPSK := d.GetOk("ipsec_auth_psk.0.psk"); authMethodIsPSK {
+	// The pre-shared key is write-only, so it is only available in the configuration.
+	psk, diags := d.GetRawConfigAt(cty.GetAttrPath("ipsec_auth_psk").IndexInt(0).GetAttr("psk"))
+	if diags.HasError() {
+		return result, diags
+	}
+
+	if psk.Type().Equals(cty.String) && psk.IsKnown() && !psk.IsNull() && psk.AsString() != "" {
 		result.Authentication = upcloud.GatewayTunnelIPSecAuthTypePSK
-		result.PSK = psk.(string)
-		return result, nil
+		result.PSK = psk.AsString()
+		return result, diags
 	}
 
 	// Put more authentication methods here once supported
 
-	return result, fmt.Errorf("tunnel IPsec authentication method not recognized")
+	return result, diag.Errorf("tunnel IPsec authentication method not recognized")
 }
 
 func setTunnelResourceData(d *schema.ResourceData, tunnel *upcloud.GatewayTunnel) diag.Diagnostics {
@@ -461,8 +481,13 @@ func setTunnelResourceData(d *schema.ResourceData, tunnel *upcloud.GatewayTunnel
 		return diag.FromErr(err)
 	}
 
-	// We do not set ipsec_auth_psk block as API don't return PSK in API responses
-	// We rely on TF core to just set it to state and track changes
+	// API does not return the pre-shared key, and the key is write-only, so only the ipsec_auth_psk block itself is tracked in the state.
+	// When importing, the block is initialized without a key version.
+	if len(d.Get("ipsec_auth_psk").([]interface{})) == 0 {
+		if err := d.Set("ipsec_auth_psk", []map[string]interface{}{{}}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
 	// Again, slice of maps because it's a schema.TypeList
 	ipsecProperties := []map[string]interface{}{{
@@ -483,6 +508,10 @@ func setTunnelResourceData(d *schema.ResourceData, tunnel *upcloud.GatewayTunnel
 		return diag.FromErr(err)
 	}
 
+	if err := utils.SetSDKv2Identity(d, tunnelIdentity); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return diags
 }
 
@@ -490,15 +519,21 @@ func ipsecAuthPSKSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"psk": {
-				Description: "The pre-shared key. This value is only used during resource creation and is not returned in the state. It is not possible to update this value. If you need to update it, delete the connection and create a new one.",
+				Description: "The pre-shared key. This value is write-only: it is only used during resource creation and is not stored in the state. To change the pre-shared key, change the value and increment `psk_version` to replace the tunnel.",
 				Type:        schema.TypeString,
 				Required:    true,
 				Sensitive:   true,
+				WriteOnly:   true,
 				ValidateDiagFunc: validation.ToDiagFunc(validation.All(
 					validation.StringLenBetween(8, 64),
 					validation.StringMatch(regexp.MustCompile("^[a-zA-Z1-9_.][a-zA-Z0-9_.]+$"), "must contain only alphanumeric characters, underscores, and dots"),
 				)),
 			},
+			"psk_version": {
+				Description: "Version of the pre-shared key. As `psk` is not stored in the state, changes to it are not detected. Change this value to replace the tunnel with the current `psk` value.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -9,7 +9,6 @@ import (
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -24,6 +23,7 @@ var (
 	_ resource.Resource                = &floatingIPResource{}
 	_ resource.ResourceWithConfigure   = &floatingIPResource{}
 	_ resource.ResourceWithImportState = &floatingIPResource{}
+	_ resource.ResourceWithIdentity    = &floatingIPResource{}
 )
 
 func NewFloatingIPAddressResource() resource.Resource {
@@ -175,6 +175,7 @@ func (r *floatingIPResource) Create(ctx context.Context, req resource.CreateRequ
 
 	setValues(&data, ip)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, floatingIPIdentity, data.ID.ValueString())...)
 }
 
 func (r *floatingIPResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -208,6 +209,7 @@ func (r *floatingIPResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	setValues(&data, ip)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, floatingIPIdentity, data.ID.ValueString())...)
 }
 
 func (r *floatingIPResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -268,6 +270,14 @@ func (r *floatingIPResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 }
 
+var floatingIPIdentity = []utils.IdentityAttribute{
+	{Name: "ip_address", Description: "The floating IP address."},
+}
+
+func (r *floatingIPResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(floatingIPIdentity)
+}
+
 func (r *floatingIPResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, floatingIPIdentity, req, resp)
 }
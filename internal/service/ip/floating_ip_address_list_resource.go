@@ -0,0 +1,89 @@
+package ip
+
+import (
+	"context"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &floatingIPListResource{}
+	_ list.ListResourceWithConfigure = &floatingIPListResource{}
+)
+
+func NewFloatingIPAddressListResource() list.ListResource {
+	return &floatingIPListResource{}
+}
+
+type floatingIPListResource struct {
+	client *service.Service
+}
+
+func (r *floatingIPListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_floating_ip_address"
+}
+
+// Configure adds the provider configured client to the list resource.
+func (r *floatingIPListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = utils.GetClientFromProviderData(req.ProviderData)
+}
+
+type floatingIPListModel struct {
+	Zone types.String `tfsdk:"zone"`
+}
+
+func (r *floatingIPListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists floating IP addresses, e.g., for generating import blocks with `terraform query`. IP addresses do not have labels, so only zone can be used for filtering.",
+		Attributes: map[string]schema.Attribute{
+			"zone": utils.ListResourceZoneAttribute("floating IP addresses"),
+		},
+	}
+}
+
+func (r *floatingIPListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data floatingIPListModel
+	diags := utils.GetListResourceConfig(ctx, req.Config, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	ipAddresses, err := r.client.GetIPAddresses(ctx)
+	if err != nil {
+		diags.AddError(
+			"Unable to list IP addresses",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, ip := range ipAddresses.IPAddresses {
+			if !ip.Floating.Bool() {
+				continue
+			}
+
+			if !data.Zone.IsNull() && ip.Zone != data.Zone.ValueString() {
+				continue
+			}
+
+			result := utils.NewListResult(ctx, req, floatingIPIdentity, ip.Address, ip.Address)
+			if req.IncludeResource {
+				var ipData floatingIPModel
+				setValues(&ipData, &ip)
+				result.Diagnostics.Append(result.Resource.Set(ctx, &ipData)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -9,9 +9,9 @@ import (
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -27,6 +27,7 @@ var (
 	_ resource.Resource                = &kubernetesClusterResource{}
 	_ resource.ResourceWithConfigure   = &kubernetesClusterResource{}
 	_ resource.ResourceWithImportState = &kubernetesClusterResource{}
+	_ resource.ResourceWithIdentity    = &kubernetesClusterResource{}
 )
 
 func NewKubernetesClusterResource() resource.Resource {
@@ -47,23 +48,24 @@ func (r *kubernetesClusterResource) Configure(_ context.Context, req resource.Co
 }
 
 type kubernetesClusterModel struct {
-	ControlPlaneIPFilter types.Set    `tfsdk:"control_plane_ip_filter"`
-	ID                   types.String `tfsdk:"id"`
-	Labels               types.Map    `tfsdk:"labels"`
-	Name                 types.String `tfsdk:"name"`
-	Network              types.String `tfsdk:"network"`
-	NetworkCIDR          types.String `tfsdk:"network_cidr"`
-	NodeGroups           types.List   `tfsdk:"node_groups"`
-	Plan                 types.String `tfsdk:"plan"`
-	PrivateNodeGroups    types.Bool   `tfsdk:"private_node_groups"`
-	State                types.String `tfsdk:"state"`
-	StorageEncryption    types.String `tfsdk:"storage_encryption"`
-	Version              types.String `tfsdk:"version"`
-	UpgradeStrategyType  types.String `tfsdk:"upgrade_strategy_type"`
-	Zone                 types.String `tfsdk:"zone"`
+	ControlPlaneIPFilter types.Set      `tfsdk:"control_plane_ip_filter"`
+	ID                   types.String   `tfsdk:"id"`
+	Labels               types.Map      `tfsdk:"labels"`
+	Name                 types.String   `tfsdk:"name"`
+	Network              types.String   `tfsdk:"network"`
+	NetworkCIDR          types.String   `tfsdk:"network_cidr"`
+	NodeGroups           types.List     `tfsdk:"node_groups"`
+	Plan                 types.String   `tfsdk:"plan"`
+	PrivateNodeGroups    types.Bool     `tfsdk:"private_node_groups"`
+	State                types.String   `tfsdk:"state"`
+	StorageEncryption    types.String   `tfsdk:"storage_encryption"`
+	Version              types.String   `tfsdk:"version"`
+	UpgradeStrategyType  types.String   `tfsdk:"upgrade_strategy_type"`
+	Zone                 types.String   `tfsdk:"zone"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
 }
 
-func (r *kubernetesClusterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *kubernetesClusterResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "This resource represents a [Managed Kubernetes](https://upcloud.com/products/managed-kubernetes) cluster.",
 		Attributes: map[string]schema.Attribute{
@@ -173,6 +175,9 @@ func (r *kubernetesClusterResource) Schema(_ context.Context, _ resource.SchemaR
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -220,6 +225,13 @@ func (r *kubernetesClusterResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	ctx, cancel, diags := utils.TimeoutContext(ctx, data.Timeouts, utils.TimeoutCreate)
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var ipFilter []string
 	resp.Diagnostics.Append(data.ControlPlaneIPFilter.ElementsAs(ctx, &ipFilter, false)...)
 
@@ -259,13 +271,14 @@ func (r *kubernetesClusterResource) Create(ctx context.Context, req resource.Cre
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error while waiting for Kubernetes cluster to be in running state",
-			utils.ErrorDiagnosticDetail(err),
+			utils.WaitErrorDiagnosticDetail(ctx, err),
 		)
 		return
 	}
 
 	resp.Diagnostics.Append(setClusterValues(ctx, &data, cluster)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, clusterIdentity, data.ID.ValueString())...)
 }
 
 func (r *kubernetesClusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -299,6 +312,7 @@ func (r *kubernetesClusterResource) Read(ctx context.Context, req resource.ReadR
 
 	resp.Diagnostics.Append(setClusterValues(ctx, &data, cluster)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, clusterIdentity, data.ID.ValueString())...)
 }
 
 func (r *kubernetesClusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -306,6 +320,17 @@ func (r *kubernetesClusterResource) Update(ctx context.Context, req resource.Upd
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := utils.TimeoutContext(ctx, plan.Timeouts, utils.TimeoutUpdate)
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var labels map[string]string
 	if !plan.Labels.IsNull() && !plan.Labels.IsUnknown() {
 		resp.Diagnostics.Append(plan.Labels.ElementsAs(ctx, &labels, false)...)
@@ -364,7 +389,7 @@ func (r *kubernetesClusterResource) Update(ctx context.Context, req resource.Upd
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error while waiting for Kubernetes cluster to be in running state",
-			utils.ErrorDiagnosticDetail(err),
+			utils.WaitErrorDiagnosticDetail(ctx, err),
 		)
 		return
 	}
@@ -377,6 +402,17 @@ func (r *kubernetesClusterResource) Delete(ctx context.Context, req resource.Del
 	var data kubernetesClusterModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := utils.TimeoutContext(ctx, data.Timeouts, utils.TimeoutDelete)
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := r.client.DeleteKubernetesCluster(ctx, &request.DeleteKubernetesClusterRequest{
 		UUID: data.ID.ValueString(),
 	}); err != nil {
@@ -389,6 +425,12 @@ func (r *kubernetesClusterResource) Delete(ctx context.Context, req resource.Del
 	resp.Diagnostics.Append(waitForClusterToBeDeleted(ctx, r.client, data.ID.ValueString())...)
 }
 
+var clusterIdentity = utils.UUIDIdentity("cluster")
+
+func (r *kubernetesClusterResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(clusterIdentity)
+}
+
 func (r *kubernetesClusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, clusterIdentity, req, resp)
 }
@@ -40,15 +40,24 @@ func (d *kubernetesClusterDataSource) Configure(_ context.Context, req datasourc
 }
 
 type kubernetesClusterDataModel struct {
+	AdditionalClusters   types.Set    `tfsdk:"additional_clusters"`
 	ClientCertificate    types.String `tfsdk:"client_certificate"`
 	ClientKey            types.String `tfsdk:"client_key"`
 	ClusterCACertificate types.String `tfsdk:"cluster_ca_certificate"`
+	ContextName          types.String `tfsdk:"context_name"`
+	Exec                 types.List   `tfsdk:"exec"`
 	ID                   types.String `tfsdk:"id"`
 	Host                 types.String `tfsdk:"host"`
 	Kubeconfig           types.String `tfsdk:"kubeconfig"`
 	Name                 types.String `tfsdk:"name"`
 }
 
+type kubeconfigExecModel struct {
+	Command types.String `tfsdk:"command"`
+	Args    types.List   `tfsdk:"args"`
+	Env     types.Map    `tfsdk:"env"`
+}
+
 func (m *kubernetesClusterDataModel) setB64Decoded(field string, encoded string) (diags diag.Diagnostics) {
 	decoded, err := base64.StdEncoding.DecodeString(encoded)
 	if err != nil {
@@ -139,6 +148,18 @@ func (d *kubernetesClusterDataSource) Schema(_ context.Context, _ datasource.Sch
 				Computed:    true,
 				Description: nameDescription,
 			},
+			"additional_clusters": schema.SetAttribute{
+				MarkdownDescription: additionalClustersDescription,
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"context_name": schema.StringAttribute{
+				MarkdownDescription: contextNameDescription,
+				Optional:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"exec": kubeconfigExecDataSourceBlock(),
 		},
 	}
 }
@@ -165,5 +186,127 @@ func (d *kubernetesClusterDataSource) Read(ctx context.Context, req datasource.R
 	}
 
 	resp.Diagnostics.Append(setClusterKubeconfigData(ctx, s, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rendered, diags := renderKubeconfig(ctx, d.client, s, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Kubeconfig = types.StringValue(rendered)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+func getKubeconfig(ctx context.Context, client *service.Service, clusterID string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	s, err := client.GetKubernetesKubeconfig(ctx, &request.GetKubernetesKubeconfigRequest{
+		UUID: clusterID,
+	})
+	if err != nil {
+		diags.AddError(
+			"Unable to read cluster kubeconfig",
+			utils.ErrorDiagnosticDetail(err),
+		)
+	}
+	return s, diags
+}
+
+func getExecOptions(ctx context.Context, data kubernetesClusterDataModel) (*kubeconfigExecOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var execModels []kubeconfigExecModel
+	diags.Append(data.Exec.ElementsAs(ctx, &execModels, false)...)
+	if diags.HasError() || len(execModels) == 0 {
+		return nil, diags
+	}
+	execModel := execModels[0]
+
+	opts := kubeconfigExecOptions{
+		Command: execModel.Command.ValueString(),
+	}
+	diags.Append(execModel.Args.ElementsAs(ctx, &opts.Args, false)...)
+	if !execModel.Env.IsNull() {
+		diags.Append(execModel.Env.ElementsAs(ctx, &opts.Env, false)...)
+	}
+	return &opts, diags
+}
+
+// renderKubeconfig modifies the kubeconfig returned by the API according to the configured options.
+func renderKubeconfig(ctx context.Context, client *service.Service, s string, data kubernetesClusterDataModel) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	execOpts, d := getExecOptions(ctx, data)
+	diags.Append(d...)
+
+	var additionalClusters []string
+	if !data.AdditionalClusters.IsNull() {
+		diags.Append(data.AdditionalClusters.ElementsAs(ctx, &additionalClusters, false)...)
+	}
+
+	if diags.HasError() {
+		return "", diags
+	}
+
+	if execOpts == nil && len(additionalClusters) == 0 && data.ContextName.IsNull() {
+		return s, diags
+	}
+
+	k, err := parseKubeconfig(s)
+	if err != nil {
+		diags.AddError(
+			"Kubeconfig YAML unmarshal failed",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return "", diags
+	}
+
+	if execOpts != nil {
+		k.useExec(*execOpts)
+	}
+
+	for _, clusterID := range additionalClusters {
+		other, d := getKubeconfig(ctx, client, clusterID)
+		diags.Append(d...)
+		if diags.HasError() {
+			return "", diags
+		}
+
+		otherKubeconfig, err := parseKubeconfig(other)
+		if err != nil {
+			diags.AddError(
+				"Kubeconfig YAML unmarshal failed",
+				utils.ErrorDiagnosticDetail(err),
+			)
+			return "", diags
+		}
+
+		if execOpts != nil {
+			otherKubeconfig.useExec(*execOpts)
+		}
+
+		if err := k.merge(otherKubeconfig); err != nil {
+			diags.AddError(
+				"Unable to render kubeconfig",
+				fmt.Sprintf("Unable to merge kubeconfig of cluster %s: %s", clusterID, err.Error()),
+			)
+			return "", diags
+		}
+	}
+
+	if !data.ContextName.IsNull() {
+		k.renameCurrentContext(data.ContextName.ValueString())
+	}
+
+	rendered, err := k.marshal()
+	if err != nil {
+		diags.AddError(
+			"Unable to render kubeconfig",
+			utils.ErrorDiagnosticDetail(err),
+		)
+	}
+	return rendered, diags
+}
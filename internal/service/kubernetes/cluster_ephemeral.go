@@ -5,10 +5,10 @@ import (
 
 	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
 
-	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func NewKubernetesClusterEphemeral() ephemeral.EphemeralResource {
@@ -66,6 +66,18 @@ func (e *kubernetesClusterEphemeral) Schema(_ context.Context, _ ephemeral.Schem
 				Computed:    true,
 				Description: nameDescription,
 			},
+			"additional_clusters": schema.SetAttribute{
+				MarkdownDescription: additionalClustersDescription,
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"context_name": schema.StringAttribute{
+				MarkdownDescription: contextNameDescription,
+				Optional:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"exec": kubeconfigExecEphemeralBlock(),
 		},
 	}
 }
@@ -77,19 +89,23 @@ func (e *kubernetesClusterEphemeral) Open(ctx context.Context, req ephemeral.Ope
 		return
 	}
 
-	clusterID := data.ID.ValueString()
-
-	s, err := e.client.GetKubernetesKubeconfig(ctx, &request.GetKubernetesKubeconfigRequest{
-		UUID: clusterID,
-	})
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to read cluster kubeconfig",
-			utils.ErrorDiagnosticDetail(err),
-		)
+	s, diags := getKubeconfig(ctx, e.client, data.ID.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	resp.Diagnostics.Append(setClusterKubeconfigData(ctx, s, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rendered, diags := renderKubeconfig(ctx, e.client, s, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Kubeconfig = types.StringValue(rendered)
 	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
 }
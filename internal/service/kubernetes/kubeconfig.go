@@ -1,38 +1,238 @@
 package kubernetes
 
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.yaml.in/yaml/v3"
+)
+
+const kubeconfigExecAPIVersion = "client.authentication.k8s.io/v1"
+
+// The kubeconfig types only define the fields that are modified when rendering the kubeconfig. Other fields, e.g.,
+// preferences and extensions, are stored in the inline maps so that they are not lost when the kubeconfig is marshaled.
+
 type kubeconfig struct {
-	Clusters       []kubeconfigCluster `yaml:"clusters"`
-	Contexts       []kubeconfigContext `yaml:"contexts"`
-	CurrentContext string              `yaml:"current-context"`
-	Users          []kubeconfigUser    `yaml:"users"`
+	APIVersion     string                 `yaml:"apiVersion,omitempty"`
+	Kind           string                 `yaml:"kind,omitempty"`
+	Clusters       []kubeconfigCluster    `yaml:"clusters"`
+	Contexts       []kubeconfigContext    `yaml:"contexts"`
+	CurrentContext string                 `yaml:"current-context"`
+	Users          []kubeconfigUser       `yaml:"users"`
+	Other          map[string]interface{} `yaml:",inline"`
 }
 
 type kubeconfigCluster struct {
-	Cluster kubeconfigClusterData `yaml:"cluster"`
-	Name    string                `yaml:"name"`
+	Cluster kubeconfigClusterData  `yaml:"cluster"`
+	Name    string                 `yaml:"name"`
+	Other   map[string]interface{} `yaml:",inline"`
 }
 
 type kubeconfigClusterData struct {
-	CertificateAuthorityData string `yaml:"certificate-authority-data"`
-	Server                   string `yaml:"server"`
+	CertificateAuthorityData string                 `yaml:"certificate-authority-data"`
+	Server                   string                 `yaml:"server"`
+	Other                    map[string]interface{} `yaml:",inline"`
 }
 
 type kubeconfigContext struct {
-	Context kubeconfigContextData `yaml:"context"`
-	Name    string                `yaml:"name"`
+	Context kubeconfigContextData  `yaml:"context"`
+	Name    string                 `yaml:"name"`
+	Other   map[string]interface{} `yaml:",inline"`
 }
 
 type kubeconfigContextData struct {
-	Cluster string `yaml:"cluster"`
-	User    string `yaml:"user"`
+	Cluster string                 `yaml:"cluster"`
+	User    string                 `yaml:"user"`
+	Other   map[string]interface{} `yaml:",inline"`
 }
 
 type kubeconfigUser struct {
-	User kubeconfigUserData `yaml:"user"`
-	Name string             `yaml:"name"`
+	User  kubeconfigUserData     `yaml:"user"`
+	Name  string                 `yaml:"name"`
+	Other map[string]interface{} `yaml:",inline"`
 }
 
 type kubeconfigUserData struct {
-	ClientCertificateData string `yaml:"client-certificate-data"`
-	ClientKeyData         string `yaml:"client-key-data"`
+	ClientCertificateData string                 `yaml:"client-certificate-data,omitempty"`
+	ClientKeyData         string                 `yaml:"client-key-data,omitempty"`
+	Exec                  *kubeconfigUserExec    `yaml:"exec,omitempty"`
+	Other                 map[string]interface{} `yaml:",inline"`
+}
+
+type kubeconfigUserExec struct {
+	APIVersion         string                  `yaml:"apiVersion"`
+	Command            string                  `yaml:"command"`
+	Args               []string                `yaml:"args,omitempty"`
+	Env                []kubeconfigUserExecEnv `yaml:"env,omitempty"`
+	InteractiveMode    string                  `yaml:"interactiveMode"`
+	ProvideClusterInfo bool                    `yaml:"provideClusterInfo"`
+}
+
+type kubeconfigUserExecEnv struct {
+	Name  string `yaml:"name"`
+	Value string `yaml:"value"`
+}
+
+// kubeconfigExecOptions defines the exec credential plugin used to authenticate to the cluster instead of the client certificate.
+type kubeconfigExecOptions struct {
+	Command string
+	Args    []string
+	Env     map[string]string
+}
+
+// The data source and the ephemeral resource use different schema packages, so the exec block is defined for both
+// here. TestKubeconfigExecBlocksMatch checks that the definitions stay in sync.
+
+func kubeconfigExecDataSourceBlock() datasourceschema.ListNestedBlock {
+	return datasourceschema.ListNestedBlock{
+		MarkdownDescription: execDescription,
+		NestedObject: datasourceschema.NestedBlockObject{
+			Attributes: map[string]datasourceschema.Attribute{
+				"command": datasourceschema.StringAttribute{
+					MarkdownDescription: execCommandDescription,
+					Required:            true,
+				},
+				"args": datasourceschema.ListAttribute{
+					MarkdownDescription: execArgsDescription,
+					Required:            true,
+					ElementType:         types.StringType,
+				},
+				"env": datasourceschema.MapAttribute{
+					MarkdownDescription: execEnvDescription,
+					Optional:            true,
+					ElementType:         types.StringType,
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+func kubeconfigExecEphemeralBlock() ephemeralschema.ListNestedBlock {
+	return ephemeralschema.ListNestedBlock{
+		MarkdownDescription: execDescription,
+		NestedObject: ephemeralschema.NestedBlockObject{
+			Attributes: map[string]ephemeralschema.Attribute{
+				"command": ephemeralschema.StringAttribute{
+					MarkdownDescription: execCommandDescription,
+					Required:            true,
+				},
+				"args": ephemeralschema.ListAttribute{
+					MarkdownDescription: execArgsDescription,
+					Required:            true,
+					ElementType:         types.StringType,
+				},
+				"env": ephemeralschema.MapAttribute{
+					MarkdownDescription: execEnvDescription,
+					Optional:            true,
+					ElementType:         types.StringType,
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+func parseKubeconfig(s string) (*kubeconfig, error) {
+	k := kubeconfig{}
+	if err := yaml.Unmarshal([]byte(s), &k); err != nil {
+		return nil, err
+	}
+	return &k, nil
+}
+
+func (k *kubeconfig) marshal() (string, error) {
+	b, err := yaml.Marshal(k)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func (k *kubeconfig) currentContext() *kubeconfigContext {
+	for i := range k.Contexts {
+		if k.Contexts[i].Name == k.CurrentContext {
+			return &k.Contexts[i]
+		}
+	}
+	return nil
+}
+
+// renameCurrentContext changes the name of the current context.
+func (k *kubeconfig) renameCurrentContext(name string) {
+	if c := k.currentContext(); c != nil {
+		c.Name = name
+	}
+	k.CurrentContext = name
+}
+
+// useExec replaces the authentication options of the users with exec credential plugin. The same command and arguments
+// are used for all users, so cluster info is provided to the plugin in KUBERNETES_EXEC_INFO environment variable to
+// allow the plugin to identify the cluster.
+func (k *kubeconfig) useExec(opts kubeconfigExecOptions) {
+	env := make([]kubeconfigUserExecEnv, 0, len(opts.Env))
+	for _, name := range slices.Sorted(maps.Keys(opts.Env)) {
+		env = append(env, kubeconfigUserExecEnv{Name: name, Value: opts.Env[name]})
+	}
+
+	for i := range k.Users {
+		k.Users[i].User = kubeconfigUserData{
+			Exec: &kubeconfigUserExec{
+				APIVersion:         kubeconfigExecAPIVersion,
+				Command:            opts.Command,
+				Args:               opts.Args,
+				Env:                env,
+				InteractiveMode:    "Never",
+				ProvideClusterInfo: true,
+			},
+		}
+	}
+}
+
+// merge adds clusters, contexts, and users from other kubeconfig. The current context is not modified.
+func (k *kubeconfig) merge(other *kubeconfig) error {
+	clusters := make(map[string]bool)
+	for _, c := range k.Clusters {
+		clusters[c.Name] = true
+	}
+	for _, c := range other.Clusters {
+		if clusters[c.Name] {
+			return fmt.Errorf("kubeconfig already contains a cluster named %s", c.Name)
+		}
+		k.Clusters = append(k.Clusters, c)
+	}
+
+	contexts := make(map[string]bool)
+	for _, c := range k.Contexts {
+		contexts[c.Name] = true
+	}
+	for _, c := range other.Contexts {
+		if contexts[c.Name] {
+			return fmt.Errorf("kubeconfig already contains a context named %s", c.Name)
+		}
+		k.Contexts = append(k.Contexts, c)
+	}
+
+	users := make(map[string]bool)
+	for _, u := range k.Users {
+		users[u.Name] = true
+	}
+	for _, u := range other.Users {
+		if users[u.Name] {
+			return fmt.Errorf("kubeconfig already contains a user named %s", u.Name)
+		}
+		k.Users = append(k.Users, u)
+	}
+
+	return nil
 }
@@ -0,0 +1,132 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testKubeconfig(name string) string {
+	return fmt.Sprintf(`apiVersion: v1
+clusters:
+- cluster:
+    certificate-authority-data: Y2E=
+    extensions:
+    - extension:
+        example: value
+      name: example
+    server: https://%[1]s.example.com:6443
+  name: %[1]s
+contexts:
+- context:
+    cluster: %[1]s
+    user: %[1]s-admin
+  name: %[1]s-admin@%[1]s
+current-context: %[1]s-admin@%[1]s
+kind: Config
+preferences: {}
+users:
+- name: %[1]s-admin
+  user:
+    client-certificate-data: Y2VydA==
+    client-key-data: a2V5
+`, name)
+}
+
+func TestKubeconfigRenameCurrentContext(t *testing.T) {
+	k, err := parseKubeconfig(testKubeconfig("first"))
+	require.NoError(t, err)
+
+	k.renameCurrentContext("example")
+	require.Equal(t, "example", k.CurrentContext)
+	require.Equal(t, "example", k.Contexts[0].Name)
+	require.Equal(t, "first", k.Contexts[0].Context.Cluster)
+}
+
+func TestKubeconfigUseExec(t *testing.T) {
+	k, err := parseKubeconfig(testKubeconfig("first"))
+	require.NoError(t, err)
+
+	k.useExec(kubeconfigExecOptions{
+		Command: "credential-plugin",
+		Args:    []string{"token", "--cluster", "0dbc8b0a-2d62-4e27-9a2e-3fbe5b6bd1c0"},
+		Env:     map[string]string{"B": "b", "A": "a"},
+	})
+
+	user := k.Users[0].User
+	require.Empty(t, user.ClientCertificateData)
+	require.Empty(t, user.ClientKeyData)
+	require.NotNil(t, user.Exec)
+	require.Equal(t, "credential-plugin", user.Exec.Command)
+	require.Equal(t, []string{"token", "--cluster", "0dbc8b0a-2d62-4e27-9a2e-3fbe5b6bd1c0"}, user.Exec.Args)
+	require.Equal(t, []kubeconfigUserExecEnv{
+		{Name: "A", Value: "a"},
+		{Name: "B", Value: "b"},
+	}, user.Exec.Env)
+	require.True(t, user.Exec.ProvideClusterInfo)
+
+	s, err := k.marshal()
+	require.NoError(t, err)
+	require.NotContains(t, s, "client-certificate-data")
+	require.Contains(t, s, "command: credential-plugin")
+}
+
+func TestKubeconfigPreservesUnknownFields(t *testing.T) {
+	k, err := parseKubeconfig(testKubeconfig("first"))
+	require.NoError(t, err)
+
+	k.renameCurrentContext("example")
+
+	s, err := k.marshal()
+	require.NoError(t, err)
+
+	rendered, err := parseKubeconfig(s)
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{}, rendered.Other["preferences"])
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			"extension": map[string]interface{}{"example": "value"},
+			"name":      "example",
+		},
+	}, rendered.Clusters[0].Cluster.Other["extensions"])
+}
+
+func TestKubeconfigMerge(t *testing.T) {
+	k, err := parseKubeconfig(testKubeconfig("first"))
+	require.NoError(t, err)
+
+	other, err := parseKubeconfig(testKubeconfig("second"))
+	require.NoError(t, err)
+
+	require.NoError(t, k.merge(other))
+	require.Len(t, k.Clusters, 2)
+	require.Len(t, k.Contexts, 2)
+	require.Len(t, k.Users, 2)
+	require.Equal(t, "first-admin@first", k.CurrentContext)
+
+	duplicate, err := parseKubeconfig(testKubeconfig("second"))
+	require.NoError(t, err)
+	require.EqualError(t, k.merge(duplicate), "kubeconfig already contains a cluster named second")
+}
+
+func TestKubeconfigExecBlocksMatch(t *testing.T) {
+	dataSource := kubeconfigExecDataSourceBlock()
+	ephemeral := kubeconfigExecEphemeralBlock()
+
+	require.Equal(t, dataSource.GetMarkdownDescription(), ephemeral.GetMarkdownDescription())
+	require.Equal(t, len(dataSource.ListValidators()), len(ephemeral.ListValidators()))
+
+	dataSourceAttrs := dataSource.GetNestedObject().GetAttributes()
+	ephemeralAttrs := ephemeral.GetNestedObject().GetAttributes()
+	require.Len(t, ephemeralAttrs, len(dataSourceAttrs))
+	for name, expected := range dataSourceAttrs {
+		actual, ok := ephemeralAttrs[name]
+		require.True(t, ok, name)
+		require.Equal(t, expected.GetType(), actual.GetType(), name)
+		require.Equal(t, expected.GetMarkdownDescription(), actual.GetMarkdownDescription(), name)
+		require.Equal(t, expected.IsRequired(), actual.IsRequired(), name)
+		require.Equal(t, expected.IsOptional(), actual.IsOptional(), name)
+		require.Equal(t, expected.IsSensitive(), actual.IsSensitive(), name)
+	}
+}
@@ -33,6 +33,13 @@ const (
 	upgradeStrategyDescription = "The upgrade strategy to use when changing the cluster `version`. If not set, `manual` strategy will be used by default. When using `manual` strategy, you must replace the existing node-groups to update them."
 	zoneDescription            = "Zone in which the Kubernetes cluster will be hosted, e.g. `de-fra1`. You can list available zones with `upctl zone list`."
 
+	additionalClustersDescription = "UUIDs of additional clusters to include in the `kubeconfig`. The current context of the `kubeconfig` will still point to the cluster defined in `id`."
+	contextNameDescription        = "Name of the current context in the `kubeconfig`. Defaults to the context name returned by the API."
+	execDescription               = "Use an [exec credential plugin](https://kubernetes.io/docs/reference/access-authn-authz/authentication/#client-go-credential-plugins) to authenticate to the cluster in the `kubeconfig` instead of the client certificate. The same command is used for all clusters in the `kubeconfig`. Cluster info, e.g. the API server address, is provided to the plugin in `KUBERNETES_EXEC_INFO` environment variable. The client certificate fields are still populated from the client certificate returned by the API."
+	execCommandDescription        = "Command to execute to get the credentials, e.g. a path to a credential plugin binary."
+	execArgsDescription           = "Arguments to pass to the command. Use these to select the cluster and to set the lifetime of the requested tokens, e.g. `[\"token\", \"--lifetime\", \"1h\"]`. The token lifetime is controlled by the credential plugin, so the provider does not have a separate option for it."
+	execEnvDescription            = "Additional environment variables to set when executing the command."
+
 	resourceNameMaxLength = 63
 	resourceNameRegexpStr = "^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
 )
@@ -48,7 +55,7 @@ func getClusterDeleted(ctx context.Context, svc *service.Service, id ...string)
 func waitForClusterToBeDeleted(ctx context.Context, svc *service.Service, id string) (diags diag.Diagnostics) {
 	err := utils.WaitForResourceToBeDeleted(ctx, svc, getClusterDeleted, id)
 	if err != nil {
-		diags.AddError("Error waiting for cluster to be deleted", err.Error())
+		diags.AddError("Error waiting for cluster to be deleted", utils.WaitErrorDiagnosticDetail(ctx, err))
 	}
 	return diags
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -38,6 +39,7 @@ var (
 	_ resource.Resource                = &kubernetesNodeGroupResource{}
 	_ resource.ResourceWithConfigure   = &kubernetesNodeGroupResource{}
 	_ resource.ResourceWithImportState = &kubernetesNodeGroupResource{}
+	_ resource.ResourceWithIdentity    = &kubernetesNodeGroupResource{}
 )
 
 var validTaintKeyRegExp = regexp.MustCompile("^[ -^`-~]+[ -~]*$") // Printable ASCII characters: ' ' (Space), ..., '^', '_', '`', ..., `~`
@@ -64,22 +66,23 @@ func (r *kubernetesNodeGroupResource) Configure(_ context.Context, req resource.
 }
 
 type kubernetesNodeGroupModel struct {
-	AntiAffinity         types.Bool   `tfsdk:"anti_affinity"`
-	Cluster              types.String `tfsdk:"cluster"`
-	CustomPlan           types.List   `tfsdk:"custom_plan"`
-	GPUPlan              types.List   `tfsdk:"gpu_plan"`
-	CloudNativePlan      types.List   `tfsdk:"cloud_native_plan"`
-	KubeletArgs          types.Set    `tfsdk:"kubelet_args"`
-	ID                   types.String `tfsdk:"id"`
-	Labels               types.Map    `tfsdk:"labels"`
-	Name                 types.String `tfsdk:"name"`
-	NamePrefix           types.String `tfsdk:"name_prefix"`
-	NodeCount            types.Int64  `tfsdk:"node_count"`
-	Plan                 types.String `tfsdk:"plan"`
-	SSHKeys              types.Set    `tfsdk:"ssh_keys"`
-	StorageEncryption    types.String `tfsdk:"storage_encryption"`
-	Taint                types.Set    `tfsdk:"taint"`
-	UtilityNetworkAccess types.Bool   `tfsdk:"utility_network_access"`
+	AntiAffinity         types.Bool     `tfsdk:"anti_affinity"`
+	Cluster              types.String   `tfsdk:"cluster"`
+	CustomPlan           types.List     `tfsdk:"custom_plan"`
+	GPUPlan              types.List     `tfsdk:"gpu_plan"`
+	CloudNativePlan      types.List     `tfsdk:"cloud_native_plan"`
+	KubeletArgs          types.Set      `tfsdk:"kubelet_args"`
+	ID                   types.String   `tfsdk:"id"`
+	Labels               types.Map      `tfsdk:"labels"`
+	Name                 types.String   `tfsdk:"name"`
+	NamePrefix           types.String   `tfsdk:"name_prefix"`
+	NodeCount            types.Int64    `tfsdk:"node_count"`
+	Plan                 types.String   `tfsdk:"plan"`
+	SSHKeys              types.Set      `tfsdk:"ssh_keys"`
+	StorageEncryption    types.String   `tfsdk:"storage_encryption"`
+	Taint                types.Set      `tfsdk:"taint"`
+	UtilityNetworkAccess types.Bool     `tfsdk:"utility_network_access"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
 }
 
 type customPlanModel struct {
@@ -110,7 +113,7 @@ type taintModel struct {
 	Value  types.String `tfsdk:"value"`
 }
 
-func (r *kubernetesNodeGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *kubernetesNodeGroupResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	nameReValidator := stringvalidator.RegexMatches(resourceNameRegexp, fmt.Sprintf("name should only contain lowercase alphanumeric characters and dashes (a-z, 0-9, -). Name should not start or end with a dash. Regular expresion used to check validation: %s", resourceNameRegexp))
 
 	resp.Schema = schema.Schema{
@@ -224,6 +227,7 @@ func (r *kubernetesNodeGroupResource) Schema(_ context.Context, _ resource.Schem
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
 			"custom_plan": schema.ListNestedBlock{
 				MarkdownDescription: "Resource properties for custom plan. This block is required for `custom` plans only.",
 				PlanModifiers: []planmodifier.List{
@@ -444,6 +448,13 @@ func (r *kubernetesNodeGroupResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	ctx, cancel, diags := utils.TimeoutContext(ctx, data.Timeouts, utils.TimeoutCreate)
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var labels map[string]string
 	if !data.Labels.IsNull() && !data.Labels.IsUnknown() {
 		resp.Diagnostics.Append(data.Labels.ElementsAs(ctx, &labels, false)...)
@@ -458,7 +469,6 @@ func (r *kubernetesNodeGroupResource) Create(ctx context.Context, req resource.C
 	var customPlan *upcloud.KubernetesNodeGroupCustomPlan
 	var gpuPlan *upcloud.KubernetesNodeGroupGPUPlan
 	var cloudNativePlan *upcloud.KubernetesNodeGroupCloudNativePlan
-	var diags diag.Diagnostics
 
 	planType := data.Plan.ValueString()
 	if planType == "custom" {
@@ -534,13 +544,14 @@ func (r *kubernetesNodeGroupResource) Create(ctx context.Context, req resource.C
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error while waiting for Kubernetes node group to be in running state",
-			utils.ErrorDiagnosticDetail(err),
+			utils.WaitErrorDiagnosticDetail(ctx, err),
 		)
 		return
 	}
 
 	resp.Diagnostics.Append(setNodeGroupValues(ctx, &data, ng)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, nodeGroupIdentity, data.ID.ValueString())...)
 }
 
 func (r *kubernetesNodeGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -586,6 +597,7 @@ func (r *kubernetesNodeGroupResource) Read(ctx context.Context, req resource.Rea
 
 	resp.Diagnostics.Append(setNodeGroupValues(ctx, &data, &nodeGroup.KubernetesNodeGroup)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, nodeGroupIdentity, data.ID.ValueString())...)
 }
 
 func (r *kubernetesNodeGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -602,6 +614,15 @@ func (r *kubernetesNodeGroupResource) Update(ctx context.Context, req resource.U
 
 	// Compare node count attribute value between plan and prior state
 	if data.NodeCount.Equal(nodeCountState) {
+		// Only timeouts can be updated in-place without node count change.
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeouts"), data.Timeouts)...)
+		return
+	}
+
+	ctx, cancel, diags := utils.TimeoutContext(ctx, data.Timeouts, utils.TimeoutUpdate)
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -632,7 +653,7 @@ func (r *kubernetesNodeGroupResource) Update(ctx context.Context, req resource.U
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error while waiting for Kubernetes ng to be in running state",
-			utils.ErrorDiagnosticDetail(err),
+			utils.WaitErrorDiagnosticDetail(ctx, err),
 		)
 		return
 	}
@@ -645,6 +666,17 @@ func (r *kubernetesNodeGroupResource) Delete(ctx context.Context, req resource.D
 	var data kubernetesNodeGroupModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := utils.TimeoutContext(ctx, data.Timeouts, utils.TimeoutDelete)
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := r.client.DeleteKubernetesNodeGroup(ctx, &request.DeleteKubernetesNodeGroupRequest{
 		ClusterUUID: data.Cluster.ValueString(),
 		Name:        data.Name.ValueString(),
@@ -659,8 +691,17 @@ func (r *kubernetesNodeGroupResource) Delete(ctx context.Context, req resource.D
 	resp.Diagnostics.Append(waitForNodeGroupToBeDeleted(ctx, r.client, data.Cluster.ValueString(), data.Name.ValueString())...)
 }
 
+var nodeGroupIdentity = []utils.IdentityAttribute{
+	{Name: "cluster", Description: "UUID of the cluster."},
+	{Name: "name", Description: "Name of the node group."},
+}
+
+func (r *kubernetesNodeGroupResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(nodeGroupIdentity)
+}
+
 func (r *kubernetesNodeGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, nodeGroupIdentity, req, resp)
 }
 
 func setNodeGroupValues(ctx context.Context, data *kubernetesNodeGroupModel, ng *upcloud.KubernetesNodeGroup) diag.Diagnostics {
@@ -907,7 +948,7 @@ func getNodeGroupDeleted(ctx context.Context, svc *service.Service, id ...string
 func waitForNodeGroupToBeDeleted(ctx context.Context, svc *service.Service, clusterUUID, name string) (diags diag.Diagnostics) {
 	err := utils.WaitForResourceToBeDeleted(ctx, svc, getNodeGroupDeleted, clusterUUID, name)
 	if err != nil {
-		diags.AddError("Error waiting for node group to be deleted", err.Error())
+		diags.AddError("Error waiting for node group to be deleted", utils.WaitErrorDiagnosticDetail(ctx, err))
 	}
 	return diags
 }
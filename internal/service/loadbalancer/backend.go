@@ -12,7 +12,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -28,6 +27,7 @@ var (
 	_ resource.Resource                 = &backendResource{}
 	_ resource.ResourceWithConfigure    = &backendResource{}
 	_ resource.ResourceWithImportState  = &backendResource{}
+	_ resource.ResourceWithIdentity     = &backendResource{}
 	_ resource.ResourceWithUpgradeState = &backendResource{}
 )
 
@@ -320,24 +320,7 @@ func setBackendValues(ctx context.Context, data *backendModel, backend *upcloud.
 	data.Name = types.StringValue(backend.Name)
 
 	if !data.Properties.IsNull() || isImport {
-		properties := make([]backendPropertiesModel, 1)
-		properties[0].HealthCheckExpectedStatus = types.Int64Value(int64(backend.Properties.HealthCheckExpectedStatus))
-		properties[0].HealthCheckFall = types.Int64Value(int64(backend.Properties.HealthCheckFall))
-		properties[0].HealthCheckInterval = types.Int64Value(int64(backend.Properties.HealthCheckInterval))
-		properties[0].HealthCheckRise = types.Int64Value(int64(backend.Properties.HealthCheckRise))
-		properties[0].HealthCheckTLSVerify = types.BoolValue(*backend.Properties.HealthCheckTLSVerify)
-		properties[0].HealthCheckType = types.StringValue(string(backend.Properties.HealthCheckType))
-		properties[0].HealthCheckURL = types.StringValue(backend.Properties.HealthCheckURL)
-		properties[0].HTTP2Enabled = types.BoolValue(*backend.Properties.HTTP2Enabled)
-		properties[0].OutboundProxyProtocol = types.StringValue(string(backend.Properties.OutboundProxyProtocol))
-		properties[0].StickySessionCookieName = types.StringValue(backend.Properties.StickySessionCookieName)
-		properties[0].TimeoutServer = types.Int64Value(int64(backend.Properties.TimeoutServer))
-		properties[0].TimeoutTunnel = types.Int64Value(int64(backend.Properties.TimeoutTunnel))
-		properties[0].TLSEnabled = types.BoolValue(*backend.Properties.TLSEnabled)
-		properties[0].TLSUseSystemCA = types.BoolValue(*backend.Properties.TLSUseSystemCA)
-		properties[0].TLSVerify = types.BoolValue(*backend.Properties.TLSVerify)
-
-		data.Properties, diags = types.ListValueFrom(ctx, data.Properties.ElementType(ctx), properties)
+		data.Properties, diags = types.ListValueFrom(ctx, data.Properties.ElementType(ctx), backendPropertiesValues(backend))
 		respDiagnostics.Append(diags...)
 	}
 
@@ -354,6 +337,27 @@ func setBackendValues(ctx context.Context, data *backendModel, backend *upcloud.
 	return respDiagnostics
 }
 
+func backendPropertiesValues(backend *upcloud.LoadBalancerBackend) []backendPropertiesModel {
+	properties := make([]backendPropertiesModel, 1)
+	properties[0].HealthCheckExpectedStatus = types.Int64Value(int64(backend.Properties.HealthCheckExpectedStatus))
+	properties[0].HealthCheckFall = types.Int64Value(int64(backend.Properties.HealthCheckFall))
+	properties[0].HealthCheckInterval = types.Int64Value(int64(backend.Properties.HealthCheckInterval))
+	properties[0].HealthCheckRise = types.Int64Value(int64(backend.Properties.HealthCheckRise))
+	properties[0].HealthCheckTLSVerify = types.BoolValue(*backend.Properties.HealthCheckTLSVerify)
+	properties[0].HealthCheckType = types.StringValue(string(backend.Properties.HealthCheckType))
+	properties[0].HealthCheckURL = types.StringValue(backend.Properties.HealthCheckURL)
+	properties[0].HTTP2Enabled = types.BoolValue(*backend.Properties.HTTP2Enabled)
+	properties[0].OutboundProxyProtocol = types.StringValue(string(backend.Properties.OutboundProxyProtocol))
+	properties[0].StickySessionCookieName = types.StringValue(backend.Properties.StickySessionCookieName)
+	properties[0].TimeoutServer = types.Int64Value(int64(backend.Properties.TimeoutServer))
+	properties[0].TimeoutTunnel = types.Int64Value(int64(backend.Properties.TimeoutTunnel))
+	properties[0].TLSEnabled = types.BoolValue(*backend.Properties.TLSEnabled)
+	properties[0].TLSUseSystemCA = types.BoolValue(*backend.Properties.TLSUseSystemCA)
+	properties[0].TLSVerify = types.BoolValue(*backend.Properties.TLSVerify)
+
+	return properties
+}
+
 func buildBackendProperties(ctx context.Context, dataProperties types.List) (*upcloud.LoadBalancerBackendProperties, request.ModifyLoadBalancerBackendClearProperties, diag.Diagnostics) {
 	clearProperties := request.ModifyLoadBalancerBackendClearProperties{}
 
@@ -430,6 +434,7 @@ func (r *backendResource) Create(ctx context.Context, req resource.CreateRequest
 
 	resp.Diagnostics.Append(setBackendValues(ctx, &data, backend)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, backendIdentity, data.ID.ValueString())...)
 }
 
 func (r *backendResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -474,6 +479,7 @@ func (r *backendResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	resp.Diagnostics.Append(setBackendValues(ctx, &data, backend)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, backendIdentity, data.ID.ValueString())...)
 }
 
 func (r *backendResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -535,6 +541,15 @@ func (r *backendResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 }
 
+var backendIdentity = []utils.IdentityAttribute{
+	{Name: "loadbalancer", Description: "UUID of the load balancer."},
+	{Name: "name", Description: "Name of the backend."},
+}
+
+func (r *backendResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(backendIdentity)
+}
+
 func (r *backendResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, backendIdentity, req, resp)
 }
@@ -38,6 +38,12 @@ type backendMemberModel struct {
 	Weight      types.Int64  `tfsdk:"weight"`
 }
 
+var backendMemberIdentity = []utils.IdentityAttribute{
+	{Name: "loadbalancer", Description: "UUID of the load balancer."},
+	{Name: "backend", Description: "Name of the backend."},
+	{Name: "name", Description: "Name of the backend member."},
+}
+
 func backendMemberSchema() schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
@@ -171,6 +177,7 @@ func (r *backendMemberResource) create(ctx context.Context, req resource.CreateR
 
 	resp.Diagnostics.Append(setBackendMemberValues(ctx, &data, member)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, backendMemberIdentity, data.ID.ValueString())...)
 }
 
 func (r *backendMemberResource) read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -217,6 +224,7 @@ func (r *backendMemberResource) read(ctx context.Context, req resource.ReadReque
 
 	resp.Diagnostics.Append(setBackendMemberValues(ctx, &data, member)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, backendMemberIdentity, data.ID.ValueString())...)
 }
 
 func (r *backendMemberResource) update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
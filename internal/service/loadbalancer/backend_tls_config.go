@@ -10,7 +10,6 @@ import (
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -23,6 +22,7 @@ var (
 	_ resource.Resource                = &backendTLSConfigResource{}
 	_ resource.ResourceWithConfigure   = &backendTLSConfigResource{}
 	_ resource.ResourceWithImportState = &backendTLSConfigResource{}
+	_ resource.ResourceWithIdentity    = &backendTLSConfigResource{}
 )
 
 func NewBackendTLSConfigResource() resource.Resource {
@@ -145,6 +145,7 @@ func (r *backendTLSConfigResource) Create(ctx context.Context, req resource.Crea
 
 	resp.Diagnostics.Append(setBackendTLSConfigValues(ctx, &data, tlsConfig)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, backendTLSConfigIdentity, data.ID.ValueString())...)
 }
 
 func (r *backendTLSConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -191,6 +192,7 @@ func (r *backendTLSConfigResource) Read(ctx context.Context, req resource.ReadRe
 
 	resp.Diagnostics.Append(setBackendTLSConfigValues(ctx, &data, tlsConfig)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, backendTLSConfigIdentity, data.ID.ValueString())...)
 }
 
 func (r *backendTLSConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -260,6 +262,16 @@ func (r *backendTLSConfigResource) Delete(ctx context.Context, req resource.Dele
 	}
 }
 
+var backendTLSConfigIdentity = []utils.IdentityAttribute{
+	{Name: "loadbalancer", Description: "UUID of the load balancer."},
+	{Name: "backend", Description: "Name of the backend."},
+	{Name: "name", Description: "Name of the TLS config."},
+}
+
+func (r *backendTLSConfigResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(backendTLSConfigIdentity)
+}
+
 func (r *backendTLSConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, backendTLSConfigIdentity, req, resp)
 }
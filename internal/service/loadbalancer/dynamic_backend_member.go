@@ -6,7 +6,6 @@ import (
 	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
 
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
@@ -14,6 +13,7 @@ var (
 	_ resource.Resource                = &backendDynamicMemberResource{}
 	_ resource.ResourceWithConfigure   = &backendDynamicMemberResource{}
 	_ resource.ResourceWithImportState = &backendDynamicMemberResource{}
+	_ resource.ResourceWithIdentity    = &backendDynamicMemberResource{}
 )
 
 func NewBackendDynamicMemberResource() resource.Resource {
@@ -54,6 +54,10 @@ func (r *backendDynamicMemberResource) Delete(ctx context.Context, req resource.
 	r.delete(ctx, req, resp)
 }
 
+func (r *backendDynamicMemberResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(backendMemberIdentity)
+}
+
 func (r *backendDynamicMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, backendMemberIdentity, req, resp)
 }
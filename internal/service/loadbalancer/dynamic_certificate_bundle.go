@@ -12,7 +12,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -25,6 +24,7 @@ var (
 	_ resource.Resource                = &dynamicCertificateBundleResource{}
 	_ resource.ResourceWithConfigure   = &dynamicCertificateBundleResource{}
 	_ resource.ResourceWithImportState = &dynamicCertificateBundleResource{}
+	_ resource.ResourceWithIdentity    = &dynamicCertificateBundleResource{}
 )
 
 func NewDynamicCertificateBundleResource() resource.Resource {
@@ -155,6 +155,7 @@ func (r *dynamicCertificateBundleResource) Create(ctx context.Context, req resou
 
 	resp.Diagnostics.Append(setDynamicCertificateBundleValues(ctx, &data, bundle)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, dynamicCertificateBundleIdentity, data.ID.ValueString())...)
 }
 
 func (r *dynamicCertificateBundleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -188,6 +189,7 @@ func (r *dynamicCertificateBundleResource) Read(ctx context.Context, req resourc
 
 	resp.Diagnostics.Append(setDynamicCertificateBundleValues(ctx, &data, bundle)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, dynamicCertificateBundleIdentity, data.ID.ValueString())...)
 }
 
 func (r *dynamicCertificateBundleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -232,6 +234,12 @@ func (r *dynamicCertificateBundleResource) Delete(ctx context.Context, req resou
 	}
 }
 
+var dynamicCertificateBundleIdentity = utils.UUIDIdentity("certificate bundle")
+
+func (r *dynamicCertificateBundleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(dynamicCertificateBundleIdentity)
+}
+
 func (r *dynamicCertificateBundleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, dynamicCertificateBundleIdentity, req, resp)
 }
@@ -12,7 +12,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -33,6 +32,7 @@ var (
 	_ resource.Resource                = &frontendResource{}
 	_ resource.ResourceWithConfigure   = &frontendResource{}
 	_ resource.ResourceWithImportState = &frontendResource{}
+	_ resource.ResourceWithIdentity    = &frontendResource{}
 )
 
 func NewFrontendResource() resource.Resource {
@@ -223,12 +223,7 @@ func setFrontendValues(ctx context.Context, data *frontendModel, frontend *upclo
 	respDiagnostics.Append(diags...)
 
 	if !data.Properties.IsNull() || isImport {
-		properties := make([]propertiesModel, 1)
-		properties[0].TimeoutClient = types.Int64Value(int64(frontend.Properties.TimeoutClient))
-		properties[0].InboundProxyProtocol = asBool(frontend.Properties.InboundProxyProtocol)
-		properties[0].HTTP2Enabled = asBool(frontend.Properties.HTTP2Enabled)
-
-		data.Properties, diags = types.ListValueFrom(ctx, data.Properties.ElementType(ctx), properties)
+		data.Properties, diags = types.ListValueFrom(ctx, data.Properties.ElementType(ctx), frontendPropertiesValues(frontend))
 		respDiagnostics.Append(diags...)
 	}
 
@@ -245,6 +240,15 @@ func setFrontendValues(ctx context.Context, data *frontendModel, frontend *upclo
 	return respDiagnostics
 }
 
+func frontendPropertiesValues(frontend *upcloud.LoadBalancerFrontend) []propertiesModel {
+	properties := make([]propertiesModel, 1)
+	properties[0].TimeoutClient = types.Int64Value(int64(frontend.Properties.TimeoutClient))
+	properties[0].InboundProxyProtocol = asBool(frontend.Properties.InboundProxyProtocol)
+	properties[0].HTTP2Enabled = asBool(frontend.Properties.HTTP2Enabled)
+
+	return properties
+}
+
 func buildNetworks(ctx context.Context, dataNetworks types.Set) ([]upcloud.LoadBalancerFrontendNetwork, diag.Diagnostics) {
 	var planNetworks []networkModel
 	respDiagnostics := dataNetworks.ElementsAs(ctx, &planNetworks, false)
@@ -322,6 +326,7 @@ func (r *frontendResource) Create(ctx context.Context, req resource.CreateReques
 
 	resp.Diagnostics.Append(setFrontendValues(ctx, &data, frontend)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, frontendIdentity, data.ID.ValueString())...)
 }
 
 func (r *frontendResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -365,6 +370,7 @@ func (r *frontendResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	resp.Diagnostics.Append(setFrontendValues(ctx, &data, frontend)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, frontendIdentity, data.ID.ValueString())...)
 }
 
 func (r *frontendResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -431,6 +437,15 @@ func (r *frontendResource) Delete(ctx context.Context, req resource.DeleteReques
 	}
 }
 
+var frontendIdentity = []utils.IdentityAttribute{
+	{Name: "loadbalancer", Description: "UUID of the load balancer."},
+	{Name: "name", Description: "Name of the frontend."},
+}
+
+func (r *frontendResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(frontendIdentity)
+}
+
 func (r *frontendResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, frontendIdentity, req, resp)
 }
@@ -0,0 +1,351 @@
+package loadbalancer
+
+import (
+	"context"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	validatorutil "github.com/UpCloudLtd/terraform-provider-upcloud/internal/validator"
+)
+
+const (
+	frontendIPFilterTypeAllow = "allow"
+	frontendIPFilterTypeDeny  = "deny"
+
+	frontendIPFilterMaxAddresses = 100
+)
+
+var (
+	_ resource.Resource                = &frontendIPFilterResource{}
+	_ resource.ResourceWithConfigure   = &frontendIPFilterResource{}
+	_ resource.ResourceWithImportState = &frontendIPFilterResource{}
+	_ resource.ResourceWithIdentity    = &frontendIPFilterResource{}
+)
+
+func NewFrontendIPFilterResource() resource.Resource {
+	return &frontendIPFilterResource{}
+}
+
+type frontendIPFilterResource struct {
+	client *service.Service
+}
+
+func (r *frontendIPFilterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_loadbalancer_frontend_ip_filter"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *frontendIPFilterResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = utils.GetClientFromProviderData(req.ProviderData)
+}
+
+type frontendIPFilterModel struct {
+	ID        types.String `tfsdk:"id"`
+	Frontend  types.String `tfsdk:"frontend"`
+	Name      types.String `tfsdk:"name"`
+	Type      types.String `tfsdk:"type"`
+	Priority  types.Int64  `tfsdk:"priority"`
+	Addresses types.Set    `tfsdk:"addresses"`
+}
+
+func (r *frontendIPFilterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource represents an IP filter rule of a load balancer frontend. The filter is a standalone frontend rule that matches the source IP address of the connection against the given addresses and rejects the connection with `tcp_reject` action: an `allow` filter rejects connections from addresses that are not in the filter, and a `deny` filter rejects connections from addresses that are in the filter. The filter can not be referenced from the matchers of other frontend rules, so it only works as an allow or deny gate for the whole frontend. Changing the addresses replaces only the rule of the filter, so the other rules of the frontend are not modified. Do not use this resource together with `upcloud_loadbalancer_frontend_rules` resource for the same frontend, as it removes rules that are not defined in it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the IP filter rule. ID is in `{load balancer UUID}/{frontend name}/{name}` format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"frontend": schema.StringAttribute{
+				MarkdownDescription: "ID of the load balancer frontend to which the IP filter rule is connected.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the IP filter rule. It must be unique within the frontend rules.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					nameValidator,
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the IP filter: `allow` rejects connections from addresses that are not in `addresses` and `deny` rejects connections from addresses that are in `addresses`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(frontendIPFilterTypeAllow, frontendIPFilterTypeDeny),
+				},
+			},
+			"priority": schema.Int64Attribute{
+				MarkdownDescription: "Priority of the IP filter rule. Rule with the higher priority goes first. To apply the filter before other rules, use a higher priority than in the other rules of the frontend.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 100),
+				},
+			},
+			"addresses": schema.SetAttribute{
+				MarkdownDescription: "IP addresses to filter. CIDR masks are supported, e.g. `192.168.0.0/24`.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeBetween(1, frontendIPFilterMaxAddresses),
+					setvalidator.ValueStringsAre(
+						stringvalidator.Any(
+							validatorutil.NewFrameworkStringValidator(validation.IsIPAddress),
+							validatorutil.NewFrameworkStringValidator(validation.IsCIDR),
+						),
+					),
+				},
+			},
+		},
+	}
+}
+
+// buildFrontendIPFilterRule returns the frontend rule for the IP filter. Allow filter matches when the source IP does not
+// match any of the addresses, and deny filter matches when the source IP matches any of the addresses.
+func buildFrontendIPFilterRule(ctx context.Context, data *frontendIPFilterModel) (request.LoadBalancerFrontendRule, diag.Diagnostics) {
+	addresses, diags := utils.SetAsSliceOfStrings(ctx, data.Addresses)
+	if diags.HasError() {
+		return request.LoadBalancerFrontendRule{}, diags
+	}
+
+	allow := data.Type.ValueString() == frontendIPFilterTypeAllow
+
+	matchingCondition := upcloud.LoadBalancerMatchingConditionOr
+	if allow {
+		matchingCondition = upcloud.LoadBalancerMatchingConditionAnd
+	}
+
+	matchers := make([]upcloud.LoadBalancerMatcher, 0, len(addresses))
+	for _, address := range addresses {
+		matchers = appendMatcher(matchers, request.NewLoadBalancerSrcIPMatcher(address), allow)
+	}
+
+	return request.LoadBalancerFrontendRule{
+		Name:              data.Name.ValueString(),
+		Priority:          int(data.Priority.ValueInt64()),
+		MatchingCondition: matchingCondition,
+		Matchers:          matchers,
+		Actions: []upcloud.LoadBalancerAction{
+			request.NewLoadBalancerTCPRejectAction(),
+		},
+	}, diags
+}
+
+func setFrontendIPFilterValues(ctx context.Context, data *frontendIPFilterModel, loadBalancer, frontendName string, frontendRule *upcloud.LoadBalancerFrontendRule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Frontend = types.StringValue(utils.MarshalID(loadBalancer, frontendName))
+	data.Name = types.StringValue(frontendRule.Name)
+	data.Priority = types.Int64Value(int64(frontendRule.Priority))
+
+	addresses := make([]string, 0, len(frontendRule.Matchers))
+	allow := len(frontendRule.Matchers) > 0
+	for _, m := range frontendRule.Matchers {
+		if m.SrcIP == nil {
+			continue
+		}
+		addresses = append(addresses, m.SrcIP.Value)
+		if m.Inverse == nil || !*m.Inverse {
+			allow = false
+		}
+	}
+
+	data.Type = types.StringValue(frontendIPFilterTypeDeny)
+	if allow {
+		data.Type = types.StringValue(frontendIPFilterTypeAllow)
+	}
+
+	data.Addresses, diags = types.SetValueFrom(ctx, types.StringType, addresses)
+	return diags
+}
+
+func (r *frontendIPFilterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data frontendIPFilterModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var loadBalancer, frontendName string
+	if err := utils.UnmarshalID(data.Frontend.ValueString(), &loadBalancer, &frontendName); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to unmarshal loadbalancer frontend name",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	rule, diags := buildFrontendIPFilterRule(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	frontendRule, err := r.client.CreateLoadBalancerFrontendRule(ctx, &request.CreateLoadBalancerFrontendRuleRequest{
+		ServiceUUID:  loadBalancer,
+		FrontendName: frontendName,
+		Rule:         rule,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create loadbalancer frontend IP filter rule",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(utils.MarshalID(loadBalancer, frontendName, data.Name.ValueString()))
+
+	resp.Diagnostics.Append(setFrontendIPFilterValues(ctx, &data, loadBalancer, frontendName, frontendRule)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, frontendIPFilterIdentity, data.ID.ValueString())...)
+}
+
+func (r *frontendIPFilterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data frontendIPFilterModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.ValueString() == "" {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	var loadBalancer, frontendName, name string
+	if err := utils.UnmarshalID(data.ID.ValueString(), &loadBalancer, &frontendName, &name); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to unmarshal loadbalancer frontend IP filter rule ID",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	frontendRule, err := r.client.GetLoadBalancerFrontendRule(ctx, &request.GetLoadBalancerFrontendRuleRequest{
+		ServiceUUID:  loadBalancer,
+		FrontendName: frontendName,
+		Name:         name,
+	})
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError(
+				"Unable to read loadbalancer frontend IP filter rule details",
+				utils.ErrorDiagnosticDetail(err),
+			)
+		}
+		return
+	}
+
+	resp.Diagnostics.Append(setFrontendIPFilterValues(ctx, &data, loadBalancer, frontendName, frontendRule)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, frontendIPFilterIdentity, data.ID.ValueString())...)
+}
+
+func (r *frontendIPFilterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data frontendIPFilterModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var loadBalancer, frontendName, name string
+	if err := utils.UnmarshalID(data.ID.ValueString(), &loadBalancer, &frontendName, &name); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to unmarshal loadbalancer frontend IP filter rule ID",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	rule, diags := buildFrontendIPFilterRule(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Matchers of a rule can not be modified, so the rule is replaced in a single request.
+	frontendRule, err := r.client.ReplaceLoadBalancerFrontendRule(ctx, &request.ReplaceLoadBalancerFrontendRuleRequest{
+		ServiceUUID:  loadBalancer,
+		FrontendName: frontendName,
+		Name:         name,
+		Rule:         rule,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to modify loadbalancer frontend IP filter rule",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setFrontendIPFilterValues(ctx, &data, loadBalancer, frontendName, frontendRule)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *frontendIPFilterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data frontendIPFilterModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	var loadBalancer, frontendName, name string
+	if err := utils.UnmarshalID(data.ID.ValueString(), &loadBalancer, &frontendName, &name); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to unmarshal loadbalancer frontend IP filter rule ID",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	if err := r.client.DeleteLoadBalancerFrontendRule(ctx, &request.DeleteLoadBalancerFrontendRuleRequest{
+		ServiceUUID:  loadBalancer,
+		FrontendName: frontendName,
+		Name:         name,
+	}); err != nil && !utils.IsNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Unable to delete loadbalancer frontend IP filter rule",
+			utils.ErrorDiagnosticDetail(err),
+		)
+	}
+}
+
+var frontendIPFilterIdentity = []utils.IdentityAttribute{
+	{Name: "loadbalancer", Description: "UUID of the load balancer."},
+	{Name: "frontend", Description: "Name of the frontend."},
+	{Name: "name", Description: "Name of the IP filter rule."},
+}
+
+func (r *frontendIPFilterResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(frontendIPFilterIdentity)
+}
+
+func (r *frontendIPFilterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	utils.ImportStateWithIdentity(ctx, frontendIPFilterIdentity, req, resp)
+}
@@ -32,6 +32,7 @@ var (
 	_ resource.Resource                = &frontendRuleResource{}
 	_ resource.ResourceWithConfigure   = &frontendRuleResource{}
 	_ resource.ResourceWithImportState = &frontendRuleResource{}
+	_ resource.ResourceWithIdentity    = &frontendRuleResource{}
 )
 
 func NewFrontendRuleResource() resource.Resource {
@@ -123,424 +124,432 @@ func (r *frontendRuleResource) Schema(_ context.Context, _ resource.SchemaReques
 			},
 		},
 		Blocks: map[string]schema.Block{
-			"actions": schema.ListNestedBlock{
-				MarkdownDescription: "Rule actions.",
-				NestedObject: schema.NestedBlockObject{
-					Blocks: map[string]schema.Block{
-						"http_redirect": schema.ListNestedBlock{
-							MarkdownDescription: "Redirects HTTP requests to specified location or URL scheme. Only either location or scheme can be defined at a time.",
-							NestedObject: schema.NestedBlockObject{
-								Attributes: map[string]schema.Attribute{
-									"location": schema.StringAttribute{
-										MarkdownDescription: "Target location.",
-										Optional:            true,
-										PlanModifiers: []planmodifier.String{
-											stringplanmodifier.RequiresReplace(),
-										},
-										Validators: []validator.String{
-											stringvalidator.LengthAtLeast(1),
-											stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("scheme")),
-										},
-									},
-									"scheme": schema.StringAttribute{
-										MarkdownDescription: "Target scheme.",
-										Optional:            true,
-										PlanModifiers: []planmodifier.String{
-											stringplanmodifier.RequiresReplace(),
-										},
-										Validators: []validator.String{
-											stringvalidator.OneOf(
-												string(upcloud.LoadBalancerActionHTTPRedirectSchemeHTTP),
-												string(upcloud.LoadBalancerActionHTTPRedirectSchemeHTTPS),
-											),
-										},
-									},
-									"status": schema.Int64Attribute{
-										MarkdownDescription: "HTTP status code.",
-										Optional:            true,
-										Computed:            true,
-										Validators: []validator.Int64{
-											int64validator.OneOf(301, 302, 303, 307, 308),
-										},
-									},
+			"actions":  frontendRuleActionsBlock(),
+			"matchers": frontendRuleMatchersBlock(),
+		},
+	}
+}
+
+func frontendRuleActionsBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		MarkdownDescription: "Rule actions.",
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"http_redirect": schema.ListNestedBlock{
+					MarkdownDescription: "Redirects HTTP requests to specified location or URL scheme. Only either location or scheme can be defined at a time.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"location": schema.StringAttribute{
+								MarkdownDescription: "Target location.",
+								Optional:            true,
+								PlanModifiers: []planmodifier.String{
+									stringplanmodifier.RequiresReplace(),
 								},
-							},
-							Validators: []validator.List{
-								validateAtLeastOneAction("http_redirect"),
-							},
-						},
-						"http_rewrite_path": schema.ListNestedBlock{
-							MarkdownDescription: "Rewrites the HTTP request path using regex pattern matching.",
-							NestedObject: schema.NestedBlockObject{
-								Attributes: map[string]schema.Attribute{
-									"match_pattern": schema.StringAttribute{
-										MarkdownDescription: "Regex pattern to match against the request path.",
-										Required:            true,
-										PlanModifiers: []planmodifier.String{
-											stringplanmodifier.RequiresReplace(),
-										},
-										Validators: []validator.String{
-											stringvalidator.LengthBetween(1, 255),
-										},
-									},
-									"rewrite_to": schema.StringAttribute{
-										MarkdownDescription: "Replacement pattern.",
-										Required:            true,
-										PlanModifiers: []planmodifier.String{
-											stringplanmodifier.RequiresReplace(),
-										},
-										Validators: []validator.String{
-											stringvalidator.LengthBetween(0, 255),
-										},
-									},
+								Validators: []validator.String{
+									stringvalidator.LengthAtLeast(1),
+									stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("scheme")),
 								},
 							},
-						},
-						"http_rewrite_uri": schema.ListNestedBlock{
-							MarkdownDescription: "Rewrites the entire HTTP request URI using regex pattern matching.",
-							NestedObject: schema.NestedBlockObject{
-								Attributes: map[string]schema.Attribute{
-									"match_pattern": schema.StringAttribute{
-										MarkdownDescription: "Regex pattern to match against the request URI.",
-										Required:            true,
-										PlanModifiers: []planmodifier.String{
-											stringplanmodifier.RequiresReplace(),
-										},
-										Validators: []validator.String{
-											stringvalidator.LengthBetween(1, 255),
-										},
-									},
-									"rewrite_to": schema.StringAttribute{
-										MarkdownDescription: "Replacement pattern.",
-										Required:            true,
-										PlanModifiers: []planmodifier.String{
-											stringplanmodifier.RequiresReplace(),
-										},
-										Validators: []validator.String{
-											stringvalidator.LengthBetween(0, 255),
-										},
-									},
+							"scheme": schema.StringAttribute{
+								MarkdownDescription: "Target scheme.",
+								Optional:            true,
+								PlanModifiers: []planmodifier.String{
+									stringplanmodifier.RequiresReplace(),
 								},
-							},
-						},
-						"http_return": schema.ListNestedBlock{
-							MarkdownDescription: "Returns HTTP response with specified HTTP status.",
-							NestedObject: schema.NestedBlockObject{
-								Attributes: map[string]schema.Attribute{
-									"content_type": schema.StringAttribute{
-										MarkdownDescription: "Content type.",
-										Required:            true,
-										PlanModifiers: []planmodifier.String{
-											stringplanmodifier.RequiresReplace(),
-										},
-									},
-									"status": schema.Int64Attribute{
-										MarkdownDescription: "HTTP status code.",
-										Required:            true,
-										PlanModifiers: []planmodifier.Int64{
-											int64planmodifier.RequiresReplace(),
-										},
-										Validators: []validator.Int64{
-											int64validator.Between(100, 599),
-										},
-									},
-									"payload": schema.StringAttribute{
-										MarkdownDescription: "The payload.",
-										Required:            true,
-										PlanModifiers: []planmodifier.String{
-											stringplanmodifier.RequiresReplace(),
-										},
-										Validators: []validator.String{
-											stringvalidator.LengthBetween(1, 4096),
-										},
-									},
+								Validators: []validator.String{
+									stringvalidator.OneOf(
+										string(upcloud.LoadBalancerActionHTTPRedirectSchemeHTTP),
+										string(upcloud.LoadBalancerActionHTTPRedirectSchemeHTTPS),
+									),
 								},
 							},
-						},
-						"set_forwarded_headers": schema.ListNestedBlock{
-							MarkdownDescription: "Adds 'X-Forwarded-For / -Proto / -Port' headers in your forwarded requests",
-							NestedObject: schema.NestedBlockObject{
-								Attributes: map[string]schema.Attribute{
-									"active": schema.BoolAttribute{
-										Optional: true,
-										Computed: true,
-										Default:  booldefault.StaticBool(true),
-									},
+							"status": schema.Int64Attribute{
+								MarkdownDescription: "HTTP status code.",
+								Optional:            true,
+								Computed:            true,
+								Validators: []validator.Int64{
+									int64validator.OneOf(301, 302, 303, 307, 308),
 								},
 							},
-							PlanModifiers: []planmodifier.List{
-								listplanmodifier.RequiresReplace(),
-							},
-							Validators: []validator.List{
-								listvalidator.SizeBetween(0, 100),
-							},
 						},
-						"set_request_header": schema.ListNestedBlock{
-							MarkdownDescription: "Set request header",
-							NestedObject:        frontendRuleActionSetHeaderSchema(),
-							PlanModifiers: []planmodifier.List{
-								listplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.List{
+						validateAtLeastOneAction("http_redirect"),
+					},
+				},
+				"http_rewrite_path": schema.ListNestedBlock{
+					MarkdownDescription: "Rewrites the HTTP request path using regex pattern matching.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"match_pattern": schema.StringAttribute{
+								MarkdownDescription: "Regex pattern to match against the request path.",
+								Required:            true,
+								PlanModifiers: []planmodifier.String{
+									stringplanmodifier.RequiresReplace(),
+								},
+								Validators: []validator.String{
+									stringvalidator.LengthBetween(1, 255),
+								},
 							},
-							Validators: []validator.List{
-								listvalidator.SizeBetween(0, 100),
+							"rewrite_to": schema.StringAttribute{
+								MarkdownDescription: "Replacement pattern.",
+								Required:            true,
+								PlanModifiers: []planmodifier.String{
+									stringplanmodifier.RequiresReplace(),
+								},
+								Validators: []validator.String{
+									stringvalidator.LengthBetween(0, 255),
+								},
 							},
 						},
-						"set_response_header": schema.ListNestedBlock{
-							MarkdownDescription: "Set response header",
-							NestedObject:        frontendRuleActionSetHeaderSchema(),
-							PlanModifiers: []planmodifier.List{
-								listplanmodifier.RequiresReplace(),
+					},
+				},
+				"http_rewrite_uri": schema.ListNestedBlock{
+					MarkdownDescription: "Rewrites the entire HTTP request URI using regex pattern matching.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"match_pattern": schema.StringAttribute{
+								MarkdownDescription: "Regex pattern to match against the request URI.",
+								Required:            true,
+								PlanModifiers: []planmodifier.String{
+									stringplanmodifier.RequiresReplace(),
+								},
+								Validators: []validator.String{
+									stringvalidator.LengthBetween(1, 255),
+								},
 							},
-							Validators: []validator.List{
-								listvalidator.SizeBetween(0, 100),
+							"rewrite_to": schema.StringAttribute{
+								MarkdownDescription: "Replacement pattern.",
+								Required:            true,
+								PlanModifiers: []planmodifier.String{
+									stringplanmodifier.RequiresReplace(),
+								},
+								Validators: []validator.String{
+									stringvalidator.LengthBetween(0, 255),
+								},
 							},
 						},
-						"tcp_reject": schema.ListNestedBlock{
-							MarkdownDescription: "Terminates a connection.",
-							NestedObject: schema.NestedBlockObject{
-								Attributes: map[string]schema.Attribute{
-									"active": schema.BoolAttribute{
-										MarkdownDescription: "Indicates if the rule is active.",
-										Optional:            true,
-										Computed:            true,
-										Default:             booldefault.StaticBool(true),
-										PlanModifiers: []planmodifier.Bool{
-											boolplanmodifier.RequiresReplace(),
-										},
-									},
+					},
+				},
+				"http_return": schema.ListNestedBlock{
+					MarkdownDescription: "Returns HTTP response with specified HTTP status.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"content_type": schema.StringAttribute{
+								MarkdownDescription: "Content type.",
+								Required:            true,
+								PlanModifiers: []planmodifier.String{
+									stringplanmodifier.RequiresReplace(),
 								},
 							},
-							PlanModifiers: []planmodifier.List{
-								listplanmodifier.RequiresReplace(),
-							},
-							Validators: []validator.List{
-								listvalidator.SizeBetween(0, 100),
-							},
-						},
-						"use_backend": schema.ListNestedBlock{
-							MarkdownDescription: "Routes traffic to specified `backend`.",
-							NestedObject: schema.NestedBlockObject{
-								Attributes: map[string]schema.Attribute{
-									"backend_name": schema.StringAttribute{
-										MarkdownDescription: "The name of the backend where traffic will be routed.",
-										Required:            true,
-										PlanModifiers: []planmodifier.String{
-											stringplanmodifier.RequiresReplace(),
-										},
-									},
+							"status": schema.Int64Attribute{
+								MarkdownDescription: "HTTP status code.",
+								Required:            true,
+								PlanModifiers: []planmodifier.Int64{
+									int64planmodifier.RequiresReplace(),
+								},
+								Validators: []validator.Int64{
+									int64validator.Between(100, 599),
 								},
 							},
-							Validators: []validator.List{
-								listvalidator.SizeBetween(0, 100),
+							"payload": schema.StringAttribute{
+								MarkdownDescription: "The payload.",
+								Required:            true,
+								PlanModifiers: []planmodifier.String{
+									stringplanmodifier.RequiresReplace(),
+								},
+								Validators: []validator.String{
+									stringvalidator.LengthBetween(1, 4096),
+								},
 							},
-							PlanModifiers: []planmodifier.List{
-								listplanmodifier.RequiresReplace(),
+						},
+					},
+				},
+				"set_forwarded_headers": schema.ListNestedBlock{
+					MarkdownDescription: "Adds 'X-Forwarded-For / -Proto / -Port' headers in your forwarded requests",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"active": schema.BoolAttribute{
+								Optional: true,
+								Computed: true,
+								Default:  booldefault.StaticBool(true),
 							},
 						},
 					},
+					PlanModifiers: []planmodifier.List{
+						listplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.List{
+						listvalidator.SizeBetween(0, 100),
+					},
 				},
-				PlanModifiers: []planmodifier.List{
-					listplanmodifier.RequiresReplace(),
+				"set_request_header": schema.ListNestedBlock{
+					MarkdownDescription: "Set request header",
+					NestedObject:        frontendRuleActionSetHeaderSchema(),
+					PlanModifiers: []planmodifier.List{
+						listplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.List{
+						listvalidator.SizeBetween(0, 100),
+					},
 				},
-				Validators: []validator.List{
-					listvalidator.SizeBetween(0, 1),
+				"set_response_header": schema.ListNestedBlock{
+					MarkdownDescription: "Set response header",
+					NestedObject:        frontendRuleActionSetHeaderSchema(),
+					PlanModifiers: []planmodifier.List{
+						listplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.List{
+						listvalidator.SizeBetween(0, 100),
+					},
 				},
-			},
-			"matchers": schema.ListNestedBlock{
-				MarkdownDescription: "Set of rule matchers. If rule doesn't have matchers, then action applies to all incoming requests.",
-				NestedObject: schema.NestedBlockObject{
-					Blocks: map[string]schema.Block{
-						"body_size": schema.ListNestedBlock{
-							MarkdownDescription: "Matches by HTTP request body size.",
-							NestedObject:        frontendRuleMatcherIntegerSchema(),
-							PlanModifiers: []planmodifier.List{
-								listplanmodifier.RequiresReplace(),
-							},
-							Validators: []validator.List{
-								listvalidator.SizeBetween(0, 100),
-							},
-						},
-						"body_size_range": schema.ListNestedBlock{
-							MarkdownDescription: "Matches by range of HTTP request body sizes.",
-							NestedObject:        frontendRuleMatcherRangeSchema(),
-							PlanModifiers: []planmodifier.List{
-								listplanmodifier.RequiresReplace(),
-							},
-							Validators: []validator.List{
-								listvalidator.SizeBetween(0, 100),
-							},
-						},
-						"cookie": schema.ListNestedBlock{
-							MarkdownDescription: "Matches by HTTP cookie value. Cookie name must be provided.",
-							NestedObject:        frontendRuleMatcherStringWithArgumentSchema(),
-							PlanModifiers: []planmodifier.List{
-								listplanmodifier.RequiresReplace(),
-							},
-							Validators: []validator.List{
-								listvalidator.SizeBetween(0, 100),
-							},
-						},
-						"header": schema.ListNestedBlock{
-							MarkdownDescription: "Matches by HTTP header value. Header name must be provided.",
-							DeprecationMessage:  "Use `request_header` instead.",
-							NestedObject:        frontendRuleMatcherStringWithArgumentSchema(),
-							PlanModifiers: []planmodifier.List{
-								listplanmodifier.RequiresReplace(),
-							},
-							Validators: []validator.List{
-								listvalidator.SizeBetween(0, 100),
-							},
-						},
-						"request_header": schema.ListNestedBlock{
-							MarkdownDescription: "Matches by HTTP request header value. Header name must be provided.",
-							NestedObject:        frontendRuleMatcherStringWithArgumentSchema(),
-							PlanModifiers: []planmodifier.List{
-								listplanmodifier.RequiresReplace(),
-							},
-							Validators: []validator.List{
-								listvalidator.SizeBetween(0, 100),
-							},
-						},
-						"response_header": schema.ListNestedBlock{
-							MarkdownDescription: "Matches by HTTP response header value. Header name must be provided.",
-							NestedObject:        frontendRuleMatcherStringWithArgumentSchema(),
-							PlanModifiers: []planmodifier.List{
-								listplanmodifier.RequiresReplace(),
-							},
-							Validators: []validator.List{
-								listvalidator.SizeBetween(0, 100),
-							},
-						},
-						"host": schema.ListNestedBlock{
-							MarkdownDescription: "Matches by hostname. Header extracted from HTTP Headers or from TLS certificate in case of secured connection.",
-							NestedObject:        frontendRuleMatcherHostSchema(),
-							PlanModifiers: []planmodifier.List{
-								listplanmodifier.RequiresReplace(),
-							},
-							Validators: []validator.List{
-								listvalidator.SizeBetween(0, 100),
-							},
-						},
-						"http_method": schema.ListNestedBlock{
-							MarkdownDescription: "Matches by HTTP method.",
-							NestedObject:        frontendRuleMatcherHTTPMethodSchema(),
-							PlanModifiers: []planmodifier.List{
-								listplanmodifier.RequiresReplace(),
-							},
-							Validators: []validator.List{
-								listvalidator.SizeBetween(0, 100),
-							},
-						},
-						"http_status": schema.ListNestedBlock{
-							MarkdownDescription: "Matches by HTTP status.",
-							NestedObject:        frontendRuleMatcherIntegerSchema(),
-							PlanModifiers: []planmodifier.List{
-								listplanmodifier.RequiresReplace(),
-							},
-							Validators: []validator.List{
-								listvalidator.SizeBetween(0, 100),
-							},
-						},
-						"http_status_range": schema.ListNestedBlock{
-							MarkdownDescription: "Matches by range of HTTP statuses.",
-							NestedObject:        frontendRuleMatcherRangeSchema(),
-							PlanModifiers: []planmodifier.List{
-								listplanmodifier.RequiresReplace(),
-							},
-							Validators: []validator.List{
-								listvalidator.SizeBetween(0, 100),
-							},
-						},
-						"num_members_up": schema.ListNestedBlock{
-							MarkdownDescription: "Matches by number of healthy backend members.",
-							NestedObject:        frontendRuleMatcherBackendSchema(),
-							PlanModifiers: []planmodifier.List{
-								listplanmodifier.RequiresReplace(),
-							},
-							Validators: []validator.List{
-								listvalidator.SizeBetween(0, 100),
-							},
-						},
-						"path": schema.ListNestedBlock{
-							MarkdownDescription: "Matches by URL path.",
-							NestedObject:        frontendRuleMatcherStringSchema(),
-							PlanModifiers: []planmodifier.List{
-								listplanmodifier.RequiresReplace(),
-							},
-							Validators: []validator.List{
-								listvalidator.SizeBetween(0, 100),
-							},
-						},
-						"src_ip": schema.ListNestedBlock{
-							MarkdownDescription: "Matches by source IP address.",
-							NestedObject:        frontendRuleMatcherIPSchema(),
-							PlanModifiers: []planmodifier.List{
-								listplanmodifier.RequiresReplace(),
-							},
-							Validators: []validator.List{
-								listvalidator.SizeBetween(0, 100),
-							},
-						},
-						"src_port": schema.ListNestedBlock{
-							MarkdownDescription: "Matches by source port number.",
-							NestedObject:        frontendRuleMatcherIntegerSchema(),
-							PlanModifiers: []planmodifier.List{
-								listplanmodifier.RequiresReplace(),
-							},
-							Validators: []validator.List{
-								listvalidator.SizeBetween(0, 100),
-							},
-						},
-						"src_port_range": schema.ListNestedBlock{
-							MarkdownDescription: "Matches by range of source port numbers.",
-							NestedObject:        frontendRuleMatcherRangeSchema(),
-							PlanModifiers: []planmodifier.List{
-								listplanmodifier.RequiresReplace(),
-							},
-							Validators: []validator.List{
-								listvalidator.SizeBetween(0, 100),
-							},
-						},
-						"url": schema.ListNestedBlock{
-							MarkdownDescription: "Matches by URL without schema, e.g. `example.com/dashboard`.",
-							NestedObject:        frontendRuleMatcherStringSchema(),
-							PlanModifiers: []planmodifier.List{
-								listplanmodifier.RequiresReplace(),
-							},
-							Validators: []validator.List{
-								listvalidator.SizeBetween(0, 100),
-							},
-						},
-						"url_param": schema.ListNestedBlock{
-							MarkdownDescription: "Matches by URL query parameter value. Query parameter name must be provided",
-							NestedObject:        frontendRuleMatcherStringWithArgumentSchema(),
-							PlanModifiers: []planmodifier.List{
-								listplanmodifier.RequiresReplace(),
-							},
-							Validators: []validator.List{
-								listvalidator.SizeBetween(0, 100),
+				"tcp_reject": schema.ListNestedBlock{
+					MarkdownDescription: "Terminates a connection.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"active": schema.BoolAttribute{
+								MarkdownDescription: "Indicates if the rule is active.",
+								Optional:            true,
+								Computed:            true,
+								Default:             booldefault.StaticBool(true),
+								PlanModifiers: []planmodifier.Bool{
+									boolplanmodifier.RequiresReplace(),
+								},
 							},
 						},
-						"url_query": schema.ListNestedBlock{
-							MarkdownDescription: "Matches by URL query string.",
-							NestedObject:        frontendRuleMatcherStringSchema(),
-							PlanModifiers: []planmodifier.List{
-								listplanmodifier.RequiresReplace(),
-							},
-							Validators: []validator.List{
-								listvalidator.SizeBetween(0, 100),
+					},
+					PlanModifiers: []planmodifier.List{
+						listplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.List{
+						listvalidator.SizeBetween(0, 100),
+					},
+				},
+				"use_backend": schema.ListNestedBlock{
+					MarkdownDescription: "Routes traffic to specified `backend`.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"backend_name": schema.StringAttribute{
+								MarkdownDescription: "The name of the backend where traffic will be routed.",
+								Required:            true,
+								PlanModifiers: []planmodifier.String{
+									stringplanmodifier.RequiresReplace(),
+								},
 							},
 						},
 					},
+					Validators: []validator.List{
+						listvalidator.SizeBetween(0, 100),
+					},
+					PlanModifiers: []planmodifier.List{
+						listplanmodifier.RequiresReplace(),
+					},
+				},
+			},
+		},
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.List{
+			listvalidator.SizeBetween(0, 1),
+		},
+	}
+}
+
+func frontendRuleMatchersBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		MarkdownDescription: "Set of rule matchers. If rule doesn't have matchers, then action applies to all incoming requests.",
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"body_size": schema.ListNestedBlock{
+					MarkdownDescription: "Matches by HTTP request body size.",
+					NestedObject:        frontendRuleMatcherIntegerSchema(),
+					PlanModifiers: []planmodifier.List{
+						listplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.List{
+						listvalidator.SizeBetween(0, 100),
+					},
 				},
-				PlanModifiers: []planmodifier.List{
-					listplanmodifier.RequiresReplace(),
+				"body_size_range": schema.ListNestedBlock{
+					MarkdownDescription: "Matches by range of HTTP request body sizes.",
+					NestedObject:        frontendRuleMatcherRangeSchema(),
+					PlanModifiers: []planmodifier.List{
+						listplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.List{
+						listvalidator.SizeBetween(0, 100),
+					},
 				},
-				Validators: []validator.List{
-					listvalidator.SizeBetween(0, 1),
+				"cookie": schema.ListNestedBlock{
+					MarkdownDescription: "Matches by HTTP cookie value. Cookie name must be provided.",
+					NestedObject:        frontendRuleMatcherStringWithArgumentSchema(),
+					PlanModifiers: []planmodifier.List{
+						listplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.List{
+						listvalidator.SizeBetween(0, 100),
+					},
+				},
+				"header": schema.ListNestedBlock{
+					MarkdownDescription: "Matches by HTTP header value. Header name must be provided.",
+					DeprecationMessage:  "Use `request_header` instead.",
+					NestedObject:        frontendRuleMatcherStringWithArgumentSchema(),
+					PlanModifiers: []planmodifier.List{
+						listplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.List{
+						listvalidator.SizeBetween(0, 100),
+					},
+				},
+				"request_header": schema.ListNestedBlock{
+					MarkdownDescription: "Matches by HTTP request header value. Header name must be provided.",
+					NestedObject:        frontendRuleMatcherStringWithArgumentSchema(),
+					PlanModifiers: []planmodifier.List{
+						listplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.List{
+						listvalidator.SizeBetween(0, 100),
+					},
+				},
+				"response_header": schema.ListNestedBlock{
+					MarkdownDescription: "Matches by HTTP response header value. Header name must be provided.",
+					NestedObject:        frontendRuleMatcherStringWithArgumentSchema(),
+					PlanModifiers: []planmodifier.List{
+						listplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.List{
+						listvalidator.SizeBetween(0, 100),
+					},
+				},
+				"host": schema.ListNestedBlock{
+					MarkdownDescription: "Matches by hostname. Header extracted from HTTP Headers or from TLS certificate in case of secured connection.",
+					NestedObject:        frontendRuleMatcherHostSchema(),
+					PlanModifiers: []planmodifier.List{
+						listplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.List{
+						listvalidator.SizeBetween(0, 100),
+					},
+				},
+				"http_method": schema.ListNestedBlock{
+					MarkdownDescription: "Matches by HTTP method.",
+					NestedObject:        frontendRuleMatcherHTTPMethodSchema(),
+					PlanModifiers: []planmodifier.List{
+						listplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.List{
+						listvalidator.SizeBetween(0, 100),
+					},
+				},
+				"http_status": schema.ListNestedBlock{
+					MarkdownDescription: "Matches by HTTP status.",
+					NestedObject:        frontendRuleMatcherIntegerSchema(),
+					PlanModifiers: []planmodifier.List{
+						listplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.List{
+						listvalidator.SizeBetween(0, 100),
+					},
+				},
+				"http_status_range": schema.ListNestedBlock{
+					MarkdownDescription: "Matches by range of HTTP statuses.",
+					NestedObject:        frontendRuleMatcherRangeSchema(),
+					PlanModifiers: []planmodifier.List{
+						listplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.List{
+						listvalidator.SizeBetween(0, 100),
+					},
+				},
+				"num_members_up": schema.ListNestedBlock{
+					MarkdownDescription: "Matches by number of healthy backend members.",
+					NestedObject:        frontendRuleMatcherBackendSchema(),
+					PlanModifiers: []planmodifier.List{
+						listplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.List{
+						listvalidator.SizeBetween(0, 100),
+					},
+				},
+				"path": schema.ListNestedBlock{
+					MarkdownDescription: "Matches by URL path.",
+					NestedObject:        frontendRuleMatcherStringSchema(),
+					PlanModifiers: []planmodifier.List{
+						listplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.List{
+						listvalidator.SizeBetween(0, 100),
+					},
+				},
+				"src_ip": schema.ListNestedBlock{
+					MarkdownDescription: "Matches by source IP address.",
+					NestedObject:        frontendRuleMatcherIPSchema(),
+					PlanModifiers: []planmodifier.List{
+						listplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.List{
+						listvalidator.SizeBetween(0, 100),
+					},
+				},
+				"src_port": schema.ListNestedBlock{
+					MarkdownDescription: "Matches by source port number.",
+					NestedObject:        frontendRuleMatcherIntegerSchema(),
+					PlanModifiers: []planmodifier.List{
+						listplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.List{
+						listvalidator.SizeBetween(0, 100),
+					},
+				},
+				"src_port_range": schema.ListNestedBlock{
+					MarkdownDescription: "Matches by range of source port numbers.",
+					NestedObject:        frontendRuleMatcherRangeSchema(),
+					PlanModifiers: []planmodifier.List{
+						listplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.List{
+						listvalidator.SizeBetween(0, 100),
+					},
+				},
+				"url": schema.ListNestedBlock{
+					MarkdownDescription: "Matches by URL without schema, e.g. `example.com/dashboard`.",
+					NestedObject:        frontendRuleMatcherStringSchema(),
+					PlanModifiers: []planmodifier.List{
+						listplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.List{
+						listvalidator.SizeBetween(0, 100),
+					},
+				},
+				"url_param": schema.ListNestedBlock{
+					MarkdownDescription: "Matches by URL query parameter value. Query parameter name must be provided",
+					NestedObject:        frontendRuleMatcherStringWithArgumentSchema(),
+					PlanModifiers: []planmodifier.List{
+						listplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.List{
+						listvalidator.SizeBetween(0, 100),
+					},
+				},
+				"url_query": schema.ListNestedBlock{
+					MarkdownDescription: "Matches by URL query string.",
+					NestedObject:        frontendRuleMatcherStringSchema(),
+					PlanModifiers: []planmodifier.List{
+						listplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.List{
+						listvalidator.SizeBetween(0, 100),
+					},
 				},
 			},
 		},
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.List{
+			listvalidator.SizeBetween(0, 1),
+		},
 	}
 }
 
@@ -634,6 +643,7 @@ func (r *frontendRuleResource) Create(ctx context.Context, req resource.CreateRe
 
 	resp.Diagnostics.Append(setFrontendRuleValues(ctx, &data, frontendRule, blocks)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, frontendRuleIdentity, data.ID.ValueString())...)
 }
 
 func (r *frontendRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -689,6 +699,7 @@ func (r *frontendRuleResource) Read(ctx context.Context, req resource.ReadReques
 
 	resp.Diagnostics.Append(setFrontendRuleValues(ctx, &data, frontendRule, blocks)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, frontendRuleIdentity, data.ID.ValueString())...)
 }
 
 func (r *frontendRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -771,8 +782,18 @@ func (r *frontendRuleResource) Delete(ctx context.Context, req resource.DeleteRe
 	}
 }
 
+var frontendRuleIdentity = []utils.IdentityAttribute{
+	{Name: "loadbalancer", Description: "UUID of the load balancer."},
+	{Name: "frontend", Description: "Name of the frontend."},
+	{Name: "name", Description: "Name of the frontend rule."},
+}
+
+func (r *frontendRuleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(frontendRuleIdentity)
+}
+
 func (r *frontendRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, frontendRuleIdentity, req, resp)
 }
 
 func elementTypesByKey(k string, blocks map[string]schema.ListNestedBlock) (map[string]basetypes.ObjectTypable, error) {
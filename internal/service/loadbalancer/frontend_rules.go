@@ -0,0 +1,684 @@
+package loadbalancer
+
+import (
+	"context"
+	"sort"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const frontendRulesMaxCount = 100
+
+var (
+	_ resource.Resource                = &frontendRulesResource{}
+	_ resource.ResourceWithConfigure   = &frontendRulesResource{}
+	_ resource.ResourceWithImportState = &frontendRulesResource{}
+	_ resource.ResourceWithIdentity    = &frontendRulesResource{}
+	_ resource.ResourceWithModifyPlan  = &frontendRulesResource{}
+)
+
+func NewFrontendRulesResource() resource.Resource {
+	return &frontendRulesResource{}
+}
+
+type frontendRulesResource struct {
+	client *service.Service
+}
+
+func (r *frontendRulesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_loadbalancer_frontend_rules"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *frontendRulesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = utils.GetClientFromProviderData(req.ProviderData)
+}
+
+type frontendRulesModel struct {
+	ID       types.String `tfsdk:"id"`
+	Frontend types.String `tfsdk:"frontend"`
+	Rules    types.List   `tfsdk:"rule"`
+}
+
+type frontendRulesRuleModel struct {
+	Name              types.String `tfsdk:"name"`
+	Priority          types.Int64  `tfsdk:"priority"`
+	MatchingCondition types.String `tfsdk:"matching_condition"`
+	Matchers          types.List   `tfsdk:"matchers"`
+	Actions           types.List   `tfsdk:"actions"`
+}
+
+func (r *frontendRulesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource manages the complete, ordered list of rules of a load balancer frontend. The rules are evaluated in the order they are defined: priorities are computed from the position of the rule, so that the first rule has priority 100, the second 99, and so on. Rules of the frontend that are not defined in this resource are removed, so do not use this resource together with `upcloud_loadbalancer_frontend_rule` resources for the same frontend.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the frontend rules. ID is in `{load balancer UUID}/{frontend name}` format.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"frontend": schema.StringAttribute{
+				MarkdownDescription: "ID of the load balancer frontend to which the frontend rules are connected.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"rule": schema.ListNestedBlock{
+				MarkdownDescription: "Frontend rule. Rules are evaluated in the order they are defined.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the frontend rule. Must be unique within the frontend.",
+							Required:            true,
+							Validators: []validator.String{
+								nameValidator,
+							},
+						},
+						"priority": schema.Int64Attribute{
+							MarkdownDescription: "Priority of the rule computed from its position in the list.",
+							Computed:            true,
+						},
+						"matching_condition": schema.StringAttribute{
+							MarkdownDescription: "Defines boolean operator used to combine multiple matchers. Defaults to `and`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(string(upcloud.LoadBalancerMatchingConditionAnd)),
+							Validators: []validator.String{
+								stringvalidator.OneOf(
+									string(upcloud.LoadBalancerMatchingConditionAnd),
+									string(upcloud.LoadBalancerMatchingConditionOr),
+								),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"actions":  withoutPlanModifiers(frontendRuleActionsBlock()),
+						"matchers": withoutPlanModifiers(frontendRuleMatchersBlock()),
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(frontendRulesMaxCount),
+				},
+			},
+		},
+	}
+}
+
+// withoutPlanModifiers removes plan modifiers from the block and its nested attributes and blocks. This allows reusing the
+// frontend rule blocks, where changes require replacing the rule, in resources that update the rules in place.
+func withoutPlanModifiers(block schema.ListNestedBlock) schema.ListNestedBlock {
+	block.PlanModifiers = nil
+
+	attributes := make(map[string]schema.Attribute, len(block.NestedObject.Attributes))
+	for k, v := range block.NestedObject.Attributes {
+		switch a := v.(type) {
+		case schema.StringAttribute:
+			a.PlanModifiers = nil
+			v = a
+		case schema.Int64Attribute:
+			a.PlanModifiers = nil
+			v = a
+		case schema.BoolAttribute:
+			a.PlanModifiers = nil
+			v = a
+		}
+		attributes[k] = v
+	}
+	block.NestedObject.Attributes = attributes
+
+	blocks := make(map[string]schema.Block, len(block.NestedObject.Blocks))
+	for k, v := range block.NestedObject.Blocks {
+		if b, ok := v.(schema.ListNestedBlock); ok {
+			v = withoutPlanModifiers(b)
+		}
+		blocks[k] = v
+	}
+	block.NestedObject.Blocks = blocks
+
+	return block
+}
+
+func frontendRulesPriority(index int) int64 {
+	return int64(frontendRulesMaxCount - index)
+}
+
+// equalIgnoringComputed compares planned value to state value so that values computed by the provider match any value in the state. Only unknown values that are not set in the configuration, i.e. optional and computed values such as HTTP redirect status, are ignored. Unknown values set in the configuration, e.g. references to resources that are being replaced, might change during apply and thus do not match any value.
+func equalIgnoringComputed(config, plan, state attr.Value) bool {
+	if plan.IsUnknown() {
+		return config.IsNull()
+	}
+
+	switch p := plan.(type) {
+	case types.List:
+		c, ok := config.(types.List)
+		if !ok {
+			return false
+		}
+		s, ok := state.(types.List)
+		if !ok || p.IsNull() != s.IsNull() || len(p.Elements()) != len(s.Elements()) || len(p.Elements()) != len(c.Elements()) {
+			return false
+		}
+
+		configElements := c.Elements()
+		stateElements := s.Elements()
+		for i, e := range p.Elements() {
+			if !equalIgnoringComputed(configElements[i], e, stateElements[i]) {
+				return false
+			}
+		}
+
+		return true
+	case types.Object:
+		c, ok := config.(types.Object)
+		if !ok {
+			return false
+		}
+		s, ok := state.(types.Object)
+		if !ok || p.IsNull() != s.IsNull() {
+			return false
+		}
+
+		configAttributes := c.Attributes()
+		stateAttributes := s.Attributes()
+		for k, v := range p.Attributes() {
+			configValue, ok := configAttributes[k]
+			if !ok {
+				return false
+			}
+			stateValue, ok := stateAttributes[k]
+			if !ok || !equalIgnoringComputed(configValue, v, stateValue) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return plan.Equal(state)
+}
+
+func frontendRulesByName(ctx context.Context, rules types.List) (map[string]frontendRulesRuleModel, diag.Diagnostics) {
+	var ruleModels []frontendRulesRuleModel
+	diags := rules.ElementsAs(ctx, &ruleModels, false)
+
+	byName := make(map[string]frontendRulesRuleModel, len(ruleModels))
+	for _, rule := range ruleModels {
+		byName[rule.Name.ValueString()] = rule
+	}
+
+	return byName, diags
+}
+
+func (r *frontendRulesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan *frontendRulesModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if plan == nil || plan.Rules.IsUnknown() || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var config frontendRulesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state *frontendRulesModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateRules := make(map[string]frontendRulesRuleModel)
+	if state != nil {
+		var diags diag.Diagnostics
+		stateRules, diags = frontendRulesByName(ctx, state.Rules)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	var configRules []frontendRulesRuleModel
+	resp.Diagnostics.Append(config.Rules.ElementsAs(ctx, &configRules, false)...)
+
+	var rules []frontendRulesRuleModel
+	resp.Diagnostics.Append(plan.Rules.ElementsAs(ctx, &rules, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i := range rules {
+		rule := &rules[i]
+		rule.Priority = types.Int64Value(frontendRulesPriority(i))
+
+		// Use state values for unchanged matchers and actions to avoid replacing the rule because of values computed by the API, e.g. HTTP redirect status.
+		if prev, ok := stateRules[rule.Name.ValueString()]; ok && i < len(configRules) {
+			if equalIgnoringComputed(configRules[i].Matchers, rule.Matchers, prev.Matchers) {
+				rule.Matchers = prev.Matchers
+			}
+			if equalIgnoringComputed(configRules[i].Actions, rule.Actions, prev.Actions) {
+				rule.Actions = prev.Actions
+			}
+		}
+	}
+
+	var diags diag.Diagnostics
+	plan.Rules, diags = types.ListValueFrom(ctx, plan.Rules.ElementType(ctx), rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+func setFrontendRulesValues(ctx context.Context, data *frontendRulesModel, frontendRules []upcloud.LoadBalancerFrontendRule) diag.Diagnostics {
+	var respDiagnostics diag.Diagnostics
+
+	var stateRules []frontendRulesRuleModel
+	if !data.Rules.IsNull() {
+		respDiagnostics.Append(data.Rules.ElementsAs(ctx, &stateRules, false)...)
+	}
+
+	actionsBlock := frontendRuleActionsBlock()
+	matchersBlock := frontendRuleMatchersBlock()
+	blocks := map[string]schema.ListNestedBlock{
+		"actions":  actionsBlock,
+		"matchers": matchersBlock,
+	}
+
+	apiRules := make(map[string]upcloud.LoadBalancerFrontendRule, len(frontendRules))
+	for _, frontendRule := range frontendRules {
+		apiRules[frontendRule.Name] = frontendRule
+	}
+
+	// Keep the order of the rules in the state and add rules that are not in the state to the end of the list in the order they are evaluated.
+	rules := make([]frontendRulesRuleModel, 0, len(frontendRules))
+	for _, rule := range stateRules {
+		if _, ok := apiRules[rule.Name.ValueString()]; ok {
+			rules = append(rules, rule)
+		}
+	}
+
+	known := make(map[string]bool, len(rules))
+	for _, rule := range rules {
+		known[rule.Name.ValueString()] = true
+	}
+
+	var unknownRules []upcloud.LoadBalancerFrontendRule
+	for _, frontendRule := range frontendRules {
+		if !known[frontendRule.Name] {
+			unknownRules = append(unknownRules, frontendRule)
+		}
+	}
+
+	sort.SliceStable(unknownRules, func(i, j int) bool {
+		if unknownRules[i].Priority != unknownRules[j].Priority {
+			return unknownRules[i].Priority > unknownRules[j].Priority
+		}
+		return unknownRules[i].Name < unknownRules[j].Name
+	})
+
+	for _, frontendRule := range unknownRules {
+		rules = append(rules, frontendRulesRuleModel{
+			Name:     types.StringValue(frontendRule.Name),
+			Matchers: types.ListNull(matchersBlock.NestedObject.Type()),
+			Actions:  types.ListNull(actionsBlock.NestedObject.Type()),
+		})
+	}
+
+	for i := range rules {
+		rule := &rules[i]
+		frontendRule := apiRules[rule.Name.ValueString()]
+		isNew := !known[rule.Name.ValueString()]
+
+		rule.Priority = types.Int64Value(int64(frontendRule.Priority))
+		rule.MatchingCondition = types.StringValue(string(frontendRule.MatchingCondition))
+
+		ruleData := frontendRuleModel{
+			Matchers: rule.Matchers,
+			Actions:  rule.Actions,
+		}
+
+		if !rule.Actions.IsNull() || isNew {
+			respDiagnostics.Append(setFrontendRuleActionsValues(ctx, &ruleData, &frontendRule, blocks)...)
+		}
+
+		if !rule.Matchers.IsNull() || isNew {
+			respDiagnostics.Append(setFrontendRuleMatchersValues(ctx, &ruleData, &frontendRule, blocks)...)
+		}
+
+		rule.Matchers = ruleData.Matchers
+		rule.Actions = ruleData.Actions
+	}
+
+	var diags diag.Diagnostics
+	data.Rules, diags = types.ListValueFrom(ctx, data.Rules.ElementType(ctx), rules)
+	respDiagnostics.Append(diags...)
+
+	return respDiagnostics
+}
+
+func (r *frontendRulesResource) getFrontendRules(ctx context.Context, loadBalancer, frontendName string) ([]upcloud.LoadBalancerFrontendRule, error) {
+	return r.client.GetLoadBalancerFrontendRules(ctx, &request.GetLoadBalancerFrontendRulesRequest{
+		ServiceUUID:  loadBalancer,
+		FrontendName: frontendName,
+	})
+}
+
+// applyFrontendRules modifies the rules of the frontend to match the planned rules. Rules that are not included in the plan are deleted,
+// rules with modified matchers or actions are replaced, and rules with modified priority or matching condition are modified in place.
+func (r *frontendRulesResource) applyFrontendRules(ctx context.Context, loadBalancer, frontendName string, plan, state types.List) diag.Diagnostics {
+	var respDiagnostics diag.Diagnostics
+
+	var planRules []frontendRulesRuleModel
+	respDiagnostics.Append(plan.ElementsAs(ctx, &planRules, false)...)
+
+	stateRules := make(map[string]frontendRulesRuleModel)
+	if !state.IsNull() {
+		var diags diag.Diagnostics
+		stateRules, diags = frontendRulesByName(ctx, state)
+		respDiagnostics.Append(diags...)
+	}
+
+	if respDiagnostics.HasError() {
+		return respDiagnostics
+	}
+
+	currentRules, err := r.getFrontendRules(ctx, loadBalancer, frontendName)
+	if err != nil {
+		respDiagnostics.AddError(
+			"Unable to read loadbalancer frontend rules",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return respDiagnostics
+	}
+
+	planned := make(map[string]bool, len(planRules))
+	for _, rule := range planRules {
+		planned[rule.Name.ValueString()] = true
+	}
+
+	existing := make(map[string]bool, len(currentRules))
+	for _, frontendRule := range currentRules {
+		if planned[frontendRule.Name] {
+			existing[frontendRule.Name] = true
+			continue
+		}
+
+		if err := r.client.DeleteLoadBalancerFrontendRule(ctx, &request.DeleteLoadBalancerFrontendRuleRequest{
+			ServiceUUID:  loadBalancer,
+			FrontendName: frontendName,
+			Name:         frontendRule.Name,
+		}); err != nil {
+			respDiagnostics.AddError(
+				"Unable to delete loadbalancer frontend rule",
+				utils.ErrorDiagnosticDetail(err),
+			)
+			return respDiagnostics
+		}
+	}
+
+	for _, rule := range planRules {
+		name := rule.Name.ValueString()
+		prev, inState := stateRules[name]
+
+		if existing[name] && inState && rule.Matchers.Equal(prev.Matchers) && rule.Actions.Equal(prev.Actions) {
+			if rule.Priority.Equal(prev.Priority) && rule.MatchingCondition.Equal(prev.MatchingCondition) {
+				continue
+			}
+
+			if _, err := r.client.ModifyLoadBalancerFrontendRule(ctx, &request.ModifyLoadBalancerFrontendRuleRequest{
+				ServiceUUID:  loadBalancer,
+				FrontendName: frontendName,
+				Name:         name,
+				Rule: request.ModifyLoadBalancerFrontendRule{
+					Name:              name,
+					Priority:          upcloud.IntPtr(int(rule.Priority.ValueInt64())),
+					MatchingCondition: upcloud.LoadBalancerMatchingCondition(rule.MatchingCondition.ValueString()),
+				},
+			}); err != nil {
+				respDiagnostics.AddError(
+					"Unable to modify loadbalancer frontend rule",
+					utils.ErrorDiagnosticDetail(err),
+				)
+				return respDiagnostics
+			}
+
+			continue
+		}
+
+		matchers, diags := buildFrontendRuleMatchers(ctx, rule.Matchers)
+		respDiagnostics.Append(diags...)
+
+		actions, diags := buildFrontendRuleActions(ctx, rule.Actions)
+		respDiagnostics.Append(diags...)
+
+		if respDiagnostics.HasError() {
+			return respDiagnostics
+		}
+
+		apiRule := request.LoadBalancerFrontendRule{
+			Name:              name,
+			Priority:          int(rule.Priority.ValueInt64()),
+			MatchingCondition: upcloud.LoadBalancerMatchingCondition(rule.MatchingCondition.ValueString()),
+			Matchers:          matchers,
+			Actions:           actions,
+		}
+
+		if existing[name] {
+			if _, err := r.client.ReplaceLoadBalancerFrontendRule(ctx, &request.ReplaceLoadBalancerFrontendRuleRequest{
+				ServiceUUID:  loadBalancer,
+				FrontendName: frontendName,
+				Name:         name,
+				Rule:         apiRule,
+			}); err != nil {
+				respDiagnostics.AddError(
+					"Unable to replace loadbalancer frontend rule",
+					utils.ErrorDiagnosticDetail(err),
+				)
+				return respDiagnostics
+			}
+
+			continue
+		}
+
+		if _, err := r.client.CreateLoadBalancerFrontendRule(ctx, &request.CreateLoadBalancerFrontendRuleRequest{
+			ServiceUUID:  loadBalancer,
+			FrontendName: frontendName,
+			Rule:         apiRule,
+		}); err != nil {
+			respDiagnostics.AddError(
+				"Unable to create loadbalancer frontend rule",
+				utils.ErrorDiagnosticDetail(err),
+			)
+			return respDiagnostics
+		}
+	}
+
+	return respDiagnostics
+}
+
+func (r *frontendRulesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data frontendRulesModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var loadBalancer, frontendName string
+	err := utils.UnmarshalID(data.Frontend.ValueString(), &loadBalancer, &frontendName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to unmarshal loadbalancer frontend name",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyFrontendRules(ctx, loadBalancer, frontendName, data.Rules, types.ListNull(data.Rules.ElementType(ctx)))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(utils.MarshalID(loadBalancer, frontendName))
+
+	frontendRules, err := r.getFrontendRules(ctx, loadBalancer, frontendName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read loadbalancer frontend rules",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setFrontendRulesValues(ctx, &data, frontendRules)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, frontendRulesIdentity, data.ID.ValueString())...)
+}
+
+func (r *frontendRulesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data frontendRulesModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.ValueString() == "" {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	var loadBalancer, frontendName string
+	err := utils.UnmarshalID(data.ID.ValueString(), &loadBalancer, &frontendName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to unmarshal loadbalancer frontend rules ID",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	frontendRules, err := r.getFrontendRules(ctx, loadBalancer, frontendName)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError(
+				"Unable to read loadbalancer frontend rules",
+				utils.ErrorDiagnosticDetail(err),
+			)
+		}
+		return
+	}
+
+	data.Frontend = types.StringValue(utils.MarshalID(loadBalancer, frontendName))
+
+	resp.Diagnostics.Append(setFrontendRulesValues(ctx, &data, frontendRules)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, frontendRulesIdentity, data.ID.ValueString())...)
+}
+
+func (r *frontendRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state frontendRulesModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var loadBalancer, frontendName string
+	if err := utils.UnmarshalID(data.ID.ValueString(), &loadBalancer, &frontendName); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to unmarshal loadbalancer frontend rules ID",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyFrontendRules(ctx, loadBalancer, frontendName, data.Rules, state.Rules)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	frontendRules, err := r.getFrontendRules(ctx, loadBalancer, frontendName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read loadbalancer frontend rules",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setFrontendRulesValues(ctx, &data, frontendRules)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *frontendRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data frontendRulesModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	var loadBalancer, frontendName string
+	if err := utils.UnmarshalID(data.ID.ValueString(), &loadBalancer, &frontendName); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to unmarshal loadbalancer frontend rules ID",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	var rules []frontendRulesRuleModel
+	resp.Diagnostics.Append(data.Rules.ElementsAs(ctx, &rules, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, rule := range rules {
+		if err := r.client.DeleteLoadBalancerFrontendRule(ctx, &request.DeleteLoadBalancerFrontendRuleRequest{
+			ServiceUUID:  loadBalancer,
+			FrontendName: frontendName,
+			Name:         rule.Name.ValueString(),
+		}); err != nil && !utils.IsNotFoundError(err) {
+			resp.Diagnostics.AddError(
+				"Unable to delete loadbalancer frontend rule",
+				utils.ErrorDiagnosticDetail(err),
+			)
+			return
+		}
+	}
+}
+
+var frontendRulesIdentity = []utils.IdentityAttribute{
+	{Name: "loadbalancer", Description: "UUID of the load balancer."},
+	{Name: "frontend", Description: "Name of the frontend."},
+}
+
+func (r *frontendRulesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(frontendRulesIdentity)
+}
+
+func (r *frontendRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	utils.ImportStateWithIdentity(ctx, frontendRulesIdentity, req, resp)
+}
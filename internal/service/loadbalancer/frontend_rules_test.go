@@ -0,0 +1,54 @@
+package loadbalancer
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEqualIgnoringComputed(t *testing.T) {
+	redirectType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"location": types.StringType,
+		"status":   types.Int64Type,
+	}}
+	redirect := func(location types.String, status types.Int64) types.List {
+		return types.ListValueMust(redirectType, []attr.Value{
+			types.ObjectValueMust(redirectType.AttrTypes, map[string]attr.Value{
+				"location": location,
+				"status":   status,
+			}),
+		})
+	}
+
+	state := redirect(types.StringValue("/new/"), types.Int64Value(301))
+
+	tests := []struct {
+		name   string
+		config attr.Value
+		plan   attr.Value
+		want   bool
+	}{
+		{"equal", state, state, true},
+		{"computed status", redirect(types.StringValue("/new/"), types.Int64Null()), redirect(types.StringValue("/new/"), types.Int64Unknown()), true},
+		{"unknown configured status", redirect(types.StringValue("/new/"), types.Int64Unknown()), redirect(types.StringValue("/new/"), types.Int64Unknown()), false},
+		{"unknown location", redirect(types.StringUnknown(), types.Int64Value(301)), redirect(types.StringUnknown(), types.Int64Value(301)), false},
+		{"unknown list", types.ListUnknown(redirectType), types.ListUnknown(redirectType), false},
+		{"modified status", redirect(types.StringValue("/new/"), types.Int64Value(302)), redirect(types.StringValue("/new/"), types.Int64Value(302)), false},
+		{"modified location", redirect(types.StringValue("/other/"), types.Int64Null()), redirect(types.StringValue("/other/"), types.Int64Unknown()), false},
+		{"null list", types.ListNull(redirectType), types.ListNull(redirectType), false},
+		{"empty list", types.ListValueMust(redirectType, []attr.Value{}), types.ListValueMust(redirectType, []attr.Value{}), false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, equalIgnoringComputed(test.config, test.plan, state))
+		})
+	}
+}
+
+func TestFrontendRulesPriority(t *testing.T) {
+	assert.Equal(t, int64(100), frontendRulesPriority(0))
+	assert.Equal(t, int64(99), frontendRulesPriority(1))
+	assert.Equal(t, int64(1), frontendRulesPriority(frontendRulesMaxCount-1))
+}
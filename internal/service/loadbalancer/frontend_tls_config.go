@@ -10,7 +10,6 @@ import (
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -23,6 +22,7 @@ var (
 	_ resource.Resource                = &frontendTLSConfigResource{}
 	_ resource.ResourceWithConfigure   = &frontendTLSConfigResource{}
 	_ resource.ResourceWithImportState = &frontendTLSConfigResource{}
+	_ resource.ResourceWithIdentity    = &frontendTLSConfigResource{}
 )
 
 func NewFrontendTLSConfigResource() resource.Resource {
@@ -145,6 +145,7 @@ func (r *frontendTLSConfigResource) Create(ctx context.Context, req resource.Cre
 
 	resp.Diagnostics.Append(setFrontendTLSConfigValues(ctx, &data, tlsConfig)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, frontendTLSConfigIdentity, data.ID.ValueString())...)
 }
 
 func (r *frontendTLSConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -191,6 +192,7 @@ func (r *frontendTLSConfigResource) Read(ctx context.Context, req resource.ReadR
 
 	resp.Diagnostics.Append(setFrontendTLSConfigValues(ctx, &data, tlsConfig)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, frontendTLSConfigIdentity, data.ID.ValueString())...)
 }
 
 func (r *frontendTLSConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -260,6 +262,16 @@ func (r *frontendTLSConfigResource) Delete(ctx context.Context, req resource.Del
 	}
 }
 
+var frontendTLSConfigIdentity = []utils.IdentityAttribute{
+	{Name: "loadbalancer", Description: "UUID of the load balancer."},
+	{Name: "frontend", Description: "Name of the frontend."},
+	{Name: "name", Description: "Name of the TLS config."},
+}
+
+func (r *frontendTLSConfigResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(frontendTLSConfigIdentity)
+}
+
 func (r *frontendTLSConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, frontendTLSConfigIdentity, req, resp)
 }
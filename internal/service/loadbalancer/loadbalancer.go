@@ -9,6 +9,7 @@ import (
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -28,6 +29,7 @@ var (
 	_ resource.Resource                = &loadBalancerResource{}
 	_ resource.ResourceWithConfigure   = &loadBalancerResource{}
 	_ resource.ResourceWithImportState = &loadBalancerResource{}
+	_ resource.ResourceWithIdentity    = &loadBalancerResource{}
 )
 
 func NewLoadBalancerResource() resource.Resource {
@@ -48,23 +50,24 @@ func (r *loadBalancerResource) Configure(_ context.Context, req resource.Configu
 }
 
 type loadBalancerModel struct {
-	Backends         types.List   `tfsdk:"backends"`
-	ConfiguredStatus types.String `tfsdk:"configured_status"`
-	DNSName          types.String `tfsdk:"dns_name"`
-	Frontends        types.List   `tfsdk:"frontends"`
-	ID               types.String `tfsdk:"id"`
-	IPAddresses      types.Set    `tfsdk:"ip_addresses"`
-	Labels           types.Map    `tfsdk:"labels"`
-	MaintenanceDOW   types.String `tfsdk:"maintenance_dow"`
-	MaintenanceTime  types.String `tfsdk:"maintenance_time"`
-	Name             types.String `tfsdk:"name"`
-	Network          types.String `tfsdk:"network"`
-	Networks         types.List   `tfsdk:"networks"`
-	Nodes            types.List   `tfsdk:"nodes"`
-	OperationalState types.String `tfsdk:"operational_state"`
-	Plan             types.String `tfsdk:"plan"`
-	Resolvers        types.List   `tfsdk:"resolvers"`
-	Zone             types.String `tfsdk:"zone"`
+	Backends         types.List     `tfsdk:"backends"`
+	ConfiguredStatus types.String   `tfsdk:"configured_status"`
+	DNSName          types.String   `tfsdk:"dns_name"`
+	Frontends        types.List     `tfsdk:"frontends"`
+	ID               types.String   `tfsdk:"id"`
+	IPAddresses      types.Set      `tfsdk:"ip_addresses"`
+	Labels           types.Map      `tfsdk:"labels"`
+	MaintenanceDOW   types.String   `tfsdk:"maintenance_dow"`
+	MaintenanceTime  types.String   `tfsdk:"maintenance_time"`
+	Name             types.String   `tfsdk:"name"`
+	Network          types.String   `tfsdk:"network"`
+	Networks         types.List     `tfsdk:"networks"`
+	Nodes            types.List     `tfsdk:"nodes"`
+	OperationalState types.String   `tfsdk:"operational_state"`
+	Plan             types.String   `tfsdk:"plan"`
+	Resolvers        types.List     `tfsdk:"resolvers"`
+	Zone             types.String   `tfsdk:"zone"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
 type loadbalancerIPAddressModel struct {
@@ -118,7 +121,7 @@ func (m loadBalancerNodeNetworkIPAddressModel) ElementType() types.ObjectType {
 	}
 }
 
-func (r *loadBalancerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *loadBalancerResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "This resource represents [Managed Load Balancer](https://upcloud.com/products/managed-load-balancer) service.",
 		Attributes: map[string]schema.Attribute{
@@ -282,6 +285,7 @@ func (r *loadBalancerResource) Schema(_ context.Context, _ resource.SchemaReques
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
 			"networks": schema.ListNestedBlock{
 				MarkdownDescription: "Attached Networks from where traffic consumed and routed. Private networks must reside in loadbalancer zone.",
 				NestedObject: schema.NestedBlockObject{
@@ -367,6 +371,14 @@ func (r *loadBalancerResource) Create(ctx context.Context, req resource.CreateRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	ctx, cancel, diags := utils.TimeoutContext(ctx, data.Timeouts, utils.TimeoutCreate)
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var labelsMap map[string]string
 	if !data.Labels.IsNull() && !data.Labels.IsUnknown() {
 		resp.Diagnostics.Append(data.Labels.ElementsAs(ctx, &labelsMap, false)...)
@@ -430,7 +442,7 @@ func (r *loadBalancerResource) Create(ctx context.Context, req resource.CreateRe
 
 	data.ID = types.StringValue(loadBalancer.UUID)
 
-	loadBalancer, diags := waitForRunningState(ctx, r.client, data, "creation")
+	loadBalancer, diags = waitForRunningState(ctx, r.client, data, "creation")
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -438,6 +450,7 @@ func (r *loadBalancerResource) Create(ctx context.Context, req resource.CreateRe
 
 	resp.Diagnostics.Append(setLoadBalancerValues(ctx, &data, loadBalancer)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, loadBalancerIdentity, data.ID.ValueString())...)
 }
 
 func waitForRunningState(ctx context.Context, client *service.Service, data loadBalancerModel, action string) (lb *upcloud.LoadBalancer, diags diag.Diagnostics) {
@@ -450,7 +463,7 @@ func waitForRunningState(ctx context.Context, client *service.Service, data load
 		if err != nil {
 			diags.AddError(
 				"Loadbalancer did not reach running state after "+action,
-				utils.ErrorDiagnosticDetail(err),
+				utils.WaitErrorDiagnosticDetail(ctx, err),
 			)
 			return nil, diags
 		}
@@ -493,40 +506,69 @@ func setLoadBalancerValues(ctx context.Context, data *loadBalancerModel, loadbal
 	}
 
 	if !data.Networks.IsNull() || isImport {
-		networks := make([]loadbalancerNetworkModel, len(loadbalancer.Networks))
-		for i, network := range loadbalancer.Networks {
-			dataNetwork := loadbalancerNetworkModel{
-				Name:    types.StringValue(network.Name),
-				Type:    types.StringValue(string(network.Type)),
-				Family:  types.StringValue(string(network.Family)),
-				DNSName: types.StringValue(network.DNSName),
-				ID:      types.StringValue(utils.MarshalID(loadbalancer.UUID, network.Name)),
-			}
-			if network.Type == upcloud.LoadBalancerNetworkTypePrivate {
-				dataNetwork.Network = types.StringValue(network.UUID)
-			}
-			networks[i] = dataNetwork
-		}
-
-		data.Networks, diags = types.ListValueFrom(ctx, data.Networks.ElementType(ctx), networks)
+		data.Networks, diags = types.ListValueFrom(ctx, data.Networks.ElementType(ctx), loadBalancerNetworkValues(loadbalancer))
 		respDiagnostics.Append(diags...)
 	}
 
 	if data.IPAddresses.IsNull() && !isImport {
 		data.IPAddresses = types.SetNull(data.IPAddresses.ElementType(ctx))
 	} else {
-		ipAddresses := make([]loadbalancerIPAddressModel, len(loadbalancer.IPAddresses))
-		for i, ip := range loadbalancer.IPAddresses {
-			dataIPAddress := loadbalancerIPAddressModel{
-				NetworkName: types.StringValue(ip.NetworkName),
-				Address:     types.StringValue(ip.Address),
-			}
-			ipAddresses[i] = dataIPAddress
+		data.IPAddresses, diags = types.SetValueFrom(ctx, data.IPAddresses.ElementType(ctx), loadBalancerIPAddressValues(loadbalancer))
+		respDiagnostics.Append(diags...)
+	}
+
+	nodes, diags := loadBalancerNodeValues(ctx, loadbalancer)
+	respDiagnostics.Append(diags...)
+
+	data.Nodes, diags = types.ListValueFrom(ctx, data.Nodes.ElementType(ctx), nodes)
+	respDiagnostics.Append(diags...)
+
+	data.OperationalState = types.StringValue(string(loadbalancer.OperationalState))
+	data.Plan = types.StringValue(loadbalancer.Plan)
+
+	resolverNames := []string{}
+	for _, resolver := range loadbalancer.Resolvers {
+		resolverNames = append(resolverNames, resolver.Name)
+	}
+	data.Resolvers, diags = types.ListValueFrom(ctx, types.StringType, resolverNames)
+	respDiagnostics.Append(diags...)
+
+	data.Zone = types.StringValue(loadbalancer.Zone)
+
+	return respDiagnostics
+}
+
+func loadBalancerNetworkValues(loadbalancer *upcloud.LoadBalancer) []loadbalancerNetworkModel {
+	networks := make([]loadbalancerNetworkModel, len(loadbalancer.Networks))
+	for i, network := range loadbalancer.Networks {
+		dataNetwork := loadbalancerNetworkModel{
+			Name:    types.StringValue(network.Name),
+			Type:    types.StringValue(string(network.Type)),
+			Family:  types.StringValue(string(network.Family)),
+			DNSName: types.StringValue(network.DNSName),
+			ID:      types.StringValue(utils.MarshalID(loadbalancer.UUID, network.Name)),
 		}
+		if network.Type == upcloud.LoadBalancerNetworkTypePrivate {
+			dataNetwork.Network = types.StringValue(network.UUID)
+		}
+		networks[i] = dataNetwork
+	}
+	return networks
+}
 
-		data.IPAddresses, diags = types.SetValueFrom(ctx, data.IPAddresses.ElementType(ctx), ipAddresses)
-		respDiagnostics.Append(diags...)
+func loadBalancerIPAddressValues(loadbalancer *upcloud.LoadBalancer) []loadbalancerIPAddressModel {
+	ipAddresses := make([]loadbalancerIPAddressModel, len(loadbalancer.IPAddresses))
+	for i, ip := range loadbalancer.IPAddresses {
+		ipAddresses[i] = loadbalancerIPAddressModel{
+			NetworkName: types.StringValue(ip.NetworkName),
+			Address:     types.StringValue(ip.Address),
+		}
 	}
+	return ipAddresses
+}
+
+func loadBalancerNodeValues(ctx context.Context, loadbalancer *upcloud.LoadBalancer) ([]loadbalancerNodeModel, diag.Diagnostics) {
+	var respDiagnostics diag.Diagnostics
 
 	nodes := make([]loadbalancerNodeModel, len(loadbalancer.Nodes))
 	for i, node := range loadbalancer.Nodes {
@@ -563,22 +605,7 @@ func setLoadBalancerValues(ctx context.Context, data *loadBalancerModel, loadbal
 		nodes[i] = dataNode
 	}
 
-	data.Nodes, diags = types.ListValueFrom(ctx, data.Nodes.ElementType(ctx), nodes)
-	respDiagnostics.Append(diags...)
-
-	data.OperationalState = types.StringValue(string(loadbalancer.OperationalState))
-	data.Plan = types.StringValue(loadbalancer.Plan)
-
-	resolverNames := []string{}
-	for _, resolver := range loadbalancer.Resolvers {
-		resolverNames = append(resolverNames, resolver.Name)
-	}
-	data.Resolvers, diags = types.ListValueFrom(ctx, types.StringType, resolverNames)
-	respDiagnostics.Append(diags...)
-
-	data.Zone = types.StringValue(loadbalancer.Zone)
-
-	return respDiagnostics
+	return nodes, respDiagnostics
 }
 
 func (r *loadBalancerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -611,6 +638,7 @@ func (r *loadBalancerResource) Read(ctx context.Context, req resource.ReadReques
 
 	resp.Diagnostics.Append(setLoadBalancerValues(ctx, &data, loadBalancer)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, loadBalancerIdentity, data.ID.ValueString())...)
 }
 
 func shouldAttachIPAddress(planIPAddress loadbalancerIPAddressModel, lbIPAddresses []upcloud.LoadBalancerFloatingIPAddress) bool {
@@ -640,6 +668,13 @@ func (r *loadBalancerResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	ctx, cancel, diags := utils.TimeoutContext(ctx, data.Timeouts, utils.TimeoutUpdate)
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var dataIPAddresses []loadbalancerIPAddressModel
 	resp.Diagnostics.Append(data.IPAddresses.ElementsAs(ctx, &dataIPAddresses, false)...)
 	if resp.Diagnostics.HasError() {
@@ -677,7 +712,7 @@ func (r *loadBalancerResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 
 	if didRemoveIPAddresses {
-		_, diags := waitForRunningState(ctx, r.client, data, "removing IP addresses")
+		_, diags = waitForRunningState(ctx, r.client, data, "removing IP addresses")
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -768,7 +803,7 @@ func (r *loadBalancerResource) Update(ctx context.Context, req resource.UpdateRe
 
 	data.ID = types.StringValue(loadBalancer.UUID)
 
-	loadBalancer, diags := waitForRunningState(ctx, r.client, data, "update")
+	loadBalancer, diags = waitForRunningState(ctx, r.client, data, "update")
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -786,6 +821,13 @@ func (r *loadBalancerResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	ctx, cancel, diags := utils.TimeoutContext(ctx, data.Timeouts, utils.TimeoutDelete)
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := r.client.DeleteLoadBalancer(ctx, &request.DeleteLoadBalancerRequest{
 		UUID: data.ID.ValueString(),
 	}); err != nil {
@@ -802,11 +844,17 @@ func (r *loadBalancerResource) Delete(ctx context.Context, req resource.DeleteRe
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error waiting for loadbalancer to be deleted",
-			utils.ErrorDiagnosticDetail(err),
+			utils.WaitErrorDiagnosticDetail(ctx, err),
 		)
 	}
 }
 
+var loadBalancerIdentity = utils.UUIDIdentity("load balancer")
+
+func (r *loadBalancerResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(loadBalancerIdentity)
+}
+
 func (r *loadBalancerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, loadBalancerIdentity, req, resp)
 }
@@ -0,0 +1,839 @@
+package loadbalancer
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewLoadBalancerDataSource() datasource.DataSource {
+	return &loadBalancerDataSource{}
+}
+
+var (
+	_ datasource.DataSource                     = &loadBalancerDataSource{}
+	_ datasource.DataSourceWithConfigure        = &loadBalancerDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &loadBalancerDataSource{}
+)
+
+type loadBalancerDataSource struct {
+	client *service.Service
+}
+
+func (d *loadBalancerDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_loadbalancer"
+}
+
+func (d *loadBalancerDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, resp.Diagnostics = utils.GetClientFromProviderData(req.ProviderData)
+}
+
+type loadBalancerDataSourceModel struct {
+	loadBalancerDataModel
+	Network types.String `tfsdk:"network"`
+}
+
+type loadBalancerDataModel struct {
+	ID                 types.String                             `tfsdk:"id"`
+	Name               types.String                             `tfsdk:"name"`
+	Zone               types.String                             `tfsdk:"zone"`
+	Plan               types.String                             `tfsdk:"plan"`
+	ConfiguredStatus   types.String                             `tfsdk:"configured_status"`
+	OperationalState   types.String                             `tfsdk:"operational_state"`
+	Labels             types.Map                                `tfsdk:"labels"`
+	MaintenanceDOW     types.String                             `tfsdk:"maintenance_dow"`
+	MaintenanceTime    types.String                             `tfsdk:"maintenance_time"`
+	Networks           []loadbalancerNetworkModel               `tfsdk:"networks"`
+	IPAddresses        []loadbalancerIPAddressModel             `tfsdk:"ip_addresses"`
+	Nodes              []loadbalancerNodeModel                  `tfsdk:"nodes"`
+	Resolvers          []string                                 `tfsdk:"resolvers"`
+	Frontends          []loadBalancerDataFrontendModel          `tfsdk:"frontends"`
+	Backends           []loadBalancerDataBackendModel           `tfsdk:"backends"`
+	CertificateBundles []loadBalancerDataCertificateBundleModel `tfsdk:"certificate_bundles"`
+}
+
+type loadBalancerDataFrontendModel struct {
+	Name               types.String                     `tfsdk:"name"`
+	Mode               types.String                     `tfsdk:"mode"`
+	Port               types.Int64                      `tfsdk:"port"`
+	DefaultBackendName types.String                     `tfsdk:"default_backend_name"`
+	Rules              []string                         `tfsdk:"rules"`
+	TLSConfigs         []loadBalancerDataTLSConfigModel `tfsdk:"tls_configs"`
+	Networks           []networkModel                   `tfsdk:"networks"`
+	Properties         []propertiesModel                `tfsdk:"properties"`
+}
+
+type loadBalancerDataBackendModel struct {
+	Name         types.String                         `tfsdk:"name"`
+	ResolverName types.String                         `tfsdk:"resolver_name"`
+	Members      []loadBalancerDataBackendMemberModel `tfsdk:"members"`
+	TLSConfigs   []loadBalancerDataTLSConfigModel     `tfsdk:"tls_configs"`
+	Properties   []backendPropertiesModel             `tfsdk:"properties"`
+}
+
+type loadBalancerDataBackendMemberModel struct {
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	IP          types.String `tfsdk:"ip"`
+	Port        types.Int64  `tfsdk:"port"`
+	Weight      types.Int64  `tfsdk:"weight"`
+	MaxSessions types.Int64  `tfsdk:"max_sessions"`
+}
+
+type loadBalancerDataTLSConfigModel struct {
+	Name              types.String `tfsdk:"name"`
+	CertificateBundle types.String `tfsdk:"certificate_bundle"`
+}
+
+type loadBalancerDataCertificateBundleModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Type             types.String `tfsdk:"type"`
+	Hostnames        []string     `tfsdk:"hostnames"`
+	KeyType          types.String `tfsdk:"key_type"`
+	NotAfter         types.String `tfsdk:"not_after"`
+	NotBefore        types.String `tfsdk:"not_before"`
+	OperationalState types.String `tfsdk:"operational_state"`
+}
+
+func loadBalancerDataTLSConfigAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the TLS config.",
+			Computed:            true,
+		},
+		"certificate_bundle": schema.StringAttribute{
+			MarkdownDescription: "UUID of the certificate bundle used in the TLS config.",
+			Computed:            true,
+		},
+	}
+}
+
+// loadBalancerDataAttributes returns the attributes describing a load balancer in `upcloud_loadbalancer` and `upcloud_loadbalancers` data sources.
+func loadBalancerDataAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The unique identifier of the load balancer.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the service.",
+			Computed:            true,
+		},
+		"zone": schema.StringAttribute{
+			MarkdownDescription: "Zone in which the service is hosted, e.g. `fi-hel1`.",
+			Computed:            true,
+		},
+		"plan": schema.StringAttribute{
+			MarkdownDescription: "Plan of the service.",
+			Computed:            true,
+		},
+		"configured_status": schema.StringAttribute{
+			MarkdownDescription: "The service configured status indicates the service's current intended status. Managed by the customer.",
+			Computed:            true,
+		},
+		"operational_state": schema.StringAttribute{
+			MarkdownDescription: "The service operational state indicates the service's current operational, effective state. Managed by the system.",
+			Computed:            true,
+		},
+		"labels": schema.MapAttribute{
+			MarkdownDescription: "User defined key-value pairs to classify the load balancer.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"maintenance_dow": schema.StringAttribute{
+			MarkdownDescription: "The day of the week on which maintenance will be performed.",
+			Computed:            true,
+		},
+		"maintenance_time": schema.StringAttribute{
+			MarkdownDescription: "The time at which the maintenance will begin in UTC.",
+			Computed:            true,
+		},
+		"networks": schema.ListNestedAttribute{
+			MarkdownDescription: "Networks attached to the load balancer.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"dns_name": schema.StringAttribute{
+						MarkdownDescription: "DNS name of the load balancer network.",
+						Computed:            true,
+					},
+					"family": schema.StringAttribute{
+						MarkdownDescription: "Network family.",
+						Computed:            true,
+					},
+					"id": schema.StringAttribute{
+						MarkdownDescription: "The unique identifier of the network.",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "The name of the network.",
+						Computed:            true,
+					},
+					"network": schema.StringAttribute{
+						MarkdownDescription: "Private network UUID. Empty for public networks.",
+						Computed:            true,
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The type of the network, `public` or `private`.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+		"ip_addresses": schema.SetNestedAttribute{
+			MarkdownDescription: "Floating IP addresses connected to the load balancer.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"network_name": schema.StringAttribute{
+						MarkdownDescription: "Name of the network where the IP address is attached.",
+						Computed:            true,
+					},
+					"address": schema.StringAttribute{
+						MarkdownDescription: "Floating IP address.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+		"nodes": loadBalancerNodesDataAttribute(),
+		"resolvers": schema.ListAttribute{
+			MarkdownDescription: "Names of the domain name resolvers.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"frontends": schema.ListNestedAttribute{
+			MarkdownDescription: "Frontends receive the traffic before dispatching it to the backends.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "The name of the frontend.",
+						Computed:            true,
+					},
+					"mode": schema.StringAttribute{
+						MarkdownDescription: "The mode of the frontend, `tcp` or `http`.",
+						Computed:            true,
+					},
+					"port": schema.Int64Attribute{
+						MarkdownDescription: "Port to listen for incoming requests.",
+						Computed:            true,
+					},
+					"default_backend_name": schema.StringAttribute{
+						MarkdownDescription: "The name of the backend where traffic is routed by default.",
+						Computed:            true,
+					},
+					"rules": schema.ListAttribute{
+						MarkdownDescription: "Names of the frontend rules.",
+						Computed:            true,
+						ElementType:         types.StringType,
+					},
+					"tls_configs": schema.ListNestedAttribute{
+						MarkdownDescription: "TLS configs of the frontend.",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: loadBalancerDataTLSConfigAttributes(),
+						},
+					},
+					"networks": schema.ListNestedAttribute{
+						MarkdownDescription: "Networks that the frontend is listening.",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									MarkdownDescription: "Name of the load balancer network.",
+									Computed:            true,
+								},
+							},
+						},
+					},
+					"properties": schema.ListNestedAttribute{
+						MarkdownDescription: "Frontend properties.",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"timeout_client": schema.Int64Attribute{
+									MarkdownDescription: "Client request timeout in seconds.",
+									Computed:            true,
+								},
+								"inbound_proxy_protocol": schema.BoolAttribute{
+									MarkdownDescription: "Whether inbound proxy protocol support is enabled.",
+									Computed:            true,
+								},
+								"http2_enabled": schema.BoolAttribute{
+									MarkdownDescription: "Whether HTTP/2 support is enabled.",
+									Computed:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"backends": schema.ListNestedAttribute{
+			MarkdownDescription: "Backends are groups of customer servers whose traffic should be balanced.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "The name of the backend.",
+						Computed:            true,
+					},
+					"resolver_name": schema.StringAttribute{
+						MarkdownDescription: "Domain name resolver used with dynamic type members.",
+						Computed:            true,
+					},
+					"members": schema.ListNestedAttribute{
+						MarkdownDescription: "Backend members receive traffic dispatched from the frontends.",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									MarkdownDescription: "The name of the member.",
+									Computed:            true,
+								},
+								"type": schema.StringAttribute{
+									MarkdownDescription: "The type of the member, `static` or `dynamic`.",
+									Computed:            true,
+								},
+								"enabled": schema.BoolAttribute{
+									MarkdownDescription: "Whether the member is enabled.",
+									Computed:            true,
+								},
+								"ip": schema.StringAttribute{
+									MarkdownDescription: "IP address of the member.",
+									Computed:            true,
+								},
+								"port": schema.Int64Attribute{
+									MarkdownDescription: "Server port of the member.",
+									Computed:            true,
+								},
+								"weight": schema.Int64Attribute{
+									MarkdownDescription: "Weight of the member used when distributing traffic between the backend members.",
+									Computed:            true,
+								},
+								"max_sessions": schema.Int64Attribute{
+									MarkdownDescription: "Maximum number of sessions before queueing.",
+									Computed:            true,
+								},
+							},
+						},
+					},
+					"tls_configs": schema.ListNestedAttribute{
+						MarkdownDescription: "TLS configs of the backend.",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: loadBalancerDataTLSConfigAttributes(),
+						},
+					},
+					"properties": schema.ListNestedAttribute{
+						MarkdownDescription: "Backend properties.",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"health_check_expected_status": schema.Int64Attribute{
+									MarkdownDescription: "Expected HTTP status code returned by the customer application to mark server as healthy.",
+									Computed:            true,
+								},
+								"health_check_fall": schema.Int64Attribute{
+									MarkdownDescription: "How many failed health checks are allowed until the backend member is taken off from the rotation.",
+									Computed:            true,
+								},
+								"health_check_interval": schema.Int64Attribute{
+									MarkdownDescription: "Interval between health checks in seconds.",
+									Computed:            true,
+								},
+								"health_check_rise": schema.Int64Attribute{
+									MarkdownDescription: "How many successful health checks are required to put the backend member back into rotation.",
+									Computed:            true,
+								},
+								"health_check_tls_verify": schema.BoolAttribute{
+									MarkdownDescription: "Whether health checks verify the certificate with the system CA certificate bundle.",
+									Computed:            true,
+								},
+								"health_check_type": schema.StringAttribute{
+									MarkdownDescription: "Health check type.",
+									Computed:            true,
+								},
+								"health_check_url": schema.StringAttribute{
+									MarkdownDescription: "Target path for health check HTTP GET requests.",
+									Computed:            true,
+								},
+								"http2_enabled": schema.BoolAttribute{
+									MarkdownDescription: "Whether HTTP/2 connections to backend members are allowed.",
+									Computed:            true,
+								},
+								"outbound_proxy_protocol": schema.StringAttribute{
+									MarkdownDescription: "Outbound proxy protocol version. Empty string if proxy protocol is disabled.",
+									Computed:            true,
+								},
+								"sticky_session_cookie_name": schema.StringAttribute{
+									MarkdownDescription: "Sticky session cookie name. Empty string if sticky session is disabled.",
+									Computed:            true,
+								},
+								"timeout_server": schema.Int64Attribute{
+									MarkdownDescription: "Backend server timeout in seconds.",
+									Computed:            true,
+								},
+								"timeout_tunnel": schema.Int64Attribute{
+									MarkdownDescription: "Maximum inactivity time on the client and server side for tunnels in seconds.",
+									Computed:            true,
+								},
+								"tls_enabled": schema.BoolAttribute{
+									MarkdownDescription: "Whether TLS is used in the connections from the load balancer to backend servers.",
+									Computed:            true,
+								},
+								"tls_use_system_ca": schema.BoolAttribute{
+									MarkdownDescription: "Whether the system CA certificate bundle is used for the certificate verification.",
+									Computed:            true,
+								},
+								"tls_verify": schema.BoolAttribute{
+									MarkdownDescription: "Whether backend servers certificates are verified.",
+									Computed:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"certificate_bundles": schema.ListNestedAttribute{
+			MarkdownDescription: "Certificate bundles used in the TLS configs of the load balancer frontends and backends.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						MarkdownDescription: "UUID of the certificate bundle.",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "The name of the certificate bundle.",
+						Computed:            true,
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The type of the certificate bundle, `manual`, `dynamic`, or `authority`.",
+						Computed:            true,
+					},
+					"hostnames": schema.ListAttribute{
+						MarkdownDescription: "Certificate hostnames of a dynamic certificate bundle.",
+						Computed:            true,
+						ElementType:         types.StringType,
+					},
+					"key_type": schema.StringAttribute{
+						MarkdownDescription: "Private key type of a dynamic certificate bundle.",
+						Computed:            true,
+					},
+					"not_after": schema.StringAttribute{
+						MarkdownDescription: "The time after which a certificate is no longer valid.",
+						Computed:            true,
+					},
+					"not_before": schema.StringAttribute{
+						MarkdownDescription: "The time on which a certificate becomes valid.",
+						Computed:            true,
+					},
+					"operational_state": schema.StringAttribute{
+						MarkdownDescription: "The certificate bundle operational state. Managed by the system.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func loadBalancerNodesDataAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: "Nodes are instances running load balancer service.",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"operational_state": schema.StringAttribute{
+					MarkdownDescription: "Node's operational state. Managed by the system.",
+					Computed:            true,
+				},
+				"networks": schema.ListNestedAttribute{
+					MarkdownDescription: "Networks attached to the node.",
+					Computed:            true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"name": schema.StringAttribute{
+								MarkdownDescription: "The name of the network.",
+								Computed:            true,
+							},
+							"type": schema.StringAttribute{
+								MarkdownDescription: "The type of the network.",
+								Computed:            true,
+							},
+							"ip_addresses": schema.ListNestedAttribute{
+								MarkdownDescription: "IP addresses attached to the network.",
+								Computed:            true,
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+										"address": schema.StringAttribute{
+											MarkdownDescription: "Node's IP address.",
+											Computed:            true,
+										},
+										"listen": schema.BoolAttribute{
+											MarkdownDescription: "Whether the node listens to the traffic.",
+											Computed:            true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type loadBalancerFilters struct {
+	name      string
+	nameRegex *regexp.Regexp
+	zone      string
+	network   string
+	labels    map[string]string
+}
+
+func (f loadBalancerFilters) matches(lb *upcloud.LoadBalancer) bool {
+	if f.name != "" && lb.Name != f.name {
+		return false
+	}
+
+	if f.nameRegex != nil && !f.nameRegex.MatchString(lb.Name) {
+		return false
+	}
+
+	if f.zone != "" && lb.Zone != f.zone {
+		return false
+	}
+
+	if f.network != "" && !loadBalancerHasNetwork(lb, f.network) {
+		return false
+	}
+
+	lbLabels := utils.LabelsSliceToMap(lb.Labels)
+	for k, v := range f.labels {
+		if value, ok := lbLabels[k]; !ok || value != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+func loadBalancerHasNetwork(lb *upcloud.LoadBalancer, network string) bool {
+	if lb.NetworkUUID == network {
+		return true
+	}
+
+	for _, n := range lb.Networks {
+		if n.UUID == network {
+			return true
+		}
+	}
+
+	return false
+}
+
+// loadBalancerCertificateBundleUUIDs returns UUIDs of the certificate bundles used in the TLS configs of the load balancer in the order they are first referenced.
+func loadBalancerCertificateBundleUUIDs(lb *upcloud.LoadBalancer) []string {
+	uuids := make([]string, 0)
+	seen := make(map[string]bool)
+	add := func(uuid string) {
+		if uuid != "" && !seen[uuid] {
+			seen[uuid] = true
+			uuids = append(uuids, uuid)
+		}
+	}
+
+	for _, frontend := range lb.Frontends {
+		for _, tlsConfig := range frontend.TLSConfigs {
+			add(tlsConfig.CertificateBundleUUID)
+		}
+	}
+	for _, backend := range lb.Backends {
+		for _, tlsConfig := range backend.TLSConfigs {
+			add(tlsConfig.CertificateBundleUUID)
+		}
+	}
+
+	return uuids
+}
+
+// getLoadBalancerCertificateBundles reads the certificate bundles used by the load balancer into the bundles map. Bundles that are already in the map are not read again, as the same bundle can be used in multiple load balancers.
+func getLoadBalancerCertificateBundles(ctx context.Context, client *service.Service, lb *upcloud.LoadBalancer, bundles map[string]*upcloud.LoadBalancerCertificateBundle) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, uuid := range loadBalancerCertificateBundleUUIDs(lb) {
+		if _, ok := bundles[uuid]; ok {
+			continue
+		}
+
+		bundle, err := client.GetLoadBalancerCertificateBundle(ctx, &request.GetLoadBalancerCertificateBundleRequest{
+			UUID: uuid,
+		})
+		if err != nil {
+			diags.AddError(
+				fmt.Sprintf("Unable to read loadbalancer certificate bundle %s details", uuid),
+				utils.ErrorDiagnosticDetail(err),
+			)
+			return diags
+		}
+
+		bundles[uuid] = bundle
+	}
+
+	return diags
+}
+
+func setLoadBalancerDataValues(ctx context.Context, data *loadBalancerDataModel, lb *upcloud.LoadBalancer, bundles map[string]*upcloud.LoadBalancerCertificateBundle) diag.Diagnostics {
+	var diags, respDiagnostics diag.Diagnostics
+
+	data.ID = types.StringValue(lb.UUID)
+	data.Name = types.StringValue(lb.Name)
+	data.Zone = types.StringValue(lb.Zone)
+	data.Plan = types.StringValue(lb.Plan)
+	data.ConfiguredStatus = types.StringValue(string(lb.ConfiguredStatus))
+	data.OperationalState = types.StringValue(string(lb.OperationalState))
+	data.MaintenanceDOW = types.StringValue(string(lb.MaintenanceDOW))
+	data.MaintenanceTime = types.StringValue(lb.MaintenanceTime)
+
+	data.Labels, diags = types.MapValueFrom(ctx, types.StringType, utils.LabelsSliceToMap(lb.Labels))
+	respDiagnostics.Append(diags...)
+
+	data.Networks = loadBalancerNetworkValues(lb)
+	data.IPAddresses = loadBalancerIPAddressValues(lb)
+
+	data.Nodes, diags = loadBalancerNodeValues(ctx, lb)
+	respDiagnostics.Append(diags...)
+
+	data.Resolvers = make([]string, 0)
+	for _, resolver := range lb.Resolvers {
+		data.Resolvers = append(data.Resolvers, resolver.Name)
+	}
+
+	data.Frontends = make([]loadBalancerDataFrontendModel, 0)
+	for _, frontend := range lb.Frontends {
+		dataFrontend := loadBalancerDataFrontendModel{
+			Name:               types.StringValue(frontend.Name),
+			Mode:               types.StringValue(string(frontend.Mode)),
+			Port:               types.Int64Value(int64(frontend.Port)),
+			DefaultBackendName: types.StringValue(frontend.DefaultBackend),
+			Rules:              make([]string, 0),
+			TLSConfigs:         make([]loadBalancerDataTLSConfigModel, 0),
+			Networks:           make([]networkModel, 0),
+			Properties:         frontendPropertiesValues(&frontend),
+		}
+
+		for _, rule := range frontend.Rules {
+			dataFrontend.Rules = append(dataFrontend.Rules, rule.Name)
+		}
+
+		for _, tlsConfig := range frontend.TLSConfigs {
+			dataFrontend.TLSConfigs = append(dataFrontend.TLSConfigs, loadBalancerDataTLSConfigModel{
+				Name:              types.StringValue(tlsConfig.Name),
+				CertificateBundle: types.StringValue(tlsConfig.CertificateBundleUUID),
+			})
+		}
+
+		for _, network := range frontend.Networks {
+			dataFrontend.Networks = append(dataFrontend.Networks, networkModel{
+				Name: types.StringValue(network.Name),
+			})
+		}
+
+		data.Frontends = append(data.Frontends, dataFrontend)
+	}
+
+	data.Backends = make([]loadBalancerDataBackendModel, 0)
+	for _, backend := range lb.Backends {
+		dataBackend := loadBalancerDataBackendModel{
+			Name:         types.StringValue(backend.Name),
+			ResolverName: types.StringValue(backend.Resolver),
+			Members:      make([]loadBalancerDataBackendMemberModel, 0),
+			TLSConfigs:   make([]loadBalancerDataTLSConfigModel, 0),
+			Properties:   backendPropertiesValues(&backend),
+		}
+
+		for _, member := range backend.Members {
+			dataBackend.Members = append(dataBackend.Members, loadBalancerDataBackendMemberModel{
+				Name:        types.StringValue(member.Name),
+				Type:        types.StringValue(string(member.Type)),
+				Enabled:     types.BoolValue(member.Enabled),
+				IP:          types.StringValue(member.IP),
+				Port:        types.Int64Value(int64(member.Port)),
+				Weight:      types.Int64Value(int64(member.Weight)),
+				MaxSessions: types.Int64Value(int64(member.MaxSessions)),
+			})
+		}
+
+		for _, tlsConfig := range backend.TLSConfigs {
+			dataBackend.TLSConfigs = append(dataBackend.TLSConfigs, loadBalancerDataTLSConfigModel{
+				Name:              types.StringValue(tlsConfig.Name),
+				CertificateBundle: types.StringValue(tlsConfig.CertificateBundleUUID),
+			})
+		}
+
+		data.Backends = append(data.Backends, dataBackend)
+	}
+
+	data.CertificateBundles = make([]loadBalancerDataCertificateBundleModel, 0)
+	for _, uuid := range loadBalancerCertificateBundleUUIDs(lb) {
+		bundle, ok := bundles[uuid]
+		if !ok {
+			continue
+		}
+
+		data.CertificateBundles = append(data.CertificateBundles, loadBalancerDataCertificateBundleModel{
+			ID:               types.StringValue(bundle.UUID),
+			Name:             types.StringValue(bundle.Name),
+			Type:             types.StringValue(string(bundle.Type)),
+			Hostnames:        utils.NilAsEmptyList(bundle.Hostnames),
+			KeyType:          types.StringValue(bundle.KeyType),
+			NotAfter:         types.StringValue(bundle.NotAfter.Format(time.RFC3339)),
+			NotBefore:        types.StringValue(bundle.NotBefore.Format(time.RFC3339)),
+			OperationalState: types.StringValue(string(bundle.OperationalState)),
+		})
+	}
+
+	return respDiagnostics
+}
+
+func (d *loadBalancerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := loadBalancerDataAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The unique identifier of the load balancer.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the load balancer.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["zone"] = schema.StringAttribute{
+		MarkdownDescription: "Zone in which the service is hosted, e.g. `fi-hel1`. Can be used to limit the lookup to a single zone.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["labels"] = schema.MapAttribute{
+		MarkdownDescription: "User defined key-value pairs to classify the load balancer. When set, the load balancer must have all of the given labels with matching values.",
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+	attributes["network"] = schema.StringAttribute{
+		MarkdownDescription: "Only match load balancers attached to the private network with the given UUID.",
+		Optional:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides details on a single [Managed Load Balancer](https://upcloud.com/products/managed-load-balancer), including its frontends, backends, members, certificate bundles, and node operational states. This can be used to reference load balancers that are not managed in the current configuration. The configured filters must match exactly one load balancer.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *loadBalancerDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+			path.MatchRoot("zone"),
+			path.MatchRoot("labels"),
+			path.MatchRoot("network"),
+		),
+	}
+}
+
+func (d *loadBalancerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data loadBalancerDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters := loadBalancerFilters{
+		name:    data.Name.ValueString(),
+		zone:    data.Zone.ValueString(),
+		network: data.Network.ValueString(),
+		labels:  make(map[string]string),
+	}
+	if !data.Labels.IsNull() {
+		resp.Diagnostics.Append(data.Labels.ElementsAs(ctx, &filters.labels, false)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var lb *upcloud.LoadBalancer
+	if uuid := data.ID.ValueString(); uuid != "" {
+		var err error
+		lb, err = d.client.GetLoadBalancer(ctx, &request.GetLoadBalancerRequest{UUID: uuid})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to read loadbalancer details",
+				utils.ErrorDiagnosticDetail(err),
+			)
+			return
+		}
+
+		if !filters.matches(lb) {
+			resp.Diagnostics.AddError("query returned no results", fmt.Sprintf("Load balancer %s does not match the given filters.", uuid))
+			return
+		}
+	} else {
+		lbs, err := d.client.GetLoadBalancers(ctx, &request.GetLoadBalancersRequest{})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to read loadbalancers",
+				utils.ErrorDiagnosticDetail(err),
+			)
+			return
+		}
+
+		matches := make([]*upcloud.LoadBalancer, 0)
+		for i := range lbs {
+			if filters.matches(&lbs[i]) {
+				matches = append(matches, &lbs[i])
+			}
+		}
+
+		if len(matches) < 1 {
+			resp.Diagnostics.AddError("query returned no results", "Could not find load balancer matching the given filters.")
+			return
+		}
+
+		if len(matches) > 1 {
+			resp.Diagnostics.AddError("query returned more than one result", fmt.Sprintf("Found %d load balancers matching the given filters. Use `id` or more specific filters to select a single load balancer.", len(matches)))
+			return
+		}
+
+		lb = matches[0]
+	}
+
+	bundles := make(map[string]*upcloud.LoadBalancerCertificateBundle)
+	resp.Diagnostics.Append(getLoadBalancerCertificateBundles(ctx, d.client, lb, bundles)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setLoadBalancerDataValues(ctx, &data.loadBalancerDataModel, lb, bundles)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
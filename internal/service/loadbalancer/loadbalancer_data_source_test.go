@@ -0,0 +1,80 @@
+package loadbalancer
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadBalancerFilters(t *testing.T) {
+	lb := &upcloud.LoadBalancer{
+		Name: "web-lb",
+		Zone: "fi-hel1",
+		Labels: []upcloud.Label{
+			{Key: "env", Value: "prod"},
+			{Key: "team", Value: "web"},
+		},
+		Networks: []upcloud.LoadBalancerNetwork{
+			{Name: "public", Type: upcloud.LoadBalancerNetworkTypePublic},
+			{Name: "private", Type: upcloud.LoadBalancerNetworkTypePrivate, UUID: "03000000-0000-4000-8000-000000000001"},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		filters loadBalancerFilters
+		want    bool
+	}{
+		{"no filters", loadBalancerFilters{}, true},
+		{"name", loadBalancerFilters{name: "web-lb"}, true},
+		{"name mismatch", loadBalancerFilters{name: "web"}, false},
+		{"name regex", loadBalancerFilters{nameRegex: regexp.MustCompile("^web-")}, true},
+		{"name regex mismatch", loadBalancerFilters{nameRegex: regexp.MustCompile("^api-")}, false},
+		{"zone", loadBalancerFilters{zone: "fi-hel1"}, true},
+		{"zone mismatch", loadBalancerFilters{zone: "de-fra1"}, false},
+		{"network", loadBalancerFilters{network: "03000000-0000-4000-8000-000000000001"}, true},
+		{"network mismatch", loadBalancerFilters{network: "03000000-0000-4000-8000-000000000002"}, false},
+		{"labels", loadBalancerFilters{labels: map[string]string{"env": "prod"}}, true},
+		{"label value mismatch", loadBalancerFilters{labels: map[string]string{"env": "dev"}}, false},
+		{"missing label", loadBalancerFilters{labels: map[string]string{"env": "prod", "owner": "ops"}}, false},
+		{"all filters", loadBalancerFilters{name: "web-lb", zone: "fi-hel1", network: "03000000-0000-4000-8000-000000000001", labels: map[string]string{"team": "web"}}, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, test.filters.matches(lb))
+		})
+	}
+}
+
+func TestLoadBalancerCertificateBundleUUIDs(t *testing.T) {
+	lb := &upcloud.LoadBalancer{
+		Frontends: []upcloud.LoadBalancerFrontend{
+			{
+				Name: "fe-1",
+				TLSConfigs: []upcloud.LoadBalancerFrontendTLSConfig{
+					{Name: "tls-1", CertificateBundleUUID: "bundle-1"},
+					{Name: "tls-2", CertificateBundleUUID: "bundle-2"},
+				},
+			},
+			{
+				Name: "fe-2",
+				TLSConfigs: []upcloud.LoadBalancerFrontendTLSConfig{
+					{Name: "tls-1", CertificateBundleUUID: "bundle-1"},
+				},
+			},
+		},
+		Backends: []upcloud.LoadBalancerBackend{
+			{
+				Name: "be-1",
+				TLSConfigs: []upcloud.LoadBalancerBackendTLSConfig{
+					{Name: "tls-1", CertificateBundleUUID: "bundle-3"},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, []string{"bundle-1", "bundle-2", "bundle-3"}, loadBalancerCertificateBundleUUIDs(lb))
+	assert.Empty(t, loadBalancerCertificateBundleUUIDs(&upcloud.LoadBalancer{}))
+}
@@ -0,0 +1,148 @@
+package loadbalancer
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewLoadBalancersDataSource() datasource.DataSource {
+	return &loadBalancersDataSource{}
+}
+
+var (
+	_ datasource.DataSource              = &loadBalancersDataSource{}
+	_ datasource.DataSourceWithConfigure = &loadBalancersDataSource{}
+)
+
+type loadBalancersDataSource struct {
+	client *service.Service
+}
+
+func (d *loadBalancersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_loadbalancers"
+}
+
+func (d *loadBalancersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, resp.Diagnostics = utils.GetClientFromProviderData(req.ProviderData)
+}
+
+type loadBalancersModel struct {
+	ID            types.String            `tfsdk:"id"`
+	Zone          types.String            `tfsdk:"zone"`
+	Labels        types.Map               `tfsdk:"labels"`
+	NameRegex     types.String            `tfsdk:"name_regex"`
+	Network       types.String            `tfsdk:"network"`
+	LoadBalancers []loadBalancerDataModel `tfsdk:"loadbalancers"`
+}
+
+func (d *loadBalancersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Returns a list of [Managed Load Balancers](https://upcloud.com/products/managed-load-balancer) matching the given filters, including their frontends, backends, members, certificate bundles, and node operational states. All configured filters must match for a load balancer to be included.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "Only include load balancers in the given zone, e.g. `fi-hel1`.",
+				Optional:            true,
+			},
+			"labels": schema.MapAttribute{
+				MarkdownDescription: "Only include load balancers that have all of the given labels with matching values.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only include load balancers with name matching the given regular expression.",
+				Optional:            true,
+			},
+			"network": schema.StringAttribute{
+				MarkdownDescription: "Only include load balancers attached to the private network with the given UUID.",
+				Optional:            true,
+			},
+			"loadbalancers": schema.ListNestedAttribute{
+				MarkdownDescription: "Load balancers matching the given filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: loadBalancerDataAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *loadBalancersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data loadBalancersModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters := loadBalancerFilters{
+		zone:    data.Zone.ValueString(),
+		network: data.Network.ValueString(),
+		labels:  make(map[string]string),
+	}
+
+	if !data.NameRegex.IsNull() {
+		var err error
+		filters.nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Could not compile name_regex",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if !data.Labels.IsNull() {
+		resp.Diagnostics.Append(data.Labels.ElementsAs(ctx, &filters.labels, false)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lbs, err := d.client.GetLoadBalancers(ctx, &request.GetLoadBalancersRequest{})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read loadbalancers",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	bundles := make(map[string]*upcloud.LoadBalancerCertificateBundle)
+	data.LoadBalancers = make([]loadBalancerDataModel, 0)
+	for i := range lbs {
+		lb := &lbs[i]
+		if !filters.matches(lb) {
+			continue
+		}
+
+		resp.Diagnostics.Append(getLoadBalancerCertificateBundles(ctx, d.client, lb, bundles)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var result loadBalancerDataModel
+		resp.Diagnostics.Append(setLoadBalancerDataValues(ctx, &result, lb, bundles)...)
+		data.LoadBalancers = append(data.LoadBalancers, result)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(time.Now().UTC().String())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -13,7 +13,6 @@ import (
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -26,6 +25,7 @@ var (
 	_ resource.Resource                 = &manualCertificateBundleResource{}
 	_ resource.ResourceWithConfigure    = &manualCertificateBundleResource{}
 	_ resource.ResourceWithImportState  = &manualCertificateBundleResource{}
+	_ resource.ResourceWithIdentity     = &manualCertificateBundleResource{}
 	_ resource.ResourceWithUpgradeState = &manualCertificateBundleResource{}
 )
 
@@ -218,6 +218,7 @@ func (r *manualCertificateBundleResource) Create(ctx context.Context, req resour
 
 	resp.Diagnostics.Append(setManualCertificateBundleValues(ctx, &data, bundle)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, manualCertificateBundleIdentity, data.ID.ValueString())...)
 }
 
 func (r *manualCertificateBundleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -251,6 +252,7 @@ func (r *manualCertificateBundleResource) Read(ctx context.Context, req resource
 
 	resp.Diagnostics.Append(setManualCertificateBundleValues(ctx, &data, bundle)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, manualCertificateBundleIdentity, data.ID.ValueString())...)
 }
 
 func (r *manualCertificateBundleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -296,6 +298,12 @@ func (r *manualCertificateBundleResource) Delete(ctx context.Context, req resour
 	}
 }
 
+var manualCertificateBundleIdentity = utils.UUIDIdentity("certificate bundle")
+
+func (r *manualCertificateBundleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(manualCertificateBundleIdentity)
+}
+
 func (r *manualCertificateBundleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, manualCertificateBundleIdentity, req, resp)
 }
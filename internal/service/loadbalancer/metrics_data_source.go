@@ -0,0 +1,322 @@
+package loadbalancer
+
+import (
+	"context"
+	"strings"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Metrics statuses of backend members that receive traffic. Status of a member that is transitioning between states
+// includes the number of successful health checks, e.g. `UP 1/3` for a member that has started failing its health
+// checks but has not yet been marked as down. Members without health checks report `no check`.
+const (
+	loadBalancerMemberStatusUp      = "up"
+	loadBalancerMemberStatusNoCheck = "no check"
+)
+
+func NewLoadBalancerMetricsDataSource() datasource.DataSource {
+	return &loadBalancerMetricsDataSource{}
+}
+
+var (
+	_ datasource.DataSource              = &loadBalancerMetricsDataSource{}
+	_ datasource.DataSourceWithConfigure = &loadBalancerMetricsDataSource{}
+)
+
+type loadBalancerMetricsDataSource struct {
+	client *service.Service
+}
+
+func (d *loadBalancerMetricsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_loadbalancer_metrics"
+}
+
+func (d *loadBalancerMetricsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, resp.Diagnostics = utils.GetClientFromProviderData(req.ProviderData)
+}
+
+type loadBalancerMetricsModel struct {
+	ID                types.String                       `tfsdk:"id"`
+	OperationalState  types.String                       `tfsdk:"operational_state"`
+	AllMembersHealthy types.Bool                         `tfsdk:"all_members_healthy"`
+	Nodes             []loadbalancerNodeModel            `tfsdk:"nodes"`
+	Frontends         []loadBalancerFrontendMetricsModel `tfsdk:"frontends"`
+	Backends          []loadBalancerBackendMetricsModel  `tfsdk:"backends"`
+}
+
+type loadBalancerFrontendMetricsModel struct {
+	Name                types.String `tfsdk:"name"`
+	CurrentSessions     types.Int64  `tfsdk:"current_sessions"`
+	SessionRate         types.Int64  `tfsdk:"session_rate"`
+	TotalSessions       types.Int64  `tfsdk:"total_sessions"`
+	RequestRate         types.Int64  `tfsdk:"request_rate"`
+	TotalHTTPRequests   types.Int64  `tfsdk:"total_http_requests"`
+	TotalDeniedRequests types.Int64  `tfsdk:"total_denied_requests"`
+	TotalRequestBytes   types.Int64  `tfsdk:"total_request_bytes"`
+	TotalResponseBytes  types.Int64  `tfsdk:"total_response_bytes"`
+}
+
+type loadBalancerBackendMetricsModel struct {
+	Name               types.String                            `tfsdk:"name"`
+	HealthyMembers     types.Int64                             `tfsdk:"healthy_members"`
+	CurrentSessions    types.Int64                             `tfsdk:"current_sessions"`
+	SessionRate        types.Int64                             `tfsdk:"session_rate"`
+	TotalSessions      types.Int64                             `tfsdk:"total_sessions"`
+	TotalRequestBytes  types.Int64                             `tfsdk:"total_request_bytes"`
+	TotalResponseBytes types.Int64                             `tfsdk:"total_response_bytes"`
+	Members            []loadBalancerBackendMemberMetricsModel `tfsdk:"members"`
+}
+
+type loadBalancerBackendMemberMetricsModel struct {
+	Name               types.String `tfsdk:"name"`
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	Status             types.String `tfsdk:"status"`
+	Healthy            types.Bool   `tfsdk:"healthy"`
+	CurrentSessions    types.Int64  `tfsdk:"current_sessions"`
+	SessionRate        types.Int64  `tfsdk:"session_rate"`
+	TotalSessions      types.Int64  `tfsdk:"total_sessions"`
+	TotalRequestBytes  types.Int64  `tfsdk:"total_request_bytes"`
+	TotalResponseBytes types.Int64  `tfsdk:"total_response_bytes"`
+}
+
+func loadBalancerTrafficMetricsAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"current_sessions": schema.Int64Attribute{
+			MarkdownDescription: "Number of currently open sessions.",
+			Computed:            true,
+		},
+		"session_rate": schema.Int64Attribute{
+			MarkdownDescription: "Number of new sessions per second.",
+			Computed:            true,
+		},
+		"total_sessions": schema.Int64Attribute{
+			MarkdownDescription: "Total number of sessions.",
+			Computed:            true,
+		},
+		"total_request_bytes": schema.Int64Attribute{
+			MarkdownDescription: "Total number of received request bytes.",
+			Computed:            true,
+		},
+		"total_response_bytes": schema.Int64Attribute{
+			MarkdownDescription: "Total number of sent response bytes.",
+			Computed:            true,
+		},
+	}
+}
+
+func (d *loadBalancerMetricsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	frontendAttributes := loadBalancerTrafficMetricsAttributes()
+	frontendAttributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the frontend.",
+		Computed:            true,
+	}
+	frontendAttributes["request_rate"] = schema.Int64Attribute{
+		MarkdownDescription: "Number of HTTP requests per second.",
+		Computed:            true,
+	}
+	frontendAttributes["total_http_requests"] = schema.Int64Attribute{
+		MarkdownDescription: "Total number of HTTP requests.",
+		Computed:            true,
+	}
+	frontendAttributes["total_denied_requests"] = schema.Int64Attribute{
+		MarkdownDescription: "Total number of denied requests.",
+		Computed:            true,
+	}
+
+	memberAttributes := loadBalancerTrafficMetricsAttributes()
+	memberAttributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the member.",
+		Computed:            true,
+	}
+	memberAttributes["enabled"] = schema.BoolAttribute{
+		MarkdownDescription: "Indicates if the member is enabled in the backend configuration.",
+		Computed:            true,
+	}
+	memberAttributes["status"] = schema.StringAttribute{
+		MarkdownDescription: "Health status of the member as reported by the load balancer, e.g. `up` or `down`.",
+		Computed:            true,
+	}
+	memberAttributes["healthy"] = schema.BoolAttribute{
+		MarkdownDescription: "Indicates if the member is in service, i.e. its `status` is `up`, `up` followed by the number of health checks while transitioning to down state (e.g. `UP 1/3`), or `no check` when health checks are disabled.",
+		Computed:            true,
+	}
+
+	backendAttributes := loadBalancerTrafficMetricsAttributes()
+	backendAttributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the backend.",
+		Computed:            true,
+	}
+	backendAttributes["healthy_members"] = schema.Int64Attribute{
+		MarkdownDescription: "Number of members that pass their health checks.",
+		Computed:            true,
+	}
+	backendAttributes["members"] = schema.ListNestedAttribute{
+		MarkdownDescription: "Health and traffic metrics of the backend members.",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: memberAttributes,
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides the health of the backend members and the operational states of the nodes of a [Managed Load Balancer](https://upcloud.com/products/managed-load-balancer), as well as traffic metrics of its frontends, backends, and members. Use, e.g., `all_members_healthy` in postconditions to ensure that all backend members are healthy before continuing deployment.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the load balancer.",
+				Required:            true,
+			},
+			"operational_state": schema.StringAttribute{
+				MarkdownDescription: "The service operational state indicates the service's current operational, effective state. Managed by the system.",
+				Computed:            true,
+			},
+			"all_members_healthy": schema.BoolAttribute{
+				MarkdownDescription: "Indicates if all enabled backend members pass their health checks.",
+				Computed:            true,
+			},
+			"nodes": loadBalancerNodesDataAttribute(),
+			"frontends": schema.ListNestedAttribute{
+				MarkdownDescription: "Traffic metrics of the frontends.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: frontendAttributes,
+				},
+			},
+			"backends": schema.ListNestedAttribute{
+				MarkdownDescription: "Health and traffic metrics of the backends and their members.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: backendAttributes,
+				},
+			},
+		},
+	}
+}
+
+func loadBalancerMemberHealthy(status string) bool {
+	status = strings.ToLower(strings.TrimSpace(status))
+	if status == loadBalancerMemberStatusNoCheck {
+		return true
+	}
+
+	state, _, _ := strings.Cut(status, " ")
+	return state == loadBalancerMemberStatusUp
+}
+
+// setLoadBalancerMetricsValues sets frontend and backend metrics and member health. Enabled state of the members is read from
+// the load balancer configuration: members that are not found in the configuration are considered enabled.
+func setLoadBalancerMetricsValues(data *loadBalancerMetricsModel, lb *upcloud.LoadBalancer, metrics *upcloud.LoadBalancerMetrics) {
+	enabled := make(map[string]bool)
+	for _, backend := range lb.Backends {
+		for _, member := range backend.Members {
+			enabled[utils.MarshalID(backend.Name, member.Name)] = member.Enabled
+		}
+	}
+
+	allMembersHealthy := true
+
+	data.Frontends = make([]loadBalancerFrontendMetricsModel, len(metrics.Frontends))
+	for i, frontend := range metrics.Frontends {
+		data.Frontends[i] = loadBalancerFrontendMetricsModel{
+			Name:                types.StringValue(frontend.Name),
+			CurrentSessions:     types.Int64Value(int64(frontend.CurrentSessions)),
+			SessionRate:         types.Int64Value(int64(frontend.SessionRate)),
+			TotalSessions:       types.Int64Value(int64(frontend.TotalSessions)),
+			RequestRate:         types.Int64Value(int64(frontend.RequestRate)),
+			TotalHTTPRequests:   types.Int64Value(int64(frontend.TotalHTTPRequests)),
+			TotalDeniedRequests: types.Int64Value(int64(frontend.TotalDeniedRequests)),
+			TotalRequestBytes:   types.Int64Value(int64(frontend.TotalRequestBytes)),
+			TotalResponseBytes:  types.Int64Value(int64(frontend.TotalResponseBytes)),
+		}
+	}
+
+	data.Backends = make([]loadBalancerBackendMetricsModel, len(metrics.Backends))
+	for i, backend := range metrics.Backends {
+		var healthyMembers int64
+
+		members := make([]loadBalancerBackendMemberMetricsModel, len(backend.Members))
+		for j, member := range backend.Members {
+			memberEnabled, ok := enabled[utils.MarshalID(backend.Name, member.Name)]
+			if !ok {
+				memberEnabled = true
+			}
+
+			healthy := loadBalancerMemberHealthy(member.Status)
+			if healthy {
+				healthyMembers++
+			} else if memberEnabled {
+				allMembersHealthy = false
+			}
+
+			members[j] = loadBalancerBackendMemberMetricsModel{
+				Name:               types.StringValue(member.Name),
+				Enabled:            types.BoolValue(memberEnabled),
+				Status:             types.StringValue(member.Status),
+				Healthy:            types.BoolValue(healthy),
+				CurrentSessions:    types.Int64Value(int64(member.CurrentSessions)),
+				SessionRate:        types.Int64Value(int64(member.SessionRate)),
+				TotalSessions:      types.Int64Value(int64(member.TotalSessions)),
+				TotalRequestBytes:  types.Int64Value(int64(member.TotalRequestBytes)),
+				TotalResponseBytes: types.Int64Value(int64(member.TotalResponseBytes)),
+			}
+		}
+
+		data.Backends[i] = loadBalancerBackendMetricsModel{
+			Name:               types.StringValue(backend.Name),
+			HealthyMembers:     types.Int64Value(healthyMembers),
+			CurrentSessions:    types.Int64Value(int64(backend.CurrentSessions)),
+			SessionRate:        types.Int64Value(int64(backend.SessionRate)),
+			TotalSessions:      types.Int64Value(int64(backend.TotalSessions)),
+			TotalRequestBytes:  types.Int64Value(int64(backend.TotalRequestBytes)),
+			TotalResponseBytes: types.Int64Value(int64(backend.TotalResponseBytes)),
+			Members:            members,
+		}
+	}
+
+	data.AllMembersHealthy = types.BoolValue(allMembersHealthy)
+}
+
+func (d *loadBalancerMetricsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data loadBalancerMetricsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lb, err := d.client.GetLoadBalancer(ctx, &request.GetLoadBalancerRequest{UUID: data.ID.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read loadbalancer details",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	metrics, err := d.client.GetLoadBalancerMetrics(ctx, &request.GetLoadBalancerMetricsRequest{UUID: lb.UUID})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read loadbalancer metrics",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	data.OperationalState = types.StringValue(string(lb.OperationalState))
+
+	nodes, diags := loadBalancerNodeValues(ctx, lb)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Nodes = nodes
+	setLoadBalancerMetricsValues(&data, lb, metrics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,82 @@
+package loadbalancer
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetLoadBalancerMetricsValues(t *testing.T) {
+	lb := &upcloud.LoadBalancer{
+		Backends: []upcloud.LoadBalancerBackend{
+			{
+				Name: "be-1",
+				Members: []upcloud.LoadBalancerBackendMember{
+					{Name: "member-1", Enabled: true},
+					{Name: "member-2", Enabled: false},
+				},
+			},
+		},
+	}
+
+	metrics := &upcloud.LoadBalancerMetrics{
+		Frontends: []upcloud.LoadBalancerFrontendMetrics{
+			{Name: "fe-1", CurrentSessions: 3, TotalHTTPRequests: 42},
+		},
+		Backends: []upcloud.LoadBalancerBackendMetrics{
+			{
+				Name: "be-1",
+				Members: []upcloud.LoadBalancerBackendMemberMetrics{
+					{Name: "member-1", Status: "UP"},
+					{Name: "member-2", Status: "MAINT"},
+				},
+			},
+		},
+	}
+
+	var data loadBalancerMetricsModel
+	setLoadBalancerMetricsValues(&data, lb, metrics)
+
+	assert.True(t, data.AllMembersHealthy.ValueBool())
+	assert.Equal(t, "fe-1", data.Frontends[0].Name.ValueString())
+	assert.Equal(t, int64(3), data.Frontends[0].CurrentSessions.ValueInt64())
+	assert.Equal(t, int64(42), data.Frontends[0].TotalHTTPRequests.ValueInt64())
+	assert.Equal(t, int64(1), data.Backends[0].HealthyMembers.ValueInt64())
+	assert.True(t, data.Backends[0].Members[0].Healthy.ValueBool())
+	assert.True(t, data.Backends[0].Members[0].Enabled.ValueBool())
+	assert.False(t, data.Backends[0].Members[1].Healthy.ValueBool())
+	assert.False(t, data.Backends[0].Members[1].Enabled.ValueBool())
+
+	// Unhealthy enabled member, or member not found in the configuration, makes the load balancer unhealthy.
+	metrics.Backends[0].Members = append(metrics.Backends[0].Members, upcloud.LoadBalancerBackendMemberMetrics{Name: "member-3", Status: "DOWN"})
+	setLoadBalancerMetricsValues(&data, lb, metrics)
+
+	assert.False(t, data.AllMembersHealthy.ValueBool())
+	assert.True(t, data.Backends[0].Members[2].Enabled.ValueBool())
+	assert.Equal(t, "DOWN", data.Backends[0].Members[2].Status.ValueString())
+}
+
+func TestLoadBalancerMemberHealthy(t *testing.T) {
+	tests := []struct {
+		status string
+		want   bool
+	}{
+		{"UP", true},
+		{"up", true},
+		{"UP 1/3", true},
+		{"UP 2/3", true},
+		{"no check", true},
+		{"DOWN", false},
+		{"DOWN 1/2", false},
+		{"NOLB", false},
+		{"MAINT", false},
+		{"DRAIN", false},
+		{"", false},
+	}
+	for _, test := range tests {
+		t.Run(test.status, func(t *testing.T) {
+			assert.Equal(t, test.want, loadBalancerMemberHealthy(test.status))
+		})
+	}
+}
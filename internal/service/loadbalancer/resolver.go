@@ -25,6 +25,7 @@ var (
 	_ resource.Resource                = &resolverResource{}
 	_ resource.ResourceWithConfigure   = &resolverResource{}
 	_ resource.ResourceWithImportState = &resolverResource{}
+	_ resource.ResourceWithIdentity    = &resolverResource{}
 )
 
 func NewResolverResource() resource.Resource {
@@ -198,6 +199,7 @@ func (r *resolverResource) Create(ctx context.Context, req resource.CreateReques
 
 	resp.Diagnostics.Append(setResolverValues(ctx, &data, resolver)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, resolverIdentity, data.ID.ValueString())...)
 }
 
 func (r *resolverResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -242,6 +244,7 @@ func (r *resolverResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	resp.Diagnostics.Append(setResolverValues(ctx, &data, resolver)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, resolverIdentity, data.ID.ValueString())...)
 }
 
 func (r *resolverResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -318,6 +321,15 @@ func (r *resolverResource) Delete(ctx context.Context, req resource.DeleteReques
 	}
 }
 
+var resolverIdentity = []utils.IdentityAttribute{
+	{Name: "loadbalancer", Description: "UUID of the load balancer."},
+	{Name: "name", Description: "Name of the resolver."},
+}
+
+func (r *resolverResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(resolverIdentity)
+}
+
 func (r *resolverResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, resolverIdentity, req, resp)
 }
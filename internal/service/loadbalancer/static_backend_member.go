@@ -6,7 +6,6 @@ import (
 	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
 
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
@@ -14,6 +13,7 @@ var (
 	_ resource.Resource                = &backendStaticMemberResource{}
 	_ resource.ResourceWithConfigure   = &backendStaticMemberResource{}
 	_ resource.ResourceWithImportState = &backendStaticMemberResource{}
+	_ resource.ResourceWithIdentity    = &backendStaticMemberResource{}
 )
 
 func NewBackendStaticMemberResource() resource.Resource {
@@ -54,6 +54,10 @@ func (r *backendStaticMemberResource) Delete(ctx context.Context, req resource.D
 	r.delete(ctx, req, resp)
 }
 
+func (r *backendStaticMemberResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(backendMemberIdentity)
+}
+
 func (r *backendStaticMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, backendMemberIdentity, req, resp)
 }
@@ -0,0 +1,119 @@
+package managedobjectstorage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	v9 "github.com/UpCloudLtd/upcloud-go-api/v9/pkg/upcloud"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// accessKeyUsernameAttribute returns the schema for the user whose permissions are used with the S3 and IAM-compatible
+// APIs of the service. The attribute only contains the username: the access keys are created on demand, so that
+// secrets are not stored in the state and refresh and delete do not depend on configuration that is not available to them.
+func accessKeyUsernameAttribute(usage string, required bool) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("Name of the managed object storage user whose permissions are used to %s. A temporary access key is created for the user through the UpCloud API for each operation and deleted when the operation completes, so the user can have at most one other access key.", usage),
+		Required:            required,
+		Optional:            !required,
+	}
+}
+
+// temporaryAccessKey is an access key shared by the concurrent operations that use the same user.
+type temporaryAccessKey struct {
+	accessKeyID     string
+	secretAccessKey string
+	refs            int
+}
+
+// temporaryAccessKeys contains the access keys that are in use, keyed by service UUID and username. Creating a separate
+// key for each concurrent operation would easily exceed the limit of two access keys per user, so the operations share
+// the key and it is deleted when the last operation releases it.
+var temporaryAccessKeys = struct {
+	sync.Mutex
+	keys map[string]*temporaryAccessKey
+}{keys: make(map[string]*temporaryAccessKey)}
+
+// acquireAccessKey returns an access key for the given user and a function that releases the key. The release function
+// must be called when the operation completes, also on errors.
+func acquireAccessKey(ctx context.Context, client *v9.ClientWithResponses, serviceUUID, username string) (string, string, func(), diag.Diagnostics) {
+	var diags diag.Diagnostics
+	noop := func() {}
+
+	if username == "" {
+		diags.AddError("Missing managed object storage access key username", missingAccessKeyUsernameDetail)
+		return "", "", noop, diags
+	}
+
+	svcUUID, err := uuid.Parse(serviceUUID)
+	if err != nil {
+		diags.AddError("Invalid service UUID", utils.ErrorDiagnosticDetail(err))
+		return "", "", noop, diags
+	}
+
+	id := utils.MarshalID(serviceUUID, username)
+
+	temporaryAccessKeys.Lock()
+	defer temporaryAccessKeys.Unlock()
+
+	key, ok := temporaryAccessKeys.keys[id]
+	if !ok {
+		apiResp, err := client.CreateObjectStorageAccessKeyWithResponse(ctx, svcUUID, username)
+		if err != nil {
+			diags.AddError(
+				"Unable to create temporary managed object storage access key",
+				utils.ErrorDiagnosticDetail(err),
+			)
+			return "", "", noop, diags
+		}
+		if apiResp.StatusCode() != http.StatusCreated || apiResp.JSON201 == nil || apiResp.JSON201.AccessKeyId == nil || apiResp.JSON201.SecretAccessKey == nil {
+			diags.AddError(
+				"Unable to create temporary managed object storage access key",
+				objectStorageAPIErrorDetail(apiResp.ApplicationproblemJSONDefault, apiResp.Body),
+			)
+			return "", "", noop, diags
+		}
+
+		key = &temporaryAccessKey{
+			accessKeyID:     *apiResp.JSON201.AccessKeyId,
+			secretAccessKey: *apiResp.JSON201.SecretAccessKey,
+		}
+		temporaryAccessKeys.keys[id] = key
+	}
+	key.refs++
+
+	release := func() {
+		// The key must be deleted even if the operation was cancelled, as the user can only have two access keys.
+		releaseAccessKey(context.WithoutCancel(ctx), client, svcUUID, username, id, key)
+	}
+	return key.accessKeyID, key.secretAccessKey, release, diags
+}
+
+func releaseAccessKey(ctx context.Context, client *v9.ClientWithResponses, svcUUID uuid.UUID, username, id string, key *temporaryAccessKey) {
+	temporaryAccessKeys.Lock()
+	defer temporaryAccessKeys.Unlock()
+
+	key.refs--
+	if key.refs > 0 {
+		return
+	}
+	delete(temporaryAccessKeys.keys, id)
+
+	apiResp, err := client.DeleteObjectStorageAccessKeyWithResponse(ctx, svcUUID, username, key.accessKeyID)
+	if err == nil && apiResp.StatusCode() != http.StatusNoContent && apiResp.StatusCode() != http.StatusNotFound {
+		err = fmt.Errorf("%s", objectStorageAPIErrorDetail(apiResp.ApplicationproblemJSONDefault, apiResp.Body))
+	}
+	if err != nil {
+		tflog.Warn(ctx, "Unable to delete temporary managed object storage access key", map[string]interface{}{
+			"username":      username,
+			"access_key_id": key.accessKeyID,
+			"error":         err.Error(),
+		})
+	}
+}
@@ -8,12 +8,18 @@ import (
 	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
 	v9 "github.com/UpCloudLtd/upcloud-go-api/v9/pkg/upcloud"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -21,6 +27,9 @@ var (
 	_ resource.Resource                = &managedObjectStorageBucketResource{}
 	_ resource.ResourceWithConfigure   = &managedObjectStorageBucketResource{}
 	_ resource.ResourceWithImportState = &managedObjectStorageBucketResource{}
+	_ resource.ResourceWithIdentity    = &managedObjectStorageBucketResource{}
+
+	_ resource.ResourceWithValidateConfig = &managedObjectStorageBucketResource{}
 )
 
 func NewBucketResource() resource.Resource {
@@ -41,18 +50,25 @@ func (r *managedObjectStorageBucketResource) Configure(_ context.Context, req re
 }
 
 type bucketModel struct {
-	ID             types.String `tfsdk:"id"`
-	ServiceUUID    types.String `tfsdk:"service_uuid"`
-	Name           types.String `tfsdk:"name"`
-	TotalObjects   types.Int64  `tfsdk:"total_objects"`
-	TotalSizeBytes types.Int64  `tfsdk:"total_size_bytes"`
+	ID                   types.String `tfsdk:"id"`
+	ServiceUUID          types.String `tfsdk:"service_uuid"`
+	Name                 types.String `tfsdk:"name"`
+	TotalObjects         types.Int64  `tfsdk:"total_objects"`
+	TotalSizeBytes       types.Int64  `tfsdk:"total_size_bytes"`
+	AccessKeyUsername    types.String `tfsdk:"access_key_username"`
+	Versioning           types.String `tfsdk:"versioning"`
+	ServerSideEncryption types.String `tfsdk:"server_side_encryption"`
+	LifecycleRules       types.List   `tfsdk:"lifecycle_rule"`
+	CORSRules            types.List   `tfsdk:"cors_rule"`
 }
 
 func (r *managedObjectStorageBucketResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: `This resource represents an UpCloud Managed Object Storage bucket.
 
-~> This resource uses the UpCloud API to manage the Managed Object Storage buckets. The main difference to S3 API is that the buckets can be deleted even when the bucket contains objects.`,
+~> This resource uses the UpCloud API to manage the Managed Object Storage buckets. The main difference to S3 API is that the buckets can be deleted even when the bucket contains objects.
+
+Versioning, lifecycle rules, CORS rules, and default server-side encryption are managed through the S3-compatible API of the service. Managing these requires a user that has permissions to configure the bucket, see ` + "`access_key_username`" + `. Configuration that is not defined in Terraform is not modified. As the user is not known when a bucket is imported, the configuration of an imported bucket is read from the service once ` + "`access_key_username`" + ` has been set on the next apply.`,
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
 				Description: "Name of the bucket.",
@@ -80,8 +96,201 @@ func (r *managedObjectStorageBucketResource) Schema(_ context.Context, _ resourc
 				MarkdownDescription: "Total size of objects stored in the bucket.",
 				Computed:            true,
 			},
+			"access_key_username": accessKeyUsernameAttribute("manage versioning, lifecycle rules, CORS rules, and server-side encryption of the bucket through the S3-compatible API of the service. Required when any of these is defined", false),
+			"versioning": schema.StringAttribute{
+				MarkdownDescription: "Versioning state of the bucket: `Enabled` or `Suspended`. Versioning can not be disabled after it has been enabled. If not set, versioning state is not managed.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Enabled", "Suspended"),
+				},
+			},
+			"server_side_encryption": schema.StringAttribute{
+				MarkdownDescription: "Algorithm used for default server-side encryption of new objects, e.g. `AES256`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
 		},
+		Blocks: map[string]schema.Block{
+			"lifecycle_rule": schema.ListNestedBlock{
+				MarkdownDescription: "Lifecycle rules for expiring and transitioning objects in the bucket.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Unique identifier of the rule.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 255),
+							},
+						},
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the rule is enabled.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+						},
+						"prefix": schema.StringAttribute{
+							MarkdownDescription: "Object key prefix the rule applies to. Rule applies to all objects by default.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(""),
+						},
+						"expiration_days": schema.Int64Attribute{
+							MarkdownDescription: "Number of days after object creation when the object expires.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"noncurrent_version_expiration_days": schema.Int64Attribute{
+							MarkdownDescription: "Number of days after an object version becomes noncurrent when the version is deleted.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"abort_incomplete_multipart_upload_days": schema.Int64Attribute{
+							MarkdownDescription: "Number of days after initiation when incomplete multipart uploads are aborted.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"transition": schema.ListNestedBlock{
+							MarkdownDescription: "Transition objects to another storage class.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"days": schema.Int64Attribute{
+										MarkdownDescription: "Number of days after object creation when the object is transitioned.",
+										Required:            true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(0),
+										},
+									},
+									"storage_class": schema.StringAttribute{
+										MarkdownDescription: "Storage class the object is transitioned to.",
+										Required:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"cors_rule": schema.ListNestedBlock{
+				MarkdownDescription: "Cross-origin resource sharing (CORS) rules of the bucket.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Unique identifier of the rule.",
+							Optional:            true,
+						},
+						"allowed_methods": schema.ListAttribute{
+							MarkdownDescription: "HTTP methods allowed from the origins, e.g. `GET`, `PUT`, `POST`, `DELETE`, or `HEAD`.",
+							ElementType:         types.StringType,
+							Required:            true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.ValueStringsAre(stringvalidator.OneOf("GET", "PUT", "POST", "DELETE", "HEAD")),
+							},
+						},
+						"allowed_origins": schema.ListAttribute{
+							MarkdownDescription: "Origins allowed to access the bucket.",
+							ElementType:         types.StringType,
+							Required:            true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+						"allowed_headers": schema.ListAttribute{
+							MarkdownDescription: "Headers allowed in preflight requests.",
+							ElementType:         types.StringType,
+							Optional:            true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+						"expose_headers": schema.ListAttribute{
+							MarkdownDescription: "Response headers that are accessible from client applications.",
+							ElementType:         types.StringType,
+							Optional:            true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+						"max_age_seconds": schema.Int64Attribute{
+							MarkdownDescription: "Time in seconds that the browser can cache the preflight response.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *managedObjectStorageBucketResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data bucketModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.AccessKeyUsername.IsNull() {
+		return
+	}
+
+	configured := map[string]bool{
+		"versioning":             !data.Versioning.IsNull(),
+		"server_side_encryption": !data.ServerSideEncryption.IsNull(),
+		"lifecycle_rule":         data.LifecycleRules.IsUnknown() || len(data.LifecycleRules.Elements()) > 0,
+		"cors_rule":              data.CORSRules.IsUnknown() || len(data.CORSRules.Elements()) > 0,
+	}
+	for _, name := range []string{"versioning", "server_side_encryption", "lifecycle_rule", "cors_rule"} {
+		if configured[name] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing bucket access key username",
+				fmt.Sprintf("`access_key_username` must be defined to manage `%s` of the bucket.", name),
+			)
+		}
+	}
+}
+
+// bucketConfigurationManaged reports whether any of the bucket configuration managed through the S3 API is defined.
+func bucketConfigurationManaged(data *bucketModel) bool {
+	return !data.Versioning.IsNull() ||
+		!data.ServerSideEncryption.IsNull() ||
+		len(data.LifecycleRules.Elements()) > 0 ||
+		len(data.CORSRules.Elements()) > 0
+}
+
+// configureBucket applies the bucket configuration managed through the S3 API and reads it back to the data. State is nil
+// when the bucket is created.
+func (r *managedObjectStorageBucketResource) configureBucket(ctx context.Context, data, state *bucketModel) diag.Diagnostics {
+	if !bucketConfigurationManaged(data) && (state == nil || !bucketConfigurationManaged(state)) {
+		return nil
 	}
+
+	s3, release, diags := getS3Client(ctx, r.client, data.ServiceUUID.ValueString(), data.AccessKeyUsername.ValueString())
+	defer release()
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(applyBucketConfiguration(ctx, s3, data.Name.ValueString(), data, state)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(setBucketConfigurationValues(ctx, data, s3, data.Name.ValueString())...)
+	return diags
 }
 
 func setBucketValues(data *bucketModel, detail *v9.ObjectStorage2BucketDetailResponse, fallbackName string) {
@@ -153,7 +362,9 @@ func (r *managedObjectStorageBucketResource) Create(ctx context.Context, req res
 	}
 
 	setBucketValues(&data, apiResp.JSON201, data.Name.ValueString())
+	resp.Diagnostics.Append(r.configureBucket(ctx, &data, nil)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, bucketIdentity, data.ID.ValueString())...)
 }
 
 func getBucket(ctx context.Context, serviceUUID, name string, client *v9.ClientWithResponses) (*v9.ObjectStorage2BucketDetailResponse, diag.Diagnostics) {
@@ -237,7 +448,23 @@ func (r *managedObjectStorageBucketResource) Read(ctx context.Context, req resou
 	}
 
 	setBucketValues(&data, bucket, name)
+
+	// The configuration is read whenever the user is known, also when it is not defined in the state, e.g. after import.
+	if !data.AccessKeyUsername.IsNull() {
+		s3, release, diags := getS3Client(ctx, r.client, serviceUUID, data.AccessKeyUsername.ValueString())
+		defer release()
+		resp.Diagnostics.Append(diags...)
+		if s3 != nil {
+			resp.Diagnostics.Append(setBucketConfigurationValues(ctx, &data, s3, name)...)
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, bucketIdentity, data.ID.ValueString())...)
 }
 
 func (r *managedObjectStorageBucketResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -270,6 +497,7 @@ func (r *managedObjectStorageBucketResource) Update(ctx context.Context, req res
 	}
 
 	setBucketValues(&data, bucket, name)
+	resp.Diagnostics.Append(r.configureBucket(ctx, &data, &state)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -314,6 +542,15 @@ func (r *managedObjectStorageBucketResource) Delete(ctx context.Context, req res
 	}
 }
 
+var bucketIdentity = []utils.IdentityAttribute{
+	{Name: "service_uuid", Description: "UUID of the managed object storage service."},
+	{Name: "name", Description: "Name of the bucket."},
+}
+
+func (r *managedObjectStorageBucketResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(bucketIdentity)
+}
+
 func (r *managedObjectStorageBucketResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, bucketIdentity, req, resp)
 }
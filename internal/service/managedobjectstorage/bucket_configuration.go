@@ -0,0 +1,442 @@
+package managedobjectstorage
+
+import (
+	"context"
+	"encoding/xml"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	s3VersioningSubresource = "versioning"
+	s3LifecycleSubresource  = "lifecycle"
+	s3CORSSubresource       = "cors"
+	s3EncryptionSubresource = "encryption"
+
+	s3LifecycleRuleStatusEnabled  = "Enabled"
+	s3LifecycleRuleStatusDisabled = "Disabled"
+)
+
+type s3VersioningConfiguration struct {
+	XMLName xml.Name `xml:"VersioningConfiguration"`
+	Xmlns   string   `xml:"xmlns,attr,omitempty"`
+	Status  string   `xml:"Status,omitempty"`
+}
+
+type s3LifecycleConfiguration struct {
+	XMLName xml.Name          `xml:"LifecycleConfiguration"`
+	Xmlns   string            `xml:"xmlns,attr,omitempty"`
+	Rules   []s3LifecycleRule `xml:"Rule"`
+}
+
+type s3LifecycleRule struct {
+	ID                             string                            `xml:"ID,omitempty"`
+	Prefix                         *string                           `xml:"Prefix"`
+	Filter                         *s3LifecycleFilter                `xml:"Filter"`
+	Status                         string                            `xml:"Status"`
+	Expiration                     *s3LifecycleExpiration            `xml:"Expiration"`
+	Transitions                    []s3LifecycleTransition           `xml:"Transition"`
+	NoncurrentVersionExpiration    *s3NoncurrentVersionExpiration    `xml:"NoncurrentVersionExpiration"`
+	AbortIncompleteMultipartUpload *s3AbortIncompleteMultipartUpload `xml:"AbortIncompleteMultipartUpload"`
+}
+
+type s3LifecycleFilter struct {
+	Prefix string `xml:"Prefix"`
+}
+
+type s3LifecycleExpiration struct {
+	Days int `xml:"Days"`
+}
+
+type s3LifecycleTransition struct {
+	Days         int    `xml:"Days"`
+	StorageClass string `xml:"StorageClass"`
+}
+
+type s3NoncurrentVersionExpiration struct {
+	NoncurrentDays int `xml:"NoncurrentDays"`
+}
+
+type s3AbortIncompleteMultipartUpload struct {
+	DaysAfterInitiation int `xml:"DaysAfterInitiation"`
+}
+
+type s3CORSConfiguration struct {
+	XMLName xml.Name     `xml:"CORSConfiguration"`
+	Xmlns   string       `xml:"xmlns,attr,omitempty"`
+	Rules   []s3CORSRule `xml:"CORSRule"`
+}
+
+type s3CORSRule struct {
+	ID             string   `xml:"ID,omitempty"`
+	AllowedHeaders []string `xml:"AllowedHeader"`
+	AllowedMethods []string `xml:"AllowedMethod"`
+	AllowedOrigins []string `xml:"AllowedOrigin"`
+	ExposeHeaders  []string `xml:"ExposeHeader"`
+	MaxAgeSeconds  *int     `xml:"MaxAgeSeconds"`
+}
+
+type s3ServerSideEncryptionConfiguration struct {
+	XMLName xml.Name                     `xml:"ServerSideEncryptionConfiguration"`
+	Xmlns   string                       `xml:"xmlns,attr,omitempty"`
+	Rules   []s3ServerSideEncryptionRule `xml:"Rule"`
+}
+
+type s3ServerSideEncryptionRule struct {
+	ApplyServerSideEncryptionByDefault s3ServerSideEncryptionByDefault `xml:"ApplyServerSideEncryptionByDefault"`
+}
+
+type s3ServerSideEncryptionByDefault struct {
+	SSEAlgorithm string `xml:"SSEAlgorithm"`
+}
+
+type bucketLifecycleRuleModel struct {
+	ID                                 types.String                     `tfsdk:"id"`
+	Enabled                            types.Bool                       `tfsdk:"enabled"`
+	Prefix                             types.String                     `tfsdk:"prefix"`
+	ExpirationDays                     types.Int64                      `tfsdk:"expiration_days"`
+	NoncurrentVersionExpirationDays    types.Int64                      `tfsdk:"noncurrent_version_expiration_days"`
+	AbortIncompleteMultipartUploadDays types.Int64                      `tfsdk:"abort_incomplete_multipart_upload_days"`
+	Transitions                        []bucketLifecycleTransitionModel `tfsdk:"transition"`
+}
+
+type bucketLifecycleTransitionModel struct {
+	Days         types.Int64  `tfsdk:"days"`
+	StorageClass types.String `tfsdk:"storage_class"`
+}
+
+type bucketCORSRuleModel struct {
+	ID             types.String `tfsdk:"id"`
+	AllowedHeaders []string     `tfsdk:"allowed_headers"`
+	AllowedMethods []string     `tfsdk:"allowed_methods"`
+	AllowedOrigins []string     `tfsdk:"allowed_origins"`
+	ExposeHeaders  []string     `tfsdk:"expose_headers"`
+	MaxAgeSeconds  types.Int64  `tfsdk:"max_age_seconds"`
+}
+
+func int64ValueOrNull(v int) types.Int64 {
+	if v == 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(v))
+}
+
+func stringValueOrNull(v string) types.String {
+	if v == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(v)
+}
+
+func buildLifecycleConfiguration(rules []bucketLifecycleRuleModel) *s3LifecycleConfiguration {
+	config := &s3LifecycleConfiguration{Xmlns: s3XMLNamespace}
+
+	for _, rule := range rules {
+		apiRule := s3LifecycleRule{
+			ID:     rule.ID.ValueString(),
+			Filter: &s3LifecycleFilter{Prefix: rule.Prefix.ValueString()},
+			Status: s3LifecycleRuleStatusEnabled,
+		}
+
+		if !rule.Enabled.IsNull() && !rule.Enabled.ValueBool() {
+			apiRule.Status = s3LifecycleRuleStatusDisabled
+		}
+
+		if !rule.ExpirationDays.IsNull() {
+			apiRule.Expiration = &s3LifecycleExpiration{Days: int(rule.ExpirationDays.ValueInt64())}
+		}
+
+		if !rule.NoncurrentVersionExpirationDays.IsNull() {
+			apiRule.NoncurrentVersionExpiration = &s3NoncurrentVersionExpiration{NoncurrentDays: int(rule.NoncurrentVersionExpirationDays.ValueInt64())}
+		}
+
+		if !rule.AbortIncompleteMultipartUploadDays.IsNull() {
+			apiRule.AbortIncompleteMultipartUpload = &s3AbortIncompleteMultipartUpload{DaysAfterInitiation: int(rule.AbortIncompleteMultipartUploadDays.ValueInt64())}
+		}
+
+		for _, transition := range rule.Transitions {
+			apiRule.Transitions = append(apiRule.Transitions, s3LifecycleTransition{
+				Days:         int(transition.Days.ValueInt64()),
+				StorageClass: transition.StorageClass.ValueString(),
+			})
+		}
+
+		config.Rules = append(config.Rules, apiRule)
+	}
+
+	return config
+}
+
+func lifecycleRulesValues(config *s3LifecycleConfiguration) []bucketLifecycleRuleModel {
+	rules := make([]bucketLifecycleRuleModel, 0)
+	if config == nil {
+		return rules
+	}
+
+	for _, apiRule := range config.Rules {
+		var prefix string
+		if apiRule.Filter != nil {
+			prefix = apiRule.Filter.Prefix
+		} else if apiRule.Prefix != nil {
+			prefix = *apiRule.Prefix
+		}
+
+		rule := bucketLifecycleRuleModel{
+			ID:                                 types.StringValue(apiRule.ID),
+			Enabled:                            types.BoolValue(apiRule.Status == s3LifecycleRuleStatusEnabled),
+			Prefix:                             types.StringValue(prefix),
+			ExpirationDays:                     types.Int64Null(),
+			NoncurrentVersionExpirationDays:    types.Int64Null(),
+			AbortIncompleteMultipartUploadDays: types.Int64Null(),
+			Transitions:                        make([]bucketLifecycleTransitionModel, 0),
+		}
+
+		if apiRule.Expiration != nil {
+			rule.ExpirationDays = int64ValueOrNull(apiRule.Expiration.Days)
+		}
+
+		if apiRule.NoncurrentVersionExpiration != nil {
+			rule.NoncurrentVersionExpirationDays = int64ValueOrNull(apiRule.NoncurrentVersionExpiration.NoncurrentDays)
+		}
+
+		if apiRule.AbortIncompleteMultipartUpload != nil {
+			rule.AbortIncompleteMultipartUploadDays = int64ValueOrNull(apiRule.AbortIncompleteMultipartUpload.DaysAfterInitiation)
+		}
+
+		for _, transition := range apiRule.Transitions {
+			rule.Transitions = append(rule.Transitions, bucketLifecycleTransitionModel{
+				Days:         types.Int64Value(int64(transition.Days)),
+				StorageClass: types.StringValue(transition.StorageClass),
+			})
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func buildCORSConfiguration(rules []bucketCORSRuleModel) *s3CORSConfiguration {
+	config := &s3CORSConfiguration{Xmlns: s3XMLNamespace}
+
+	for _, rule := range rules {
+		apiRule := s3CORSRule{
+			ID:             rule.ID.ValueString(),
+			AllowedHeaders: rule.AllowedHeaders,
+			AllowedMethods: rule.AllowedMethods,
+			AllowedOrigins: rule.AllowedOrigins,
+			ExposeHeaders:  rule.ExposeHeaders,
+		}
+
+		if !rule.MaxAgeSeconds.IsNull() {
+			maxAge := int(rule.MaxAgeSeconds.ValueInt64())
+			apiRule.MaxAgeSeconds = &maxAge
+		}
+
+		config.Rules = append(config.Rules, apiRule)
+	}
+
+	return config
+}
+
+func corsRulesValues(config *s3CORSConfiguration) []bucketCORSRuleModel {
+	rules := make([]bucketCORSRuleModel, 0)
+	if config == nil {
+		return rules
+	}
+
+	for _, apiRule := range config.Rules {
+		rule := bucketCORSRuleModel{
+			ID:             stringValueOrNull(apiRule.ID),
+			AllowedMethods: apiRule.AllowedMethods,
+			AllowedOrigins: apiRule.AllowedOrigins,
+			MaxAgeSeconds:  types.Int64Null(),
+		}
+
+		// Optional lists are null when not configured.
+		if len(apiRule.AllowedHeaders) > 0 {
+			rule.AllowedHeaders = apiRule.AllowedHeaders
+		}
+
+		if len(apiRule.ExposeHeaders) > 0 {
+			rule.ExposeHeaders = apiRule.ExposeHeaders
+		}
+
+		if apiRule.MaxAgeSeconds != nil {
+			rule.MaxAgeSeconds = types.Int64Value(int64(*apiRule.MaxAgeSeconds))
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func buildServerSideEncryptionConfiguration(algorithm string) *s3ServerSideEncryptionConfiguration {
+	return &s3ServerSideEncryptionConfiguration{
+		Xmlns: s3XMLNamespace,
+		Rules: []s3ServerSideEncryptionRule{{
+			ApplyServerSideEncryptionByDefault: s3ServerSideEncryptionByDefault{SSEAlgorithm: algorithm},
+		}},
+	}
+}
+
+func serverSideEncryptionValue(config *s3ServerSideEncryptionConfiguration) types.String {
+	if config == nil || len(config.Rules) == 0 {
+		return types.StringNull()
+	}
+	return stringValueOrNull(config.Rules[0].ApplyServerSideEncryptionByDefault.SSEAlgorithm)
+}
+
+func (c *s3Client) getBucketVersioning(ctx context.Context, bucket string) (string, error) {
+	var config s3VersioningConfiguration
+	if err := c.getXML(ctx, bucket, s3VersioningSubresource, &config); err != nil {
+		return "", err
+	}
+	return config.Status, nil
+}
+
+func (c *s3Client) putBucketVersioning(ctx context.Context, bucket, status string) error {
+	return c.putXML(ctx, bucket, s3VersioningSubresource, &s3VersioningConfiguration{Xmlns: s3XMLNamespace, Status: status})
+}
+
+func (c *s3Client) getBucketLifecycle(ctx context.Context, bucket string) (*s3LifecycleConfiguration, error) {
+	var config s3LifecycleConfiguration
+	if err := c.getXML(ctx, bucket, s3LifecycleSubresource, &config); err != nil {
+		if isS3ErrorCode(err, "NoSuchLifecycleConfiguration") {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &config, nil
+}
+
+func (c *s3Client) getBucketCORS(ctx context.Context, bucket string) (*s3CORSConfiguration, error) {
+	var config s3CORSConfiguration
+	if err := c.getXML(ctx, bucket, s3CORSSubresource, &config); err != nil {
+		if isS3ErrorCode(err, "NoSuchCORSConfiguration") {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &config, nil
+}
+
+func (c *s3Client) getBucketEncryption(ctx context.Context, bucket string) (*s3ServerSideEncryptionConfiguration, error) {
+	var config s3ServerSideEncryptionConfiguration
+	if err := c.getXML(ctx, bucket, s3EncryptionSubresource, &config); err != nil {
+		if isS3ErrorCode(err, "ServerSideEncryptionConfigurationNotFoundError") {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &config, nil
+}
+
+// applyBucketConfiguration updates the bucket configuration managed through the S3 API to match the plan. State is nil
+// when the bucket is created.
+func applyBucketConfiguration(ctx context.Context, s3 *s3Client, bucket string, plan, state *bucketModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !plan.Versioning.IsNull() && (state == nil || !plan.Versioning.Equal(state.Versioning)) {
+		if err := s3.putBucketVersioning(ctx, bucket, plan.Versioning.ValueString()); err != nil {
+			diags.AddError("Unable to configure managed object storage bucket versioning", utils.ErrorDiagnosticDetail(err))
+			return diags
+		}
+	}
+
+	var lifecycleRules []bucketLifecycleRuleModel
+	diags.Append(plan.LifecycleRules.ElementsAs(ctx, &lifecycleRules, false)...)
+
+	var corsRules []bucketCORSRuleModel
+	diags.Append(plan.CORSRules.ElementsAs(ctx, &corsRules, false)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	if len(lifecycleRules) > 0 {
+		if err := s3.putXML(ctx, bucket, s3LifecycleSubresource, buildLifecycleConfiguration(lifecycleRules)); err != nil {
+			diags.AddError("Unable to configure managed object storage bucket lifecycle rules", utils.ErrorDiagnosticDetail(err))
+			return diags
+		}
+	} else if state != nil && len(state.LifecycleRules.Elements()) > 0 {
+		if err := s3.deleteSubresource(ctx, bucket, s3LifecycleSubresource); err != nil {
+			diags.AddError("Unable to delete managed object storage bucket lifecycle rules", utils.ErrorDiagnosticDetail(err))
+			return diags
+		}
+	}
+
+	if len(corsRules) > 0 {
+		if err := s3.putXML(ctx, bucket, s3CORSSubresource, buildCORSConfiguration(corsRules)); err != nil {
+			diags.AddError("Unable to configure managed object storage bucket CORS rules", utils.ErrorDiagnosticDetail(err))
+			return diags
+		}
+	} else if state != nil && len(state.CORSRules.Elements()) > 0 {
+		if err := s3.deleteSubresource(ctx, bucket, s3CORSSubresource); err != nil {
+			diags.AddError("Unable to delete managed object storage bucket CORS rules", utils.ErrorDiagnosticDetail(err))
+			return diags
+		}
+	}
+
+	if !plan.ServerSideEncryption.IsNull() {
+		if err := s3.putXML(ctx, bucket, s3EncryptionSubresource, buildServerSideEncryptionConfiguration(plan.ServerSideEncryption.ValueString())); err != nil {
+			diags.AddError("Unable to configure managed object storage bucket server-side encryption", utils.ErrorDiagnosticDetail(err))
+			return diags
+		}
+	} else if state != nil && !state.ServerSideEncryption.IsNull() {
+		if err := s3.deleteSubresource(ctx, bucket, s3EncryptionSubresource); err != nil {
+			diags.AddError("Unable to delete managed object storage bucket server-side encryption", utils.ErrorDiagnosticDetail(err))
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// setBucketConfigurationValues reads the bucket configuration managed through the S3 API. Versioning and server-side
+// encryption are only read when they are managed in the configuration, as the service may configure defaults for them.
+func setBucketConfigurationValues(ctx context.Context, data *bucketModel, s3 *s3Client, bucket string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !data.Versioning.IsNull() {
+		versioning, err := s3.getBucketVersioning(ctx, bucket)
+		if err != nil {
+			diags.AddError("Unable to read managed object storage bucket versioning", utils.ErrorDiagnosticDetail(err))
+			return diags
+		}
+		data.Versioning = stringValueOrNull(versioning)
+	}
+
+	lifecycle, err := s3.getBucketLifecycle(ctx, bucket)
+	if err != nil {
+		diags.AddError("Unable to read managed object storage bucket lifecycle rules", utils.ErrorDiagnosticDetail(err))
+		return diags
+	}
+
+	cors, err := s3.getBucketCORS(ctx, bucket)
+	if err != nil {
+		diags.AddError("Unable to read managed object storage bucket CORS rules", utils.ErrorDiagnosticDetail(err))
+		return diags
+	}
+
+	if !data.ServerSideEncryption.IsNull() {
+		encryption, err := s3.getBucketEncryption(ctx, bucket)
+		if err != nil {
+			diags.AddError("Unable to read managed object storage bucket server-side encryption", utils.ErrorDiagnosticDetail(err))
+			return diags
+		}
+		data.ServerSideEncryption = serverSideEncryptionValue(encryption)
+	}
+
+	var d diag.Diagnostics
+	data.LifecycleRules, d = types.ListValueFrom(ctx, data.LifecycleRules.ElementType(ctx), lifecycleRulesValues(lifecycle))
+	diags.Append(d...)
+
+	data.CORSRules, d = types.ListValueFrom(ctx, data.CORSRules.ElementType(ctx), corsRulesValues(cors))
+	diags.Append(d...)
+
+	return diags
+}
@@ -0,0 +1,103 @@
+package managedobjectstorage
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildLifecycleConfiguration(t *testing.T) {
+	t.Parallel()
+
+	config := buildLifecycleConfiguration([]bucketLifecycleRuleModel{
+		{
+			ID:                                 types.StringValue("logs"),
+			Enabled:                            types.BoolValue(false),
+			Prefix:                             types.StringValue("logs/"),
+			ExpirationDays:                     types.Int64Value(30),
+			NoncurrentVersionExpirationDays:    types.Int64Null(),
+			AbortIncompleteMultipartUploadDays: types.Int64Value(7),
+			Transitions: []bucketLifecycleTransitionModel{
+				{Days: types.Int64Value(10), StorageClass: types.StringValue("GLACIER")},
+			},
+		},
+	})
+
+	body, err := xml.Marshal(config)
+	require.NoError(t, err)
+	assert.Equal(t, `<LifecycleConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Rule><ID>logs</ID><Filter><Prefix>logs/</Prefix></Filter><Status>Disabled</Status><Expiration><Days>30</Days></Expiration><Transition><Days>10</Days><StorageClass>GLACIER</StorageClass></Transition><AbortIncompleteMultipartUpload><DaysAfterInitiation>7</DaysAfterInitiation></AbortIncompleteMultipartUpload></Rule></LifecycleConfiguration>`, string(body))
+}
+
+func TestLifecycleRulesValues(t *testing.T) {
+	t.Parallel()
+
+	var config s3LifecycleConfiguration
+	require.NoError(t, xml.Unmarshal([]byte(`<LifecycleConfiguration>
+  <Rule>
+    <ID>legacy</ID>
+    <Prefix>tmp/</Prefix>
+    <Status>Enabled</Status>
+    <NoncurrentVersionExpiration><NoncurrentDays>5</NoncurrentDays></NoncurrentVersionExpiration>
+  </Rule>
+  <Rule>
+    <ID>filter</ID>
+    <Filter><Prefix></Prefix></Filter>
+    <Status>Disabled</Status>
+    <Expiration><Days>1</Days></Expiration>
+  </Rule>
+</LifecycleConfiguration>`), &config))
+
+	rules := lifecycleRulesValues(&config)
+	require.Len(t, rules, 2)
+
+	assert.Equal(t, "legacy", rules[0].ID.ValueString())
+	assert.Equal(t, "tmp/", rules[0].Prefix.ValueString())
+	assert.True(t, rules[0].Enabled.ValueBool())
+	assert.True(t, rules[0].ExpirationDays.IsNull())
+	assert.Equal(t, int64(5), rules[0].NoncurrentVersionExpirationDays.ValueInt64())
+	assert.Empty(t, rules[0].Transitions)
+
+	assert.Equal(t, "", rules[1].Prefix.ValueString())
+	assert.False(t, rules[1].Enabled.ValueBool())
+	assert.Equal(t, int64(1), rules[1].ExpirationDays.ValueInt64())
+	assert.True(t, rules[1].AbortIncompleteMultipartUploadDays.IsNull())
+
+	assert.Empty(t, lifecycleRulesValues(nil))
+}
+
+func TestCORSRulesRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	rules := []bucketCORSRuleModel{
+		{
+			ID:             types.StringNull(),
+			AllowedMethods: []string{"GET", "HEAD"},
+			AllowedOrigins: []string{"https://example.com"},
+			ExposeHeaders:  []string{"ETag"},
+			MaxAgeSeconds:  types.Int64Value(3600),
+		},
+	}
+
+	body, err := xml.Marshal(buildCORSConfiguration(rules))
+	require.NoError(t, err)
+	assert.Equal(t, `<CORSConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><CORSRule><AllowedMethod>GET</AllowedMethod><AllowedMethod>HEAD</AllowedMethod><AllowedOrigin>https://example.com</AllowedOrigin><ExposeHeader>ETag</ExposeHeader><MaxAgeSeconds>3600</MaxAgeSeconds></CORSRule></CORSConfiguration>`, string(body))
+
+	var config s3CORSConfiguration
+	require.NoError(t, xml.Unmarshal(body, &config))
+	assert.Equal(t, rules, corsRulesValues(&config))
+}
+
+func TestServerSideEncryptionValue(t *testing.T) {
+	t.Parallel()
+
+	body, err := xml.Marshal(buildServerSideEncryptionConfiguration("AES256"))
+	require.NoError(t, err)
+
+	var config s3ServerSideEncryptionConfiguration
+	require.NoError(t, xml.Unmarshal(body, &config))
+	assert.Equal(t, types.StringValue("AES256"), serverSideEncryptionValue(&config))
+	assert.True(t, serverSideEncryptionValue(nil).IsNull())
+}
@@ -9,7 +9,6 @@ import (
 	v9 "github.com/UpCloudLtd/upcloud-go-api/v9/pkg/upcloud"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -23,6 +22,7 @@ var (
 	_ resource.Resource                = &managedObjectStorageCustomDomainResource{}
 	_ resource.ResourceWithConfigure   = &managedObjectStorageCustomDomainResource{}
 	_ resource.ResourceWithImportState = &managedObjectStorageCustomDomainResource{}
+	_ resource.ResourceWithIdentity    = &managedObjectStorageCustomDomainResource{}
 )
 
 func NewCustomDomainResource() resource.Resource {
@@ -131,6 +131,7 @@ func (r *managedObjectStorageCustomDomainResource) Create(ctx context.Context, r
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, customDomainIdentity, data.ID.ValueString())...)
 }
 
 func (r *managedObjectStorageCustomDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -186,6 +187,7 @@ func (r *managedObjectStorageCustomDomainResource) Read(ctx context.Context, req
 	data.Mode = types.StringPointerValue((*string)(customDomain.Mode))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, customDomainIdentity, data.ID.ValueString())...)
 }
 
 func (r *managedObjectStorageCustomDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -273,6 +275,15 @@ func (r *managedObjectStorageCustomDomainResource) Delete(ctx context.Context, r
 	}
 }
 
+var customDomainIdentity = []utils.IdentityAttribute{
+	{Name: "service_uuid", Description: "UUID of the managed object storage service."},
+	{Name: "domain_name", Description: "Custom domain name."},
+}
+
+func (r *managedObjectStorageCustomDomainResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(customDomainIdentity)
+}
+
 func (r *managedObjectStorageCustomDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, customDomainIdentity, req, resp)
 }
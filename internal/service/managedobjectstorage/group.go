@@ -0,0 +1,233 @@
+package managedobjectstorage
+
+import (
+	"context"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	v9 "github.com/UpCloudLtd/upcloud-go-api/v9/pkg/upcloud"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &managedObjectStorageGroupResource{}
+	_ resource.ResourceWithConfigure   = &managedObjectStorageGroupResource{}
+	_ resource.ResourceWithImportState = &managedObjectStorageGroupResource{}
+	_ resource.ResourceWithIdentity    = &managedObjectStorageGroupResource{}
+)
+
+func NewGroupResource() resource.Resource {
+	return &managedObjectStorageGroupResource{}
+}
+
+type managedObjectStorageGroupResource struct {
+	client *v9.ClientWithResponses
+}
+
+func (r *managedObjectStorageGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_managed_object_storage_group"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *managedObjectStorageGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = utils.GetV9ClientFromProviderData(req.ProviderData)
+}
+
+type groupModel struct {
+	AccessKeyUsername types.String `tfsdk:"access_key_username"`
+	ARN               types.String `tfsdk:"arn"`
+	GroupID           types.String `tfsdk:"group_id"`
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	ServiceUUID       types.String `tfsdk:"service_uuid"`
+}
+
+func (r *managedObjectStorageGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource represents an UpCloud Managed Object Storage user group. Groups are managed through the IAM-compatible API of the service, so the user defined with `access_key_username` must have IAM permissions. See `upcloud_managed_object_storage_group_membership` for adding users to the group and `upcloud_managed_object_storage_group_policy` for attaching policies to the group.",
+		Attributes: map[string]schema.Attribute{
+			"access_key_username": accessKeyUsernameAttribute("manage the group through the IAM-compatible API of the service", true),
+			"arn": schema.StringAttribute{
+				Description: "Group ARN.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Description: "Unique identifier of the group generated by the service.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "ID of the group. ID is in {object storage UUID}/{name} format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Group name.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"service_uuid": schema.StringAttribute{
+				Description: "Managed Object Storage service UUID.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func setGroupValues(data *groupModel, group *iamGroup) {
+	data.ARN = types.StringValue(group.Arn)
+	data.GroupID = types.StringValue(group.GroupID)
+	data.Name = types.StringValue(group.GroupName)
+}
+
+func (r *managedObjectStorageGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data groupModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iam, release, diags := getIAMClient(ctx, r.client, data.ServiceUUID.ValueString(), data.AccessKeyUsername.ValueString())
+	defer release()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := iam.createGroup(ctx, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create managed object storage group",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(utils.MarshalID(data.ServiceUUID.ValueString(), data.Name.ValueString()))
+	setGroupValues(&data, group)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, groupIdentity, data.ID.ValueString())...)
+}
+
+func (r *managedObjectStorageGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data groupModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.ValueString() == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var serviceUUID, name string
+	resp.Diagnostics.Append(utils.UnmarshalIDDiag(data.ID.ValueString(), &serviceUUID, &name)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ServiceUUID = types.StringValue(serviceUUID)
+
+	// The username is not known after import. The group is read when the username has been set on the next apply.
+	if data.AccessKeyUsername.IsNull() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, groupIdentity, data.ID.ValueString())...)
+		return
+	}
+
+	iam, release, diags := getIAMClient(ctx, r.client, serviceUUID, data.AccessKeyUsername.ValueString())
+	defer release()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, _, err := iam.getGroup(ctx, name)
+	if isIAMNotFoundError(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read managed object storage group details",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	setGroupValues(&data, group)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, groupIdentity, data.ID.ValueString())...)
+}
+
+func (r *managedObjectStorageGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data groupModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All configurable attributes, except access_key_username, require replace. The object storage has nothing to update.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *managedObjectStorageGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data groupModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	var serviceUUID, name string
+	resp.Diagnostics.Append(utils.UnmarshalIDDiag(data.ID.ValueString(), &serviceUUID, &name)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iam, release, diags := getIAMClient(ctx, r.client, serviceUUID, data.AccessKeyUsername.ValueString())
+	defer release()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := iam.deleteGroup(ctx, name); err != nil && !isIAMNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Unable to delete managed object storage group",
+			utils.ErrorDiagnosticDetail(err),
+		)
+	}
+}
+
+var groupIdentity = []utils.IdentityAttribute{
+	{Name: "service_uuid", Description: "UUID of the managed object storage service."},
+	{Name: "name", Description: "Name of the group."},
+}
+
+func (r *managedObjectStorageGroupResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(groupIdentity)
+}
+
+func (r *managedObjectStorageGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	utils.ImportStateWithIdentity(ctx, groupIdentity, req, resp)
+}
@@ -0,0 +1,224 @@
+package managedobjectstorage
+
+import (
+	"context"
+	"slices"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	v9 "github.com/UpCloudLtd/upcloud-go-api/v9/pkg/upcloud"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &managedObjectStorageGroupMembershipResource{}
+	_ resource.ResourceWithConfigure   = &managedObjectStorageGroupMembershipResource{}
+	_ resource.ResourceWithImportState = &managedObjectStorageGroupMembershipResource{}
+	_ resource.ResourceWithIdentity    = &managedObjectStorageGroupMembershipResource{}
+)
+
+func NewGroupMembershipResource() resource.Resource {
+	return &managedObjectStorageGroupMembershipResource{}
+}
+
+type managedObjectStorageGroupMembershipResource struct {
+	client *v9.ClientWithResponses
+}
+
+func (r *managedObjectStorageGroupMembershipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_managed_object_storage_group_membership"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *managedObjectStorageGroupMembershipResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = utils.GetV9ClientFromProviderData(req.ProviderData)
+}
+
+type groupMembershipModel struct {
+	AccessKeyUsername types.String `tfsdk:"access_key_username"`
+	Group             types.String `tfsdk:"group"`
+	ID                types.String `tfsdk:"id"`
+	ServiceUUID       types.String `tfsdk:"service_uuid"`
+	Username          types.String `tfsdk:"username"`
+}
+
+func (r *managedObjectStorageGroupMembershipResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource represents an UpCloud Managed Object Storage user group membership. The user gets the permissions of the policies attached to the group. Memberships are managed through the IAM-compatible API of the service with the permissions of the user defined with `access_key_username`.",
+		Attributes: map[string]schema.Attribute{
+			"access_key_username": accessKeyUsernameAttribute("manage the group membership through the IAM-compatible API of the service", true),
+			"group": schema.StringAttribute{
+				Description: "Group name.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "ID of the group membership. ID is in {object storage UUID}/{group name}/{username} format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description: "Username.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"service_uuid": schema.StringAttribute{
+				Description: "Managed Object Storage service UUID.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *managedObjectStorageGroupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data groupMembershipModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iam, release, diags := getIAMClient(ctx, r.client, data.ServiceUUID.ValueString(), data.AccessKeyUsername.ValueString())
+	defer release()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := iam.addUserToGroup(ctx, data.Group.ValueString(), data.Username.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create managed object storage group membership",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(utils.MarshalID(data.ServiceUUID.ValueString(), data.Group.ValueString(), data.Username.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, groupMembershipIdentity, data.ID.ValueString())...)
+}
+
+func (r *managedObjectStorageGroupMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data groupMembershipModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.ValueString() == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var serviceUUID, group, username string
+	resp.Diagnostics.Append(utils.UnmarshalIDDiag(data.ID.ValueString(), &serviceUUID, &group, &username)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ServiceUUID = types.StringValue(serviceUUID)
+	data.Group = types.StringValue(group)
+	data.Username = types.StringValue(username)
+
+	// The username is not known after import. The membership is read when the username has been set on the next apply.
+	if data.AccessKeyUsername.IsNull() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, groupMembershipIdentity, data.ID.ValueString())...)
+		return
+	}
+
+	iam, release, diags := getIAMClient(ctx, r.client, serviceUUID, data.AccessKeyUsername.ValueString())
+	defer release()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, users, err := iam.getGroup(ctx, group)
+	if isIAMNotFoundError(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read managed object storage group members",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	if !slices.Contains(users, username) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, groupMembershipIdentity, data.ID.ValueString())...)
+}
+
+func (r *managedObjectStorageGroupMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data groupMembershipModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All configurable attributes, except access_key_username, require replace. The object storage has nothing to update.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *managedObjectStorageGroupMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data groupMembershipModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	var serviceUUID, group, username string
+	resp.Diagnostics.Append(utils.UnmarshalIDDiag(data.ID.ValueString(), &serviceUUID, &group, &username)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iam, release, diags := getIAMClient(ctx, r.client, serviceUUID, data.AccessKeyUsername.ValueString())
+	defer release()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := iam.removeUserFromGroup(ctx, group, username); err != nil && !isIAMNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Unable to delete managed object storage group membership",
+			utils.ErrorDiagnosticDetail(err),
+		)
+	}
+}
+
+var groupMembershipIdentity = []utils.IdentityAttribute{
+	{Name: "service_uuid", Description: "UUID of the managed object storage service."},
+	{Name: "group", Description: "Name of the group."},
+	{Name: "username", Description: "Name of the user."},
+}
+
+func (r *managedObjectStorageGroupMembershipResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(groupMembershipIdentity)
+}
+
+func (r *managedObjectStorageGroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	utils.ImportStateWithIdentity(ctx, groupMembershipIdentity, req, resp)
+}
@@ -0,0 +1,292 @@
+package managedobjectstorage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	v9 "github.com/UpCloudLtd/upcloud-go-api/v9/pkg/upcloud"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &managedObjectStorageGroupPolicyResource{}
+	_ resource.ResourceWithConfigure   = &managedObjectStorageGroupPolicyResource{}
+	_ resource.ResourceWithImportState = &managedObjectStorageGroupPolicyResource{}
+	_ resource.ResourceWithIdentity    = &managedObjectStorageGroupPolicyResource{}
+)
+
+func NewGroupPolicyResource() resource.Resource {
+	return &managedObjectStorageGroupPolicyResource{}
+}
+
+type managedObjectStorageGroupPolicyResource struct {
+	client *v9.ClientWithResponses
+}
+
+func (r *managedObjectStorageGroupPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_managed_object_storage_group_policy"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *managedObjectStorageGroupPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = utils.GetV9ClientFromProviderData(req.ProviderData)
+}
+
+type groupPolicyModel struct {
+	AccessKeyUsername types.String `tfsdk:"access_key_username"`
+	Group             types.String `tfsdk:"group"`
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	ServiceUUID       types.String `tfsdk:"service_uuid"`
+}
+
+func (r *managedObjectStorageGroupPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource represents an UpCloud Managed Object Storage group policy attachment. The policy applies to all users in the group. Attachments are managed through the IAM-compatible API of the service with the permissions of the user defined with `access_key_username`.",
+		Attributes: map[string]schema.Attribute{
+			"access_key_username": accessKeyUsernameAttribute("manage the policy attachment through the IAM-compatible API of the service", true),
+			"group": schema.StringAttribute{
+				Description: "Group name.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "ID of the group policy attachment. ID is in {object storage UUID}/{group name}/{policy name} format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Policy name.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"service_uuid": schema.StringAttribute{
+				Description: "Managed Object Storage service UUID.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// getPolicyARN returns the ARN of the policy. The ARN is needed for attaching the policy through the IAM-compatible API.
+func (r *managedObjectStorageGroupPolicyResource) getPolicyARN(ctx context.Context, serviceUUID, name string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	svcUUID, err := uuid.Parse(serviceUUID)
+	if err != nil {
+		diags.AddError(
+			"Unable to parse service UUID",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return "", diags
+	}
+
+	apiResp, err := r.client.GetObjectStoragePolicyWithResponse(ctx, svcUUID, name)
+	if err != nil {
+		diags.AddError(
+			"Unable to read managed object storage policy details",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return "", diags
+	}
+	if apiResp.JSON200 == nil || apiResp.JSON200.Arn == nil {
+		diags.AddError(
+			"Unable to read managed object storage policy details",
+			fmt.Sprintf("API returned status %s", apiResp.Status()),
+		)
+		return "", diags
+	}
+
+	return *apiResp.JSON200.Arn, diags
+}
+
+func findAttachedPolicy(policies []iamAttachedPolicy, name string) (iamAttachedPolicy, bool) {
+	for _, p := range policies {
+		if p.PolicyName == name {
+			return p, true
+		}
+	}
+	return iamAttachedPolicy{}, false
+}
+
+func (r *managedObjectStorageGroupPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data groupPolicyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	arn, diags := r.getPolicyARN(ctx, data.ServiceUUID.ValueString(), data.Name.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iam, release, diags := getIAMClient(ctx, r.client, data.ServiceUUID.ValueString(), data.AccessKeyUsername.ValueString())
+	defer release()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := iam.attachGroupPolicy(ctx, data.Group.ValueString(), arn); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create managed object storage group policy",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(utils.MarshalID(data.ServiceUUID.ValueString(), data.Group.ValueString(), data.Name.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, groupPolicyIdentity, data.ID.ValueString())...)
+}
+
+func (r *managedObjectStorageGroupPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data groupPolicyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.ValueString() == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var serviceUUID, group, name string
+	resp.Diagnostics.Append(utils.UnmarshalIDDiag(data.ID.ValueString(), &serviceUUID, &group, &name)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ServiceUUID = types.StringValue(serviceUUID)
+	data.Group = types.StringValue(group)
+	data.Name = types.StringValue(name)
+
+	// The username is not known after import. The policy attachment is read when the username has been set on the next apply.
+	if data.AccessKeyUsername.IsNull() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, groupPolicyIdentity, data.ID.ValueString())...)
+		return
+	}
+
+	iam, release, diags := getIAMClient(ctx, r.client, serviceUUID, data.AccessKeyUsername.ValueString())
+	defer release()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policies, err := iam.listAttachedGroupPolicies(ctx, group)
+	if isIAMNotFoundError(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read managed object storage group policies",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	if _, ok := findAttachedPolicy(policies, name); !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, groupPolicyIdentity, data.ID.ValueString())...)
+}
+
+func (r *managedObjectStorageGroupPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data groupPolicyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All configurable attributes, except access_key_username, require replace. The object storage has nothing to update.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *managedObjectStorageGroupPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data groupPolicyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	var serviceUUID, group, name string
+	resp.Diagnostics.Append(utils.UnmarshalIDDiag(data.ID.ValueString(), &serviceUUID, &group, &name)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iam, release, diags := getIAMClient(ctx, r.client, serviceUUID, data.AccessKeyUsername.ValueString())
+	defer release()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Policy ARN is read from the attached policies, so that the attachment can be removed even if the policy itself
+	// cannot be read anymore.
+	policies, err := iam.listAttachedGroupPolicies(ctx, group)
+	if isIAMNotFoundError(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read managed object storage group policies",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	policy, ok := findAttachedPolicy(policies, name)
+	if !ok {
+		return
+	}
+
+	if err := iam.detachGroupPolicy(ctx, group, policy.PolicyArn); err != nil && !isIAMNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Unable to delete managed object storage group policy",
+			utils.ErrorDiagnosticDetail(err),
+		)
+	}
+}
+
+var groupPolicyIdentity = []utils.IdentityAttribute{
+	{Name: "service_uuid", Description: "UUID of the managed object storage service."},
+	{Name: "group", Description: "Name of the group."},
+	{Name: "name", Description: "Name of the policy."},
+}
+
+func (r *managedObjectStorageGroupPolicyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(groupPolicyIdentity)
+}
+
+func (r *managedObjectStorageGroupPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	utils.ImportStateWithIdentity(ctx, groupPolicyIdentity, req, resp)
+}
@@ -0,0 +1,181 @@
+package managedobjectstorage
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"net/url"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	v9 "github.com/UpCloudLtd/upcloud-go-api/v9/pkg/upcloud"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+const (
+	iamService    = "iam"
+	iamAPIVersion = "2010-05-08"
+)
+
+// iamClient is a minimal client for the IAM-compatible API of a Managed Object Storage service. It is used for the
+// operations that are not available in the UpCloud API, e.g., managing user groups.
+type iamClient struct {
+	s3 *s3Client
+}
+
+type iamGroup struct {
+	GroupName string `xml:"GroupName"`
+	GroupID   string `xml:"GroupId"`
+	Arn       string `xml:"Arn"`
+}
+
+type iamAttachedPolicy struct {
+	PolicyName string `xml:"PolicyName"`
+	PolicyArn  string `xml:"PolicyArn"`
+}
+
+func newIAMClient(endpoint, region, accessKeyID, secretAccessKey string) (*iamClient, error) {
+	s3, err := newS3Client(endpoint, region, accessKeyID, secretAccessKey)
+	if err != nil {
+		return nil, err
+	}
+
+	s3.service = iamService
+	return &iamClient{s3: s3}, nil
+}
+
+// getIAMClient returns IAM client for the public endpoint of the given Managed Object Storage service. The client uses a
+// temporary access key of the given user: the returned release function must be called when the client is no longer used.
+func getIAMClient(ctx context.Context, client *v9.ClientWithResponses, serviceUUID, username string) (*iamClient, func(), diag.Diagnostics) {
+	endpoint, diags := getObjectStorageEndpoint(ctx, client, serviceUUID)
+	if diags.HasError() {
+		return nil, func() {}, diags
+	}
+
+	if endpoint.iamURL == "" {
+		diags.AddError(
+			"Unable to determine managed object storage IAM endpoint",
+			"The public endpoint of the managed object storage service does not have an IAM URL.",
+		)
+		return nil, func() {}, diags
+	}
+
+	accessKeyID, secretAccessKey, release, d := acquireAccessKey(ctx, client, serviceUUID, username)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, release, diags
+	}
+
+	iam, err := newIAMClient(endpoint.iamURL, endpoint.region, accessKeyID, secretAccessKey)
+	if err != nil {
+		diags.AddError(
+			"Unable to initialize managed object storage IAM client",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return nil, release, diags
+	}
+
+	return iam, release, diags
+}
+
+// call sends a query API request for the given action and unmarshals the response into result, if result is not nil.
+func (c *iamClient) call(ctx context.Context, action string, params url.Values, result any) error {
+	form := url.Values{}
+	for k, v := range params {
+		form[k] = v
+	}
+	form.Set("Action", action)
+	form.Set("Version", iamAPIVersion)
+
+	header := http.Header{}
+	header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+
+	body, _, err := c.s3.do(ctx, http.MethodPost, "", "", nil, header, []byte(form.Encode()))
+	if err != nil || result == nil {
+		return err
+	}
+	return xml.Unmarshal(body, result)
+}
+
+func isIAMNotFoundError(err error) bool {
+	return isS3ErrorCode(err, "NoSuchEntity", "NotFound")
+}
+
+func (c *iamClient) createGroup(ctx context.Context, name string) (*iamGroup, error) {
+	var result struct {
+		Group iamGroup `xml:"CreateGroupResult>Group"`
+	}
+	if err := c.call(ctx, "CreateGroup", url.Values{"GroupName": {name}}, &result); err != nil {
+		return nil, err
+	}
+	return &result.Group, nil
+}
+
+// getGroup returns the group and the names of the users in the group.
+func (c *iamClient) getGroup(ctx context.Context, name string) (*iamGroup, []string, error) {
+	var users []string
+	params := url.Values{"GroupName": {name}}
+	for {
+		var result struct {
+			Group iamGroup `xml:"GetGroupResult>Group"`
+			Users []struct {
+				UserName string `xml:"UserName"`
+			} `xml:"GetGroupResult>Users>member"`
+			IsTruncated bool   `xml:"GetGroupResult>IsTruncated"`
+			Marker      string `xml:"GetGroupResult>Marker"`
+		}
+		if err := c.call(ctx, "GetGroup", params, &result); err != nil {
+			return nil, nil, err
+		}
+
+		for _, user := range result.Users {
+			users = append(users, user.UserName)
+		}
+
+		if !result.IsTruncated || result.Marker == "" {
+			return &result.Group, users, nil
+		}
+		params.Set("Marker", result.Marker)
+	}
+}
+
+func (c *iamClient) deleteGroup(ctx context.Context, name string) error {
+	return c.call(ctx, "DeleteGroup", url.Values{"GroupName": {name}}, nil)
+}
+
+func (c *iamClient) addUserToGroup(ctx context.Context, group, username string) error {
+	return c.call(ctx, "AddUserToGroup", url.Values{"GroupName": {group}, "UserName": {username}}, nil)
+}
+
+func (c *iamClient) removeUserFromGroup(ctx context.Context, group, username string) error {
+	return c.call(ctx, "RemoveUserFromGroup", url.Values{"GroupName": {group}, "UserName": {username}}, nil)
+}
+
+func (c *iamClient) attachGroupPolicy(ctx context.Context, group, policyARN string) error {
+	return c.call(ctx, "AttachGroupPolicy", url.Values{"GroupName": {group}, "PolicyArn": {policyARN}}, nil)
+}
+
+func (c *iamClient) detachGroupPolicy(ctx context.Context, group, policyARN string) error {
+	return c.call(ctx, "DetachGroupPolicy", url.Values{"GroupName": {group}, "PolicyArn": {policyARN}}, nil)
+}
+
+func (c *iamClient) listAttachedGroupPolicies(ctx context.Context, group string) ([]iamAttachedPolicy, error) {
+	var policies []iamAttachedPolicy
+	params := url.Values{"GroupName": {group}}
+	for {
+		var result struct {
+			Policies    []iamAttachedPolicy `xml:"ListAttachedGroupPoliciesResult>AttachedPolicies>member"`
+			IsTruncated bool                `xml:"ListAttachedGroupPoliciesResult>IsTruncated"`
+			Marker      string              `xml:"ListAttachedGroupPoliciesResult>Marker"`
+		}
+		if err := c.call(ctx, "ListAttachedGroupPolicies", params, &result); err != nil {
+			return nil, err
+		}
+
+		policies = append(policies, result.Policies...)
+
+		if !result.IsTruncated || result.Marker == "" {
+			return policies, nil
+		}
+		params.Set("Marker", result.Marker)
+	}
+}
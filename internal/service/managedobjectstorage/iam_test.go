@@ -0,0 +1,80 @@
+package managedobjectstorage
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIAMClientGetGroup(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/iam", r.URL.EscapedPath())
+		assert.Contains(t, r.Header.Get("Authorization"), "/europe-1/iam/aws4_request")
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "GetGroup", r.PostForm.Get("Action"))
+		assert.Equal(t, iamAPIVersion, r.PostForm.Get("Version"))
+
+		switch r.PostForm.Get("GroupName") {
+		case "developers":
+			if r.PostForm.Get("Marker") == "" {
+				_, _ = w.Write([]byte(`<GetGroupResponse><GetGroupResult><Group><GroupName>developers</GroupName><GroupId>AGPA1</GroupId><Arn>arn:aws:iam::123:group/developers</Arn></Group><Users><member><UserName>alice</UserName></member></Users><IsTruncated>true</IsTruncated><Marker>next</Marker></GetGroupResult></GetGroupResponse>`))
+				return
+			}
+			assert.Equal(t, "next", r.PostForm.Get("Marker"))
+			_, _ = w.Write([]byte(`<GetGroupResponse><GetGroupResult><Group><GroupName>developers</GroupName><GroupId>AGPA1</GroupId><Arn>arn:aws:iam::123:group/developers</Arn></Group><Users><member><UserName>bob</UserName></member></Users><IsTruncated>false</IsTruncated></GetGroupResult></GetGroupResponse>`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`<ErrorResponse><Error><Type>Sender</Type><Code>NoSuchEntity</Code><Message>The group cannot be found.</Message></Error><RequestId>abc</RequestId></ErrorResponse>`))
+		}
+	}))
+	defer srv.Close()
+
+	c, err := newIAMClient(srv.URL+"/iam/", "europe-1", "AKIA", "secret")
+	require.NoError(t, err)
+
+	group, users, err := c.getGroup(context.Background(), "developers")
+	require.NoError(t, err)
+	assert.Equal(t, &iamGroup{GroupName: "developers", GroupID: "AGPA1", Arn: "arn:aws:iam::123:group/developers"}, group)
+	assert.Equal(t, []string{"alice", "bob"}, users)
+
+	_, _, err = c.getGroup(context.Background(), "testers")
+	assert.True(t, isIAMNotFoundError(err))
+	assert.EqualError(t, err, "NoSuchEntity: The group cannot be found. (HTTP 404, request_id=abc)")
+}
+
+func TestIAMClientListAttachedGroupPolicies(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/", r.URL.EscapedPath())
+		assert.True(t, strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded"))
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "ListAttachedGroupPolicies", r.PostForm.Get("Action"))
+		assert.Equal(t, "developers", r.PostForm.Get("GroupName"))
+
+		_, _ = w.Write([]byte(`<ListAttachedGroupPoliciesResponse><ListAttachedGroupPoliciesResult><AttachedPolicies><member><PolicyName>read</PolicyName><PolicyArn>arn:aws:iam::123:policy/read</PolicyArn></member><member><PolicyName>write</PolicyName><PolicyArn>arn:aws:iam::123:policy/write</PolicyArn></member></AttachedPolicies><IsTruncated>false</IsTruncated></ListAttachedGroupPoliciesResult></ListAttachedGroupPoliciesResponse>`))
+	}))
+	defer srv.Close()
+
+	c, err := newIAMClient(srv.URL, "europe-1", "AKIA", "secret")
+	require.NoError(t, err)
+
+	policies, err := c.listAttachedGroupPolicies(context.Background(), "developers")
+	require.NoError(t, err)
+	assert.Len(t, policies, 2)
+
+	policy, ok := findAttachedPolicy(policies, "write")
+	assert.True(t, ok)
+	assert.Equal(t, "arn:aws:iam::123:policy/write", policy.PolicyArn)
+
+	_, ok = findAttachedPolicy(policies, "admin")
+	assert.False(t, ok)
+}
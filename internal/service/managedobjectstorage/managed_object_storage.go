@@ -10,10 +10,10 @@ import (
 	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
 	v9 "github.com/UpCloudLtd/upcloud-go-api/v9/pkg/upcloud"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -27,6 +27,7 @@ var (
 	_ resource.Resource                = &managedObjectStorageResource{}
 	_ resource.ResourceWithConfigure   = &managedObjectStorageResource{}
 	_ resource.ResourceWithImportState = &managedObjectStorageResource{}
+	_ resource.ResourceWithIdentity    = &managedObjectStorageResource{}
 )
 
 func NewManagedObjectStorageResource() resource.Resource {
@@ -47,16 +48,17 @@ func (r *managedObjectStorageResource) Configure(_ context.Context, req resource
 }
 
 type managedObjectStorageModel struct {
-	ConfiguredStatus types.String `tfsdk:"configured_status"`
-	CreatedAt        types.String `tfsdk:"created_at"`
-	Endpoint         types.Set    `tfsdk:"endpoint"`
-	ID               types.String `tfsdk:"id"`
-	Labels           types.Map    `tfsdk:"labels"`
-	Name             types.String `tfsdk:"name"`
-	Network          types.Set    `tfsdk:"network"`
-	OperationalState types.String `tfsdk:"operational_state"`
-	Region           types.String `tfsdk:"region"`
-	UpdatedAt        types.String `tfsdk:"updated_at"`
+	ConfiguredStatus types.String   `tfsdk:"configured_status"`
+	CreatedAt        types.String   `tfsdk:"created_at"`
+	Endpoint         types.Set      `tfsdk:"endpoint"`
+	ID               types.String   `tfsdk:"id"`
+	Labels           types.Map      `tfsdk:"labels"`
+	Name             types.String   `tfsdk:"name"`
+	Network          types.Set      `tfsdk:"network"`
+	OperationalState types.String   `tfsdk:"operational_state"`
+	Region           types.String   `tfsdk:"region"`
+	UpdatedAt        types.String   `tfsdk:"updated_at"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
 type endpointModel struct {
@@ -73,7 +75,7 @@ type networkModel struct {
 	UUID   types.String `tfsdk:"uuid"`
 }
 
-func (r *managedObjectStorageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *managedObjectStorageResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "This resource represents an UpCloud Managed Object Storage instance, which provides S3 compatible storage.",
 		Attributes: map[string]schema.Attribute{
@@ -145,6 +147,7 @@ func (r *managedObjectStorageResource) Schema(_ context.Context, _ resource.Sche
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
 			"network": schema.SetNestedBlock{
 				MarkdownDescription: "Attached networks from where object storage can be used. Private networks must reside in object storage region. To gain access from multiple private networks that might reside in different zones, create the networks and a corresponding router for each network.",
 				NestedObject: schema.NestedBlockObject{
@@ -388,7 +391,7 @@ func (r *managedObjectStorageResource) waitForManagedObjectStorageState(ctx cont
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error while waiting for managed object storage to be in %s state", desiredState),
-			utils.ErrorDiagnosticDetail(err),
+			utils.WaitErrorDiagnosticDetail(ctx, err),
 		)
 	}
 
@@ -403,6 +406,13 @@ func (r *managedObjectStorageResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel, diags := utils.TimeoutContext(ctx, data.Timeouts, utils.TimeoutCreate)
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var labels map[string]string
 	if !data.Labels.IsNull() && !data.Labels.IsUnknown() {
 		resp.Diagnostics.Append(data.Labels.ElementsAs(ctx, &labels, false)...)
@@ -461,6 +471,7 @@ func (r *managedObjectStorageResource) Create(ctx context.Context, req resource.
 
 	resp.Diagnostics.Append(setManagedObjectStorageValues(ctx, &data, objsto)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, managedObjectStorageIdentity, data.ID.ValueString())...)
 }
 
 func (r *managedObjectStorageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -509,6 +520,7 @@ func (r *managedObjectStorageResource) Read(ctx context.Context, req resource.Re
 
 	resp.Diagnostics.Append(setManagedObjectStorageValues(ctx, &data, objstoResp.JSON200)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, managedObjectStorageIdentity, data.ID.ValueString())...)
 }
 
 func (r *managedObjectStorageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -522,6 +534,13 @@ func (r *managedObjectStorageResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel, diags := utils.TimeoutContext(ctx, data.Timeouts, utils.TimeoutUpdate)
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var labels map[string]string
 	if !data.Labels.IsNull() && !data.Labels.IsUnknown() {
 		resp.Diagnostics.Append(data.Labels.ElementsAs(ctx, &labels, false)...)
@@ -624,6 +643,13 @@ func (r *managedObjectStorageResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel, diags := utils.TimeoutContext(ctx, data.Timeouts, utils.TimeoutDelete)
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	serviceUUID, err := uuid.Parse(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -654,11 +680,17 @@ func (r *managedObjectStorageResource) Delete(ctx context.Context, req resource.
 	if err := r.client.WaitForObjectStorageDeletion(ctx, data.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError(
 			"Error while waiting for managed object storage to be deleted",
-			utils.ErrorDiagnosticDetail(err),
+			utils.WaitErrorDiagnosticDetail(ctx, err),
 		)
 	}
 }
 
+var managedObjectStorageIdentity = utils.UUIDIdentity("managed object storage service")
+
+func (r *managedObjectStorageResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(managedObjectStorageIdentity)
+}
+
 func (r *managedObjectStorageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, managedObjectStorageIdentity, req, resp)
 }
@@ -0,0 +1,647 @@
+package managedobjectstorage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	v9 "github.com/UpCloudLtd/upcloud-go-api/v9/pkg/upcloud"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &managedObjectStorageObjectResource{}
+	_ resource.ResourceWithConfigure   = &managedObjectStorageObjectResource{}
+	_ resource.ResourceWithModifyPlan  = &managedObjectStorageObjectResource{}
+	_ resource.ResourceWithImportState = &managedObjectStorageObjectResource{}
+	_ resource.ResourceWithIdentity    = &managedObjectStorageObjectResource{}
+)
+
+const (
+	s3MetadataHeaderPrefix = "X-Amz-Meta-"
+	defaultObjectMediaType = "application/octet-stream"
+)
+
+// objectMetadataKeyRegexp matches the allowed metadata keys. The keys must be lowercase, because the metadata header
+// names are case-insensitive and the keys are read back in lowercase.
+var objectMetadataKeyRegexp = regexp.MustCompile("^[a-z0-9-_.]+$")
+
+// objectMediaTypes contains media types for common file extensions. The media types are not read from the system to
+// keep the planned content types same on all platforms.
+var objectMediaTypes = map[string]string{
+	".avif":  "image/avif",
+	".css":   "text/css; charset=utf-8",
+	".csv":   "text/csv; charset=utf-8",
+	".gif":   "image/gif",
+	".gz":    "application/gzip",
+	".htm":   "text/html; charset=utf-8",
+	".html":  "text/html; charset=utf-8",
+	".ico":   "image/vnd.microsoft.icon",
+	".jpeg":  "image/jpeg",
+	".jpg":   "image/jpeg",
+	".js":    "text/javascript; charset=utf-8",
+	".json":  "application/json",
+	".map":   "application/json",
+	".md":    "text/markdown; charset=utf-8",
+	".mjs":   "text/javascript; charset=utf-8",
+	".mp4":   "video/mp4",
+	".otf":   "font/otf",
+	".pdf":   "application/pdf",
+	".png":   "image/png",
+	".svg":   "image/svg+xml",
+	".toml":  "application/toml",
+	".ttf":   "font/ttf",
+	".txt":   "text/plain; charset=utf-8",
+	".wasm":  "application/wasm",
+	".webm":  "video/webm",
+	".webp":  "image/webp",
+	".woff":  "font/woff",
+	".woff2": "font/woff2",
+	".xml":   "application/xml",
+	".yaml":  "application/yaml",
+	".yml":   "application/yaml",
+	".zip":   "application/zip",
+}
+
+// objectMediaType returns the media type for the object based on the extension of the object key.
+func objectMediaType(key string) string {
+	if mediaType, ok := objectMediaTypes[strings.ToLower(filepath.Ext(key))]; ok {
+		return mediaType
+	}
+	return defaultObjectMediaType
+}
+
+func NewObjectResource() resource.Resource {
+	return &managedObjectStorageObjectResource{}
+}
+
+type managedObjectStorageObjectResource struct {
+	client *v9.ClientWithResponses
+}
+
+func (r *managedObjectStorageObjectResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_managed_object_storage_object"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *managedObjectStorageObjectResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = utils.GetV9ClientFromProviderData(req.ProviderData)
+}
+
+type objectModel struct {
+	ID                types.String `tfsdk:"id"`
+	ServiceUUID       types.String `tfsdk:"service_uuid"`
+	Bucket            types.String `tfsdk:"bucket"`
+	Key               types.String `tfsdk:"key"`
+	AccessKeyUsername types.String `tfsdk:"access_key_username"`
+	Source            types.String `tfsdk:"source"`
+	Content           types.String `tfsdk:"content"`
+	ContentType       types.String `tfsdk:"content_type"`
+	CacheControl      types.String `tfsdk:"cache_control"`
+	Metadata          types.Map    `tfsdk:"metadata"`
+	ContentSHA256     types.String `tfsdk:"content_sha256"`
+	ETag              types.String `tfsdk:"etag"`
+}
+
+func (r *managedObjectStorageObjectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `This resource represents an object in an UpCloud Managed Object Storage bucket. The object is uploaded from a local file or inline content through the S3-compatible API of the service with the permissions of the user defined with ` + "`access_key_username`" + `. The user must have permissions to read, write, and delete objects in the bucket.
+
+After import, the object is read once ` + "`access_key_username`" + ` has been stored in the state on the next apply. The object is not uploaded again if the local content matches the content of the imported object.
+
+Changes to the content are detected by comparing the SHA256 hash of the local content to the hash of the uploaded content. If the object is modified outside of Terraform, i.e., its ETag changes, the object is uploaded again.
+
+~> This resource is intended for small files, such as configuration artifacts and static site content. The content is read into memory and stored in the state when defined with ` + "`content`" + `.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the object. ID is in {object storage UUID}/{bucket name}/{key} format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_uuid": schema.StringAttribute{
+				Description: "Managed Object Storage service UUID.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"bucket": schema.StringAttribute{
+				Description: "Name of the bucket.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Description: "Key of the object.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1024),
+				},
+			},
+			"access_key_username": accessKeyUsernameAttribute("upload, read, and delete the object through the S3-compatible API of the service", true),
+			"source": schema.StringAttribute{
+				MarkdownDescription: "Path to a local file to upload. Conflicts with `content`. The file can be created in the same apply, e.g. with the `local_file` resource, in which case `content_sha256` is known after apply.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("content")),
+				},
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "Content to upload as UTF-8 encoded string. Conflicts with `source`.",
+				Optional:            true,
+			},
+			"content_type": schema.StringAttribute{
+				MarkdownDescription: "Media type of the object. By default, the media type is determined from the extension of the object key and defaults to `" + defaultObjectMediaType + "` for unknown extensions.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"cache_control": schema.StringAttribute{
+				MarkdownDescription: "Value of the `Cache-Control` header returned with the object, e.g. `max-age=3600`.",
+				Optional:            true,
+			},
+			"metadata": schema.MapAttribute{
+				MarkdownDescription: "User-defined metadata of the object. Keys must be lowercase.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.KeysAre(
+						stringvalidator.RegexMatches(objectMetadataKeyRegexp, "must contain only lowercase letters, numbers, hyphens, underscores, and periods"),
+					),
+				},
+			},
+			"content_sha256": schema.StringAttribute{
+				Description: "SHA256 hash of the uploaded content.",
+				Computed:    true,
+			},
+			"etag": schema.StringAttribute{
+				Description: "ETag of the object.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// readObjectContent reads the content to upload from the source file or the content attribute. Ok is false if the
+// content is not known yet.
+func readObjectContent(data *objectModel) (content []byte, ok bool, diags diag.Diagnostics) {
+	if data.Source.IsUnknown() || data.Content.IsUnknown() {
+		return nil, false, diags
+	}
+
+	if data.Source.IsNull() {
+		return []byte(data.Content.ValueString()), true, diags
+	}
+
+	content, err := os.ReadFile(data.Source.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("source"),
+			"Unable to read object source file",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return nil, false, diags
+	}
+	return content, true, diags
+}
+
+// objectSourcePending reports whether the source file does not exist yet. The file may be created during the same apply,
+// e.g. with the local_file resource, so the content hash is not known until then.
+func objectSourcePending(data *objectModel) bool {
+	if data.Source.IsNull() || data.Source.IsUnknown() {
+		return false
+	}
+
+	_, err := os.Stat(data.Source.ValueString())
+	return os.IsNotExist(err)
+}
+
+// objectContentChanged checks whether the object needs to be uploaded again to apply the changes between state and plan.
+func objectContentChanged(plan, state *objectModel) bool {
+	return !plan.ContentSHA256.Equal(state.ContentSHA256) ||
+		!plan.ContentType.Equal(state.ContentType) ||
+		!plan.CacheControl.Equal(state.CacheControl) ||
+		!plan.Metadata.Equal(state.Metadata)
+}
+
+func (r *managedObjectStorageObjectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan objectModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var configContentType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("content_type"), &configContentType)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.ServiceUUID.IsUnknown() && !plan.Bucket.IsUnknown() && !plan.Key.IsUnknown() {
+		plan.ID = types.StringValue(utils.MarshalID(plan.ServiceUUID.ValueString(), plan.Bucket.ValueString(), plan.Key.ValueString()))
+	}
+
+	if configContentType.IsNull() && !plan.Key.IsUnknown() {
+		plan.ContentType = types.StringValue(objectMediaType(plan.Key.ValueString()))
+	}
+
+	plan.ContentSHA256 = types.StringUnknown()
+	if !objectSourcePending(&plan) {
+		content, ok, diags := readObjectContent(&plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if ok {
+			plan.ContentSHA256 = types.StringValue(sha256Hex(content))
+		}
+	}
+
+	plan.ETag = types.StringUnknown()
+	if !req.State.Raw.IsNull() {
+		var state objectModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !objectContentChanged(&plan, &state) {
+			plan.ETag = state.ETag
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// objectHeader returns the headers for uploading an object. Header names are canonicalized, so the metadata keys are
+// read back in lowercase by objectMetadata.
+func objectHeader(contentType, cacheControl string, metadata map[string]string) http.Header {
+	header := http.Header{}
+	header.Set("Content-Type", contentType)
+	if cacheControl != "" {
+		header.Set("Cache-Control", cacheControl)
+	}
+	for k, v := range metadata {
+		header.Set(s3MetadataHeaderPrefix+k, v)
+	}
+	return header
+}
+
+func (c *s3Client) putObject(ctx context.Context, bucket, key string, content []byte, contentType, cacheControl string, metadata map[string]string) error {
+	header := objectHeader(contentType, cacheControl, metadata)
+
+	if content == nil {
+		content = []byte{}
+	}
+
+	_, _, err := c.do(ctx, http.MethodPut, bucket, key, nil, header, content)
+	return err
+}
+
+func (c *s3Client) getObject(ctx context.Context, bucket, key string) ([]byte, error) {
+	body, _, err := c.do(ctx, http.MethodGet, bucket, key, nil, nil, nil)
+	return body, err
+}
+
+func (c *s3Client) headObject(ctx context.Context, bucket, key string) (http.Header, error) {
+	_, header, err := c.do(ctx, http.MethodHead, bucket, key, nil, nil, nil)
+	return header, err
+}
+
+func (c *s3Client) deleteObject(ctx context.Context, bucket, key string) error {
+	_, _, err := c.do(ctx, http.MethodDelete, bucket, key, nil, nil, nil)
+	return err
+}
+
+func isS3NotFoundError(err error) bool {
+	return isS3ErrorCode(err, "NotFound", "NoSuchKey", "NoSuchBucket")
+}
+
+// objectMetadata parses user-defined metadata from the response headers.
+func objectMetadata(header http.Header) map[string]string {
+	metadata := make(map[string]string)
+	for k, v := range header {
+		if len(v) == 0 || !strings.HasPrefix(http.CanonicalHeaderKey(k), s3MetadataHeaderPrefix) {
+			continue
+		}
+		metadata[strings.ToLower(k[len(s3MetadataHeaderPrefix):])] = v[0]
+	}
+	return metadata
+}
+
+func objectETag(header http.Header) string {
+	return strings.Trim(header.Get("ETag"), `"`)
+}
+
+// setObjectValues sets the values read from the object headers. If the ETag has changed, the object has been modified
+// outside of Terraform and the content hash is cleared to upload the object again.
+func setObjectValues(ctx context.Context, data *objectModel, header http.Header) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	etag := objectETag(header)
+	if data.ETag.ValueString() != etag {
+		data.ContentSHA256 = types.StringNull()
+	}
+	data.ETag = types.StringValue(etag)
+
+	data.ContentType = types.StringValue(header.Get("Content-Type"))
+	data.CacheControl = stringValueOrNull(header.Get("Cache-Control"))
+
+	metadata := objectMetadata(header)
+	if len(metadata) > 0 {
+		data.Metadata, diags = types.MapValueFrom(ctx, types.StringType, metadata)
+	} else {
+		data.Metadata = types.MapNull(types.StringType)
+	}
+
+	return diags
+}
+
+func (r *managedObjectStorageObjectResource) upload(ctx context.Context, s3 *s3Client, data *objectModel) diag.Diagnostics {
+	content, _, diags := readObjectContent(data)
+	if diags.HasError() {
+		return diags
+	}
+
+	metadata := make(map[string]string)
+	diags.Append(data.Metadata.ElementsAs(ctx, &metadata, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	bucket, key := data.Bucket.ValueString(), data.Key.ValueString()
+	if err := s3.putObject(ctx, bucket, key, content, data.ContentType.ValueString(), data.CacheControl.ValueString(), metadata); err != nil {
+		diags.AddError(
+			"Unable to upload managed object storage object",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return diags
+	}
+
+	header, err := s3.headObject(ctx, bucket, key)
+	if err != nil {
+		diags.AddError(
+			"Unable to read managed object storage object",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return diags
+	}
+
+	data.ContentSHA256 = types.StringValue(sha256Hex(content))
+	data.ETag = types.StringValue(objectETag(header))
+	return diags
+}
+
+func (r *managedObjectStorageObjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data objectModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(utils.MarshalID(data.ServiceUUID.ValueString(), data.Bucket.ValueString(), data.Key.ValueString()))
+
+	s3, release, diags := getS3Client(ctx, r.client, data.ServiceUUID.ValueString(), data.AccessKeyUsername.ValueString())
+	defer release()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.upload(ctx, s3, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setObjectIdentity(ctx, resp.Identity, data.ID.ValueString())...)
+}
+
+func (r *managedObjectStorageObjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data objectModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.ValueString() == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	serviceUUID, bucket, key, diags := unmarshalObjectID(data.ID.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ServiceUUID = types.StringValue(serviceUUID)
+	data.Bucket = types.StringValue(bucket)
+	data.Key = types.StringValue(key)
+
+	// The username is not known after import. The object is read when the username has been set on the next apply.
+	if data.AccessKeyUsername.IsNull() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.Append(setObjectIdentity(ctx, resp.Identity, data.ID.ValueString())...)
+		return
+	}
+
+	s3, release, diags := getS3Client(ctx, r.client, serviceUUID, data.AccessKeyUsername.ValueString())
+	defer release()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := readObject(ctx, s3, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setObjectIdentity(ctx, resp.Identity, data.ID.ValueString())...)
+}
+
+// readObject reads the object values to data. Found is false if the object does not exist.
+func readObject(ctx context.Context, s3 *s3Client, data *objectModel) (found bool, diags diag.Diagnostics) {
+	bucket, key := data.Bucket.ValueString(), data.Key.ValueString()
+
+	header, err := s3.headObject(ctx, bucket, key)
+	if err != nil {
+		if isS3NotFoundError(err) {
+			return false, diags
+		}
+
+		diags.AddError(
+			"Unable to read managed object storage object",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return false, diags
+	}
+
+	// ETag is not known after import, so the content hash is calculated from the uploaded content to avoid uploading
+	// the object again when the local content matches.
+	if data.ETag.IsNull() {
+		content, err := s3.getObject(ctx, bucket, key)
+		if err != nil {
+			diags.AddError(
+				"Unable to read managed object storage object content",
+				utils.ErrorDiagnosticDetail(err),
+			)
+			return false, diags
+		}
+
+		data.ContentSHA256 = types.StringValue(sha256Hex(content))
+		data.ETag = types.StringValue(objectETag(header))
+	}
+
+	diags.Append(setObjectValues(ctx, data, header)...)
+	return true, diags
+}
+
+func (r *managedObjectStorageObjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state objectModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	s3, release, diags := getS3Client(ctx, r.client, data.ServiceUUID.ValueString(), data.AccessKeyUsername.ValueString())
+	defer release()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The object has not been read yet if it was imported before the username was set.
+	if state.ETag.IsNull() {
+		found, diags := readObject(ctx, s3, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !found {
+			state.ContentSHA256 = types.StringNull()
+		}
+	}
+
+	// Changes to the content source do not require uploading the object again.
+	if objectContentChanged(&data, &state) {
+		resp.Diagnostics.Append(r.upload(ctx, s3, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		data.ETag = state.ETag
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *managedObjectStorageObjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data objectModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceUUID, bucket, key, diags := unmarshalObjectID(data.ID.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	s3, release, diags := getS3Client(ctx, r.client, serviceUUID, data.AccessKeyUsername.ValueString())
+	defer release()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := s3.deleteObject(ctx, bucket, key); err != nil && !isS3NotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Unable to delete managed object storage object",
+			utils.ErrorDiagnosticDetail(err),
+		)
+	}
+}
+
+var objectIdentity = []utils.IdentityAttribute{
+	{Name: "service_uuid", Description: "UUID of the managed object storage service."},
+	{Name: "bucket", Description: "Name of the bucket."},
+	{Name: "key", Description: "Key of the object."},
+}
+
+// unmarshalObjectID parses the object ID. The key is the remainder of the ID, as object keys may contain slashes.
+func unmarshalObjectID(id string) (serviceUUID, bucket, key string, diags diag.Diagnostics) {
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		diags.AddError(
+			"Unable to unmarshal ID",
+			fmt.Sprintf("object ID '%s' is not in {object storage UUID}/{bucket name}/{key} format", id),
+		)
+		return
+	}
+	return parts[0], parts[1], parts[2], diags
+}
+
+// setObjectIdentity sets the identity attributes from the object ID. utils.SetIdentity can not be used, because the key
+// may contain slashes.
+func setObjectIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, id string) (diags diag.Diagnostics) {
+	if identity == nil || id == "" {
+		return
+	}
+
+	serviceUUID, bucket, key, diags := unmarshalObjectID(id)
+	if diags.HasError() {
+		return
+	}
+
+	for i, value := range []string{serviceUUID, bucket, key} {
+		diags.Append(identity.SetAttribute(ctx, path.Root(objectIdentity[i].Name), value)...)
+	}
+	return
+}
+
+func (r *managedObjectStorageObjectResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(objectIdentity)
+}
+
+func (r *managedObjectStorageObjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	utils.ImportStateWithIdentity(ctx, objectIdentity, req, resp)
+}
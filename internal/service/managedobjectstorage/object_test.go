@@ -0,0 +1,116 @@
+package managedobjectstorage
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObjectMediaType(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "text/html; charset=utf-8", objectMediaType("site/index.HTML"))
+	assert.Equal(t, "image/svg+xml", objectMediaType("logo.svg"))
+	assert.Equal(t, defaultObjectMediaType, objectMediaType("config"))
+	assert.Equal(t, defaultObjectMediaType, objectMediaType("archive.unknown"))
+}
+
+func TestSetObjectValues(t *testing.T) {
+	t.Parallel()
+
+	header := http.Header{}
+	header.Set("ETag", `"d41d8cd98f00b204e9800998ecf8427e"`)
+	header.Set("Content-Type", "application/json")
+	header.Set("X-Amz-Meta-Owner", "team-devex")
+
+	data := objectModel{
+		ETag:          types.StringValue("d41d8cd98f00b204e9800998ecf8427e"),
+		ContentSHA256: types.StringValue("e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"),
+	}
+	diags := setObjectValues(context.Background(), &data, header)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "application/json", data.ContentType.ValueString())
+	assert.True(t, data.CacheControl.IsNull())
+	assert.Equal(t, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", data.ContentSHA256.ValueString())
+	assert.Equal(t, types.MapValueMust(types.StringType, map[string]attr.Value{"owner": types.StringValue("team-devex")}), data.Metadata)
+
+	// Object has been modified outside of Terraform.
+	header.Set("ETag", `"0cc175b9c0f1b6a831c399e269772661"`)
+	header.Del("X-Amz-Meta-Owner")
+	diags = setObjectValues(context.Background(), &data, header)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "0cc175b9c0f1b6a831c399e269772661", data.ETag.ValueString())
+	assert.True(t, data.ContentSHA256.IsNull())
+	assert.True(t, data.Metadata.IsNull())
+}
+
+func TestObjectMetadata(t *testing.T) {
+	t.Parallel()
+
+	metadata := map[string]string{"owner": "team-devex", "build_id": "42", "app.version": "1.2.3"}
+	assert.Equal(t, metadata, objectMetadata(objectHeader("application/json", "", metadata)))
+
+	// Keys with uppercase letters would be read back in lowercase and cause a diff on every plan.
+	for key := range metadata {
+		assert.True(t, objectMetadataKeyRegexp.MatchString(key))
+	}
+	assert.False(t, objectMetadataKeyRegexp.MatchString("Owner"))
+}
+
+func TestObjectSourcePending(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	source := filepath.Join(dir, "app.json")
+
+	data := objectModel{Source: types.StringValue(source)}
+	assert.True(t, objectSourcePending(&data))
+
+	assert.NoError(t, os.WriteFile(source, []byte("{}"), 0o600))
+	assert.False(t, objectSourcePending(&data))
+
+	data = objectModel{Source: types.StringNull(), Content: types.StringValue("{}")}
+	assert.False(t, objectSourcePending(&data))
+}
+
+func TestObjectContentChanged(t *testing.T) {
+	t.Parallel()
+
+	state := objectModel{
+		Source:        types.StringValue("a/index.html"),
+		ContentType:   types.StringValue("text/html; charset=utf-8"),
+		CacheControl:  types.StringNull(),
+		Metadata:      types.MapNull(types.StringType),
+		ContentSHA256: types.StringValue("abc"),
+	}
+
+	plan := state
+	plan.Source = types.StringValue("b/index.html")
+	assert.False(t, objectContentChanged(&plan, &state))
+
+	plan.CacheControl = types.StringValue("max-age=60")
+	assert.True(t, objectContentChanged(&plan, &state))
+
+	plan = state
+	plan.ContentSHA256 = types.StringUnknown()
+	assert.True(t, objectContentChanged(&plan, &state))
+}
+
+func TestUnmarshalObjectID(t *testing.T) {
+	t.Parallel()
+
+	serviceUUID, bucket, key, diags := unmarshalObjectID("1201cd6f-20cd-44b6-939d-ae1c861769e7/artifacts/config/app.json")
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "1201cd6f-20cd-44b6-939d-ae1c861769e7", serviceUUID)
+	assert.Equal(t, "artifacts", bucket)
+	assert.Equal(t, "config/app.json", key)
+
+	_, _, _, diags = unmarshalObjectID("1201cd6f-20cd-44b6-939d-ae1c861769e7/artifacts")
+	assert.True(t, diags.HasError())
+}
@@ -11,7 +11,6 @@ import (
 	v9 "github.com/UpCloudLtd/upcloud-go-api/v9/pkg/upcloud"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -25,6 +24,7 @@ var (
 	_ resource.Resource                = &managedObjectStoragePolicyResource{}
 	_ resource.ResourceWithConfigure   = &managedObjectStoragePolicyResource{}
 	_ resource.ResourceWithImportState = &managedObjectStoragePolicyResource{}
+	_ resource.ResourceWithIdentity    = &managedObjectStoragePolicyResource{}
 )
 
 func NewPolicyResource() resource.Resource {
@@ -221,6 +221,7 @@ func (r *managedObjectStoragePolicyResource) Create(ctx context.Context, req res
 
 	resp.Diagnostics.Append(setPolicyValues(ctx, &data, apiResp.JSON201)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, policyIdentity, data.ID.ValueString())...)
 }
 
 func (r *managedObjectStoragePolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -276,6 +277,7 @@ func (r *managedObjectStoragePolicyResource) Read(ctx context.Context, req resou
 
 	resp.Diagnostics.Append(setPolicyValues(ctx, &data, apiResp.JSON200)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, policyIdentity, data.ID.ValueString())...)
 }
 
 func (r *managedObjectStoragePolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -381,8 +383,17 @@ func (r *managedObjectStoragePolicyResource) Delete(ctx context.Context, req res
 	}
 }
 
+var policyIdentity = []utils.IdentityAttribute{
+	{Name: "service_uuid", Description: "UUID of the managed object storage service."},
+	{Name: "name", Description: "Name of the policy."},
+}
+
+func (r *managedObjectStoragePolicyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(policyIdentity)
+}
+
 func (r *managedObjectStoragePolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, policyIdentity, req, resp)
 }
 
 func normalizePolicyDocument(document string) (string, diag.Diagnostics) {
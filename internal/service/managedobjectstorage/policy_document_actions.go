@@ -0,0 +1,184 @@
+package managedobjectstorage
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// policyDocumentActions contains the actions supported in Managed Object Storage policies, grouped by the service prefix.
+var policyDocumentActions = map[string][]string{
+	"s3": {
+		"AbortMultipartUpload",
+		"BypassGovernanceRetention",
+		"CreateBucket",
+		"DeleteBucket",
+		"DeleteBucketPolicy",
+		"DeleteBucketWebsite",
+		"DeleteObject",
+		"DeleteObjectTagging",
+		"DeleteObjectVersion",
+		"DeleteObjectVersionTagging",
+		"GetBucketAcl",
+		"GetBucketCORS",
+		"GetBucketLocation",
+		"GetBucketLogging",
+		"GetBucketNotification",
+		"GetBucketObjectLockConfiguration",
+		"GetBucketPolicy",
+		"GetBucketPolicyStatus",
+		"GetBucketTagging",
+		"GetBucketVersioning",
+		"GetBucketWebsite",
+		"GetEncryptionConfiguration",
+		"GetLifecycleConfiguration",
+		"GetObject",
+		"GetObjectAcl",
+		"GetObjectAttributes",
+		"GetObjectLegalHold",
+		"GetObjectRetention",
+		"GetObjectTagging",
+		"GetObjectVersion",
+		"GetObjectVersionAcl",
+		"GetObjectVersionAttributes",
+		"GetObjectVersionTagging",
+		"GetReplicationConfiguration",
+		"ListAllMyBuckets",
+		"ListBucket",
+		"ListBucketMultipartUploads",
+		"ListBucketVersions",
+		"ListMultipartUploadParts",
+		"PutBucketAcl",
+		"PutBucketCORS",
+		"PutBucketLogging",
+		"PutBucketNotification",
+		"PutBucketObjectLockConfiguration",
+		"PutBucketPolicy",
+		"PutBucketTagging",
+		"PutBucketVersioning",
+		"PutBucketWebsite",
+		"PutEncryptionConfiguration",
+		"PutLifecycleConfiguration",
+		"PutObject",
+		"PutObjectAcl",
+		"PutObjectLegalHold",
+		"PutObjectRetention",
+		"PutObjectTagging",
+		"PutObjectVersionAcl",
+		"PutObjectVersionTagging",
+		"PutReplicationConfiguration",
+		"ReplicateDelete",
+		"ReplicateObject",
+		"RestoreObject",
+	},
+	"iam": {
+		"AddUserToGroup",
+		"AttachGroupPolicy",
+		"AttachUserPolicy",
+		"CreateAccessKey",
+		"CreateGroup",
+		"CreatePolicy",
+		"CreatePolicyVersion",
+		"CreateUser",
+		"DeleteAccessKey",
+		"DeleteGroup",
+		"DeletePolicy",
+		"DeletePolicyVersion",
+		"DeleteUser",
+		"DetachGroupPolicy",
+		"DetachUserPolicy",
+		"GetAccessKeyLastUsed",
+		"GetGroup",
+		"GetPolicy",
+		"GetPolicyVersion",
+		"GetUser",
+		"ListAccessKeys",
+		"ListAttachedGroupPolicies",
+		"ListAttachedUserPolicies",
+		"ListGroups",
+		"ListGroupsForUser",
+		"ListPolicies",
+		"ListPolicyVersions",
+		"ListUserTags",
+		"ListUsers",
+		"RemoveUserFromGroup",
+		"SetDefaultPolicyVersion",
+		"TagUser",
+		"UntagUser",
+		"UpdateAccessKey",
+		"UpdateUser",
+	},
+	"sts": {
+		"AssumeRole",
+		"GetCallerIdentity",
+		"GetSessionToken",
+	},
+}
+
+// isSupportedPolicyAction checks whether the action matches at least one supported action. Action names are case
+// insensitive and may contain `*` and `?` wildcards.
+func isSupportedPolicyAction(action string) bool {
+	if action == "*" {
+		return true
+	}
+
+	service, name, ok := strings.Cut(strings.ToLower(action), ":")
+	if !ok || name == "" {
+		return false
+	}
+
+	for prefix, actions := range policyDocumentActions {
+		if matched, _ := path.Match(service, prefix); !matched {
+			continue
+		}
+
+		for _, supported := range actions {
+			if matched, _ := path.Match(name, strings.ToLower(supported)); matched {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func supportedPolicyActionPrefixes() []string {
+	prefixes := make([]string, 0, len(policyDocumentActions))
+	for prefix := range policyDocumentActions {
+		prefixes = append(prefixes, prefix+":")
+	}
+	sort.Strings(prefixes)
+	return prefixes
+}
+
+type policyActionValidator struct{}
+
+var _ validator.String = policyActionValidator{}
+
+func (v policyActionValidator) Description(_ context.Context) string {
+	return "value must be an action supported by the Managed Object Storage service"
+}
+
+func (v policyActionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v policyActionValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	action := req.ConfigValue.ValueString()
+	if isSupportedPolicyAction(action) {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Unsupported policy action",
+		fmt.Sprintf("Action %q is not supported by the Managed Object Storage service. Actions must be in `<service>:<action>` format, where service is one of %s, and may contain `*` and `?` wildcards.", action, strings.Join(supportedPolicyActionPrefixes(), ", ")),
+	)
+}
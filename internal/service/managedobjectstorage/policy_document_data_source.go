@@ -0,0 +1,223 @@
+package managedobjectstorage
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"regexp"
+	"sort"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	defaultPolicyDocumentVersion = "2012-10-17"
+	policyEffectAllow            = "Allow"
+	policyEffectDeny             = "Deny"
+)
+
+func NewPolicyDocumentDataSource() datasource.DataSource {
+	return &managedObjectStoragePolicyDocumentDataSource{}
+}
+
+var _ datasource.DataSource = &managedObjectStoragePolicyDocumentDataSource{}
+
+type managedObjectStoragePolicyDocumentDataSource struct{}
+
+func (d *managedObjectStoragePolicyDocumentDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_managed_object_storage_policy_document"
+}
+
+type policyDocumentModel struct {
+	Version    types.String                   `tfsdk:"version"`
+	Statements []policyDocumentStatementModel `tfsdk:"statement"`
+	JSON       types.String                   `tfsdk:"json"`
+	Document   types.String                   `tfsdk:"document"`
+}
+
+type policyDocumentStatementModel struct {
+	Sid        types.String                   `tfsdk:"sid"`
+	Effect     types.String                   `tfsdk:"effect"`
+	Actions    []string                       `tfsdk:"actions"`
+	Resources  []string                       `tfsdk:"resources"`
+	Conditions []policyDocumentConditionModel `tfsdk:"condition"`
+}
+
+type policyDocumentConditionModel struct {
+	Test     types.String `tfsdk:"test"`
+	Variable types.String `tfsdk:"variable"`
+	Values   []string     `tfsdk:"values"`
+}
+
+func (d *managedObjectStoragePolicyDocumentDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates a policy document for `upcloud_managed_object_storage_policy` resource. The actions are validated against the actions supported by the Managed Object Storage service and the generated document is in the same normalized format as the document returned by the API.",
+		Attributes: map[string]schema.Attribute{
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Version of the policy language. Defaults to `" + defaultPolicyDocumentVersion + "`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(defaultPolicyDocumentVersion),
+				},
+			},
+			"json": schema.StringAttribute{
+				Description: "Policy document as JSON.",
+				Computed:    true,
+			},
+			"document": schema.StringAttribute{
+				MarkdownDescription: "Policy document as URL-encoded JSON. Can be used as the `document` of `upcloud_managed_object_storage_policy` resource.",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"statement": schema.ListNestedBlock{
+				Description: "Statements of the policy.",
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"sid": schema.StringAttribute{
+							Description: "Identifier of the statement.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexp.MustCompile("^[a-zA-Z0-9]+$"), "must contain only alphanumeric characters"),
+							},
+						},
+						"effect": schema.StringAttribute{
+							MarkdownDescription: "Whether the statement allows or denies the actions: `" + policyEffectAllow + "` or `" + policyEffectDeny + "`. Defaults to `" + policyEffectAllow + "`.",
+							Optional:            true,
+							Computed:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(policyEffectAllow, policyEffectDeny),
+							},
+						},
+						"actions": schema.SetAttribute{
+							MarkdownDescription: "Actions the statement applies to, e.g. `s3:GetObject` or `s3:Get*`.",
+							ElementType:         types.StringType,
+							Required:            true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueStringsAre(policyActionValidator{}),
+							},
+						},
+						"resources": schema.SetAttribute{
+							MarkdownDescription: "Resources the statement applies to, e.g. `arn:aws:s3:::bucket/*` or `*`.",
+							ElementType:         types.StringType,
+							Required:            true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"condition": schema.ListNestedBlock{
+							Description: "Conditions for when the statement applies.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"test": schema.StringAttribute{
+										MarkdownDescription: "Condition operator, e.g. `StringEquals`, `StringLike`, or `IpAddress`.",
+										Required:            true,
+									},
+									"variable": schema.StringAttribute{
+										MarkdownDescription: "Condition key to evaluate, e.g. `aws:SourceIp` or `s3:prefix`.",
+										Required:            true,
+									},
+									"values": schema.ListAttribute{
+										Description: "Values to compare the condition key against. The condition matches if any of the values matches.",
+										ElementType: types.StringType,
+										Required:    true,
+										Validators: []validator.List{
+											listvalidator.SizeAtLeast(1),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// buildPolicyDocument builds the policy document from the data and sets the default values. The document is marshaled
+// from maps to sort the keys the same way as in normalizePolicyDocument.
+func buildPolicyDocument(data *policyDocumentModel) (string, error) {
+	if data.Version.IsNull() || data.Version.IsUnknown() {
+		data.Version = types.StringValue(defaultPolicyDocumentVersion)
+	}
+
+	statements := make([]map[string]interface{}, 0, len(data.Statements))
+	for i := range data.Statements {
+		s := &data.Statements[i]
+		if s.Effect.IsNull() || s.Effect.IsUnknown() {
+			s.Effect = types.StringValue(policyEffectAllow)
+		}
+
+		// Actions and resources are sets, so sort them to keep the document stable.
+		sort.Strings(s.Actions)
+		sort.Strings(s.Resources)
+
+		statement := map[string]interface{}{
+			"Action":   s.Actions,
+			"Effect":   s.Effect.ValueString(),
+			"Resource": s.Resources,
+		}
+		if !s.Sid.IsNull() {
+			statement["Sid"] = s.Sid.ValueString()
+		}
+
+		if len(s.Conditions) > 0 {
+			conditions := make(map[string]map[string][]string)
+			for _, c := range s.Conditions {
+				test := c.Test.ValueString()
+				if conditions[test] == nil {
+					conditions[test] = make(map[string][]string)
+				}
+				conditions[test][c.Variable.ValueString()] = append(conditions[test][c.Variable.ValueString()], c.Values...)
+			}
+			statement["Condition"] = conditions
+		}
+
+		statements = append(statements, statement)
+	}
+
+	document, err := json.Marshal(map[string]interface{}{
+		"Version":   data.Version.ValueString(),
+		"Statement": statements,
+	})
+	return string(document), err
+}
+
+func (d *managedObjectStoragePolicyDocumentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data policyDocumentModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	document, err := buildPolicyDocument(&data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to build object storage policy document",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	data.JSON = types.StringValue(document)
+	data.Document = types.StringValue(url.QueryEscape(document))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
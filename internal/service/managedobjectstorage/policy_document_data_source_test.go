@@ -0,0 +1,72 @@
+package managedobjectstorage
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildPolicyDocument(t *testing.T) {
+	t.Parallel()
+
+	data := policyDocumentModel{
+		Version: types.StringNull(),
+		Statements: []policyDocumentStatementModel{
+			{
+				Sid:       types.StringValue("ReadWebsite"),
+				Effect:    types.StringNull(),
+				Actions:   []string{"s3:ListBucket", "s3:GetObject"},
+				Resources: []string{"arn:aws:s3:::website/*", "arn:aws:s3:::website"},
+				Conditions: []policyDocumentConditionModel{
+					{Test: types.StringValue("IpAddress"), Variable: types.StringValue("aws:SourceIp"), Values: []string{"192.0.2.0/24"}},
+					{Test: types.StringValue("IpAddress"), Variable: types.StringValue("aws:SourceIp"), Values: []string{"198.51.100.0/24"}},
+				},
+			},
+			{
+				Sid:       types.StringNull(),
+				Effect:    types.StringValue(policyEffectDeny),
+				Actions:   []string{"s3:DeleteObject"},
+				Resources: []string{"*"},
+			},
+		},
+	}
+
+	document, err := buildPolicyDocument(&data)
+	require.NoError(t, err)
+
+	assert.Equal(t, `{"Statement":[{"Action":["s3:GetObject","s3:ListBucket"],"Condition":{"IpAddress":{"aws:SourceIp":["192.0.2.0/24","198.51.100.0/24"]}},"Effect":"Allow","Resource":["arn:aws:s3:::website","arn:aws:s3:::website/*"],"Sid":"ReadWebsite"},{"Action":["s3:DeleteObject"],"Effect":"Deny","Resource":["*"]}],"Version":"2012-10-17"}`, document)
+	assert.Equal(t, defaultPolicyDocumentVersion, data.Version.ValueString())
+	assert.Equal(t, policyEffectAllow, data.Statements[0].Effect.ValueString())
+
+	// Generated document must not change when normalized, so that the policy resource does not detect changes.
+	normalized, diags := normalizePolicyDocument(url.QueryEscape(document))
+	require.False(t, diags.HasError())
+	assert.Equal(t, url.QueryEscape(document), normalized)
+}
+
+func TestIsSupportedPolicyAction(t *testing.T) {
+	t.Parallel()
+
+	for action, want := range map[string]bool{
+		"*":                  true,
+		"s3:*":               true,
+		"s3:GetObject":       true,
+		"S3:getobject":       true,
+		"s3:Get*":            true,
+		"s3:Put?bject":       true,
+		"iam:GetUser":        true,
+		"*:GetUser":          true,
+		"sts:AssumeRole":     true,
+		"s3:GetObjects":      false,
+		"s3:Create*Policy":   false,
+		"ec2:RunInstances":   false,
+		"GetObject":          false,
+		"s3:":                false,
+		"iam:CreateFunction": false,
+	} {
+		assert.Equal(t, want, isSupportedPolicyAction(action), action)
+	}
+}
@@ -0,0 +1,391 @@
+package managedobjectstorage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	v9 "github.com/UpCloudLtd/upcloud-go-api/v9/pkg/upcloud"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+const (
+	s3Service       = "s3"
+	s3Algorithm     = "AWS4-HMAC-SHA256"
+	s3TimeFormat    = "20060102T150405Z"
+	s3DateFormat    = "20060102"
+	s3XMLNamespace  = "http://s3.amazonaws.com/doc/2006-03-01/"
+	s3ClientTimeout = 5 * time.Minute
+
+	missingAccessKeyUsernameDetail = "`access_key_username` must be defined to manage the resource through the S3 and IAM-compatible APIs of the service."
+)
+
+// s3Client is a minimal client for the S3-compatible API of a Managed Object Storage service. Requests are authenticated
+// with AWS Signature Version 4 and buckets are addressed with path-style URLs. The same client is used for the
+// IAM-compatible API, see iamClient.
+type s3Client struct {
+	endpoint        *url.URL
+	region          string
+	service         string
+	accessKeyID     string
+	secretAccessKey string
+	httpClient      *http.Client
+	now             func() time.Time
+}
+
+type s3Error struct {
+	StatusCode int    `xml:"-"`
+	Code       string `xml:"Code"`
+	Message    string `xml:"Message"`
+	RequestID  string `xml:"RequestId"`
+}
+
+func (e *s3Error) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("S3 API returned HTTP %d", e.StatusCode)
+	}
+	return fmt.Sprintf("%s: %s (HTTP %d, request_id=%s)", e.Code, e.Message, e.StatusCode, e.RequestID)
+}
+
+func isS3ErrorCode(err error, codes ...string) bool {
+	var s3Err *s3Error
+	if !errors.As(err, &s3Err) {
+		return false
+	}
+
+	for _, code := range codes {
+		if s3Err.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+func newS3Client(endpoint, region, accessKeyID, secretAccessKey string) (*s3Client, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3Client{
+		endpoint:        u,
+		region:          region,
+		service:         s3Service,
+		accessKeyID:     accessKeyID,
+		secretAccessKey: secretAccessKey,
+		httpClient:      &http.Client{Timeout: s3ClientTimeout},
+		now:             time.Now,
+	}, nil
+}
+
+// objectStorageEndpoint contains the addresses of the public endpoint of a Managed Object Storage service.
+type objectStorageEndpoint struct {
+	domainName string
+	iamURL     string
+	region     string
+}
+
+// getObjectStorageEndpoint returns the public endpoint of the given Managed Object Storage service.
+func getObjectStorageEndpoint(ctx context.Context, client *v9.ClientWithResponses, serviceUUID string) (*objectStorageEndpoint, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	svcUUID, err := uuid.Parse(serviceUUID)
+	if err != nil {
+		diags.AddError(
+			"Unable to parse service UUID",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return nil, diags
+	}
+
+	apiResp, err := client.GetObjectStorageWithResponse(ctx, svcUUID)
+	if err != nil {
+		diags.AddError(
+			"Unable to read managed object storage details",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return nil, diags
+	}
+	if apiResp.StatusCode() != http.StatusOK || apiResp.JSON200 == nil {
+		diags.AddError(
+			"Unable to read managed object storage details",
+			objectStorageAPIErrorDetail(apiResp.ApplicationproblemJSONDefault, apiResp.Body),
+		)
+		return nil, diags
+	}
+
+	objsto := apiResp.JSON200
+	if objsto.Endpoints != nil {
+		for _, e := range *objsto.Endpoints {
+			if e.Type == nil || string(*e.Type) != "public" {
+				continue
+			}
+
+			endpoint := &objectStorageEndpoint{}
+			if e.DomainName != nil {
+				endpoint.domainName = *e.DomainName
+			}
+			if e.IamUrl != nil {
+				endpoint.iamURL = *e.IamUrl
+			}
+			if objsto.Region != nil {
+				endpoint.region = *objsto.Region
+			}
+			return endpoint, diags
+		}
+	}
+
+	diags.AddError(
+		"Unable to determine managed object storage endpoint",
+		"The managed object storage service does not have a public endpoint.",
+	)
+	return nil, diags
+}
+
+// getS3Client returns S3 client for the public endpoint of the given Managed Object Storage service. The client uses a
+// temporary access key of the given user: the returned release function must be called when the client is no longer used.
+func getS3Client(ctx context.Context, client *v9.ClientWithResponses, serviceUUID, username string) (*s3Client, func(), diag.Diagnostics) {
+	endpoint, diags := getObjectStorageEndpoint(ctx, client, serviceUUID)
+	if diags.HasError() {
+		return nil, func() {}, diags
+	}
+
+	if endpoint.domainName == "" {
+		diags.AddError(
+			"Unable to determine managed object storage S3 endpoint",
+			"The public endpoint of the managed object storage service does not have a domain name.",
+		)
+		return nil, func() {}, diags
+	}
+
+	accessKeyID, secretAccessKey, release, d := acquireAccessKey(ctx, client, serviceUUID, username)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, release, diags
+	}
+
+	s3, err := newS3Client("https://"+endpoint.domainName, endpoint.region, accessKeyID, secretAccessKey)
+	if err != nil {
+		diags.AddError(
+			"Unable to initialize managed object storage S3 client",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return nil, release, diags
+	}
+
+	return s3, release, diags
+}
+
+// s3Escape URI encodes the value as specified for AWS Signature Version 4: all characters except the unreserved characters
+// are percent-encoded. Slashes are encoded unless encodeSlash is false.
+func s3Escape(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= 'A' && c <= 'Z', c >= 'a' && c <= 'z', c >= '0' && c <= '9', c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func s3CanonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		values := append([]string{}, query[k]...)
+		sort.Strings(values)
+		for _, v := range values {
+			parts = append(parts, s3Escape(k, true)+"="+s3Escape(v, true))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// sign adds AWS Signature Version 4 authorization to the request. All headers of the request are included in the signature.
+func (c *s3Client) sign(req *http.Request, payloadHash string, t time.Time) {
+	amzDate := t.UTC().Format(s3TimeFormat)
+	date := t.UTC().Format(s3DateFormat)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+
+	headers := map[string]string{"host": host}
+	for k, v := range req.Header {
+		headers[strings.ToLower(k)] = strings.TrimSpace(strings.Join(v, ","))
+	}
+
+	headerNames := make([]string, 0, len(headers))
+	for k := range headers {
+		headerNames = append(headerNames, k)
+	}
+	sort.Strings(headerNames)
+
+	var canonicalHeaders strings.Builder
+	for _, k := range headerNames {
+		canonicalHeaders.WriteString(k + ":" + headers[k] + "\n")
+	}
+	signedHeaders := strings.Join(headerNames, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		s3CanonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{date, c.region, c.service, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{
+		s3Algorithm,
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+c.secretAccessKey), date)
+	key = hmacSHA256(key, c.region)
+	key = hmacSHA256(key, c.service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s,SignedHeaders=%s,Signature=%s", s3Algorithm, c.accessKeyID, scope, signedHeaders, signature))
+}
+
+// do sends a signed request to the given bucket and object key. Response body is returned for successful requests,
+// otherwise the error is returned as *s3Error.
+func (c *s3Client) do(ctx context.Context, method, bucket, key string, query url.Values, header http.Header, body []byte) ([]byte, http.Header, error) {
+	u := *c.endpoint
+	u.Path = strings.TrimSuffix(c.endpoint.Path, "/")
+	u.RawPath = s3Escape(u.Path, false)
+	if bucket != "" {
+		u.Path += "/" + bucket
+		u.RawPath += "/" + s3Escape(bucket, true)
+	}
+	if key != "" {
+		u.Path += "/" + key
+		u.RawPath += "/" + s3Escape(key, false)
+	}
+	if u.Path == "" {
+		u.Path, u.RawPath = "/", "/"
+	}
+	u.RawQuery = s3CanonicalQuery(query)
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	if body != nil {
+		// Content-MD5 header is required by the S3 API for, e.g., lifecycle and CORS configuration requests.
+		sum := md5.Sum(body)
+		req.Header.Set("Content-MD5", base64.StdEncoding.EncodeToString(sum[:]))
+	}
+	req.ContentLength = int64(len(body))
+
+	c.sign(req, sha256Hex(body), c.now())
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		s3Err := &s3Error{StatusCode: resp.StatusCode}
+		_ = xml.Unmarshal(respBody, s3Err)
+		if s3Err.Code == "" {
+			// IAM API wraps the error details in ErrorResponse element.
+			errResp := struct {
+				Error     *s3Error `xml:"Error"`
+				RequestID string   `xml:"RequestId"`
+			}{Error: s3Err}
+			_ = xml.Unmarshal(respBody, &errResp)
+			if s3Err.RequestID == "" {
+				s3Err.RequestID = errResp.RequestID
+			}
+		}
+		if s3Err.Code == "" && resp.StatusCode == http.StatusNotFound {
+			s3Err.Code = "NotFound"
+		}
+		return nil, resp.Header, s3Err
+	}
+
+	return respBody, resp.Header, nil
+}
+
+func (c *s3Client) getXML(ctx context.Context, bucket, subresource string, v any) error {
+	body, _, err := c.do(ctx, http.MethodGet, bucket, "", url.Values{subresource: {""}}, nil, nil)
+	if err != nil {
+		return err
+	}
+	return xml.Unmarshal(body, v)
+}
+
+func (c *s3Client) putXML(ctx context.Context, bucket, subresource string, v any) error {
+	body, err := xml.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	header := http.Header{}
+	header.Set("Content-Type", "application/xml")
+
+	_, _, err = c.do(ctx, http.MethodPut, bucket, "", url.Values{subresource: {""}}, header, body)
+	return err
+}
+
+func (c *s3Client) deleteSubresource(ctx context.Context, bucket, subresource string) error {
+	_, _, err := c.do(ctx, http.MethodDelete, bucket, "", url.Values{subresource: {""}}, nil, nil)
+	return err
+}
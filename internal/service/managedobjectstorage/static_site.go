@@ -9,6 +9,7 @@ import (
 	v9 "github.com/UpCloudLtd/upcloud-go-api/v9/pkg/upcloud"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -24,6 +25,8 @@ var (
 	_ resource.Resource                = &managedObjectStorageStaticSiteResource{}
 	_ resource.ResourceWithConfigure   = &managedObjectStorageStaticSiteResource{}
 	_ resource.ResourceWithImportState = &managedObjectStorageStaticSiteResource{}
+	_ resource.ResourceWithIdentity    = &managedObjectStorageStaticSiteResource{}
+	_ resource.ResourceWithModifyPlan  = &managedObjectStorageStaticSiteResource{}
 )
 
 func NewStaticSiteResource() resource.Resource {
@@ -53,6 +56,11 @@ type staticSiteModel struct {
 	SpaMode       types.Bool   `tfsdk:"spa_mode"`
 	Enabled       types.Bool   `tfsdk:"enabled"`
 	ErrorPages    types.List   `tfsdk:"error_pages"`
+
+	AccessKeyUsername types.String `tfsdk:"access_key_username"`
+	SourceDir         types.String `tfsdk:"source_dir"`
+	SourceFiles       types.Map    `tfsdk:"source_files"`
+	SourceHash        types.String `tfsdk:"source_hash"`
 }
 
 type errorPageModel struct {
@@ -64,7 +72,9 @@ type errorPageModel struct {
 
 func (r *managedObjectStorageStaticSiteResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "This resource represents an UpCloud Managed Object Storage static site.",
+		MarkdownDescription: `This resource represents an UpCloud Managed Object Storage static site.
+
+Optionally, the content of the static site can be synced from a local directory with ` + "`source_dir`" + `. Changed files are uploaded and removed files are deleted under ` + "`bucket_prefix`" + ` through the S3-compatible API of the service. Media types of the files are inferred from the file extensions. Objects under ` + "`bucket_prefix`" + ` that were not uploaded by this resource are not modified.`,
 		Attributes: map[string]schema.Attribute{
 			"domain_name": schema.StringAttribute{
 				MarkdownDescription: "A custom domain in `static-website` mode attached to the service.",
@@ -141,6 +151,23 @@ func (r *managedObjectStorageStaticSiteResource) Schema(_ context.Context, _ res
 					listvalidator.SizeBetween(0, 25),
 				},
 			},
+			"access_key_username": accessKeyUsernameAttribute("sync the content of `source_dir` through the S3-compatible API of the service", false),
+			"source_dir": schema.StringAttribute{
+				MarkdownDescription: "Path to a local directory to sync to `bucket_prefix` in the bucket. Files synced earlier are deleted when `source_dir` is removed. Requires `access_key_username`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("access_key_username")),
+				},
+			},
+			"source_files": schema.MapAttribute{
+				MarkdownDescription: "SHA256 hashes of the files synced from `source_dir`, keyed by the file path relative to `source_dir`.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"source_hash": schema.StringAttribute{
+				MarkdownDescription: "Combined hash of the files synced from `source_dir`. Can be used, for example, to trigger cache invalidation when the content changes.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -244,6 +271,50 @@ func diagUnexpectedStatus(respDiags *diag.Diagnostics, action string, status int
 	)
 }
 
+func (r *managedObjectStorageStaticSiteResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan staticSiteModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(planStaticSiteContent(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// getS3Client returns S3 client for syncing the content or nil, if the content is not synced from a source directory.
+// The returned function releases the access key of the client and must always be called.
+func (r *managedObjectStorageStaticSiteResource) getS3Client(ctx context.Context, data *staticSiteModel) (*s3Client, func(), diag.Diagnostics) {
+	if data.SourceDir.IsNull() && data.SourceFiles.IsNull() {
+		return nil, func() {}, nil
+	}
+	return getS3Client(ctx, r.client, data.ServiceUUID.ValueString(), data.AccessKeyUsername.ValueString())
+}
+
+func (r *managedObjectStorageStaticSiteResource) syncContent(ctx context.Context, data, state *staticSiteModel) diag.Diagnostics {
+	s3, release, diags := r.getS3Client(ctx, data)
+	// Files synced earlier need to be deleted, if source_dir has been removed.
+	if s3 == nil && state != nil && !diags.HasError() {
+		release()
+		s3, release, diags = r.getS3Client(ctx, state)
+	}
+	defer release()
+	if diags.HasError() || s3 == nil {
+		return diags
+	}
+	diags.Append(syncStaticSiteContent(ctx, s3, data, state)...)
+	return diags
+}
+
 func (r *managedObjectStorageStaticSiteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data staticSiteModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -321,8 +392,10 @@ func (r *managedObjectStorageStaticSiteResource) Create(ctx context.Context, req
 
 	data.ID = types.StringValue(utils.MarshalID(data.ServiceUUID.ValueString(), created.JSON201.DomainName))
 	resp.Diagnostics.Append(setStaticSiteValues(ctx, &data, created.JSON201)...)
+	resp.Diagnostics.Append(r.syncContent(ctx, &data, nil)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, staticSiteIdentity, data.ID.ValueString())...)
 }
 
 func (r *managedObjectStorageStaticSiteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -376,7 +449,20 @@ func (r *managedObjectStorageStaticSiteResource) Read(ctx context.Context, req r
 
 	resp.Diagnostics.Append(setStaticSiteValues(ctx, &data, site.JSON200)...)
 	data.ID = types.StringValue(utils.MarshalID(serviceUUID, data.DomainName.ValueString()))
+
+	s3, release, diags := r.getS3Client(ctx, &data)
+	defer release()
+	resp.Diagnostics.Append(diags...)
+	if s3 != nil {
+		resp.Diagnostics.Append(readStaticSiteContent(ctx, s3, &data)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, staticSiteIdentity, data.ID.ValueString())...)
 }
 
 func (r *managedObjectStorageStaticSiteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -459,6 +545,7 @@ func (r *managedObjectStorageStaticSiteResource) Update(ctx context.Context, req
 
 	resp.Diagnostics.Append(setStaticSiteValues(ctx, &data, updated.JSON200)...)
 	data.ID = types.StringValue(utils.MarshalID(data.ServiceUUID.ValueString(), data.DomainName.ValueString()))
+	resp.Diagnostics.Append(r.syncContent(ctx, &data, &state)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -493,9 +580,38 @@ func (r *managedObjectStorageStaticSiteResource) Delete(ctx context.Context, req
 
 	if deleted.StatusCode() != http.StatusNoContent && deleted.StatusCode() != http.StatusNotFound {
 		diagUnexpectedStatus(&resp.Diagnostics, "delete", deleted.StatusCode(), deleted.Body)
+		return
 	}
+
+	files, diags := staticSiteFiles(ctx, data.SourceFiles)
+	resp.Diagnostics.Append(diags...)
+	if len(files) == 0 {
+		return
+	}
+
+	s3, release, diags := r.getS3Client(ctx, &data)
+	defer release()
+	resp.Diagnostics.Append(diags...)
+	if s3 == nil {
+		return
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	resp.Diagnostics.Append(deleteStaticSiteContent(ctx, s3, data.BucketName.ValueString(), data.BucketPrefix.ValueString(), names)...)
+}
+
+var staticSiteIdentity = []utils.IdentityAttribute{
+	{Name: "service_uuid", Description: "UUID of the managed object storage service."},
+	{Name: "domain_name", Description: "Domain name of the static site."},
+}
+
+func (r *managedObjectStorageStaticSiteResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(staticSiteIdentity)
 }
 
 func (r *managedObjectStorageStaticSiteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, staticSiteIdentity, req, resp)
 }
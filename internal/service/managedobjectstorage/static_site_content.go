@@ -0,0 +1,278 @@
+package managedobjectstorage
+
+import (
+	"context"
+	"encoding/xml"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type s3ListBucketResult struct {
+	Contents []struct {
+		Key string `xml:"Key"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+// listObjectKeys lists keys of all objects in the bucket that start with the given prefix.
+func (c *s3Client) listObjectKeys(ctx context.Context, bucket, prefix string) ([]string, error) {
+	var keys []string
+	query := url.Values{"list-type": {"2"}, "prefix": {prefix}}
+	for {
+		body, _, err := c.do(ctx, http.MethodGet, bucket, "", query, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var result s3ListBucketResult
+		if err := xml.Unmarshal(body, &result); err != nil {
+			return nil, err
+		}
+
+		for _, object := range result.Contents {
+			keys = append(keys, object.Key)
+		}
+
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return keys, nil
+		}
+		query.Set("continuation-token", result.NextContinuationToken)
+	}
+}
+
+// readStaticSiteSourceFiles returns the SHA256 hashes of the regular files in the source directory. Map keys are the
+// file paths relative to the source directory with forward slashes as separators.
+func readStaticSiteSourceFiles(dir string) (map[string]string, error) {
+	files := make(map[string]string)
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		content, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = sha256Hex(content)
+		return nil
+	})
+	return files, err
+}
+
+// staticSiteSourceHash calculates a combined hash of the source files.
+func staticSiteSourceHash(files map[string]string) string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	for _, name := range names {
+		b.WriteString(files[name] + "  " + name + "\n")
+	}
+	return sha256Hex([]byte(b.String()))
+}
+
+// staticSiteContentChanges returns the files that need to be uploaded and the files that need to be deleted to sync the
+// planned files. If the content has been moved to another bucket or prefix, all planned files are uploaded.
+func staticSiteContentChanges(planFiles, stateFiles map[string]string, moved bool) (upload, remove []string) {
+	for name, hash := range planFiles {
+		if moved || stateFiles[name] != hash {
+			upload = append(upload, name)
+		}
+	}
+	for name := range stateFiles {
+		if _, ok := planFiles[name]; moved || !ok {
+			remove = append(remove, name)
+		}
+	}
+	sort.Strings(upload)
+	sort.Strings(remove)
+	return
+}
+
+func staticSiteFilesValue(ctx context.Context, files map[string]string) (types.Map, diag.Diagnostics) {
+	if files == nil {
+		return types.MapNull(types.StringType), nil
+	}
+	return types.MapValueFrom(ctx, types.StringType, files)
+}
+
+func staticSiteFiles(ctx context.Context, m types.Map) (map[string]string, diag.Diagnostics) {
+	if m.IsNull() || m.IsUnknown() {
+		return nil, nil
+	}
+
+	files := make(map[string]string)
+	diags := m.ElementsAs(ctx, &files, false)
+	return files, diags
+}
+
+// planStaticSiteContent sets the planned source files and hash based on the contents of the source directory.
+func planStaticSiteContent(ctx context.Context, plan *staticSiteModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch {
+	case plan.SourceDir.IsNull():
+		plan.SourceFiles = types.MapNull(types.StringType)
+		plan.SourceHash = types.StringNull()
+		return diags
+	case plan.SourceDir.IsUnknown():
+		plan.SourceFiles = types.MapUnknown(types.StringType)
+		plan.SourceHash = types.StringUnknown()
+		return diags
+	}
+
+	files, err := readStaticSiteSourceFiles(plan.SourceDir.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("source_dir"),
+			"Unable to read static site source directory",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return diags
+	}
+
+	plan.SourceFiles, diags = staticSiteFilesValue(ctx, files)
+	plan.SourceHash = types.StringValue(staticSiteSourceHash(files))
+
+	diags.Append(validateStaticSiteContent(ctx, plan, files)...)
+	return diags
+}
+
+// syncStaticSiteContent uploads the changed files from the source directory and deletes the removed files. If
+// source_dir has been removed, all previously synced files are deleted. Plan must contain the bucket and prefix returned
+// by the API. State is nil when the static site is created.
+func syncStaticSiteContent(ctx context.Context, s3 *s3Client, plan, state *staticSiteModel) diag.Diagnostics {
+	planFiles, diags := staticSiteFiles(ctx, plan.SourceFiles)
+	if diags.HasError() {
+		return diags
+	}
+
+	var stateFiles map[string]string
+	moved := false
+	if state != nil {
+		var d diag.Diagnostics
+		stateFiles, d = staticSiteFiles(ctx, state.SourceFiles)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		moved = !plan.BucketName.Equal(state.BucketName) || !plan.BucketPrefix.Equal(state.BucketPrefix)
+	}
+
+	if planFiles == nil && stateFiles == nil {
+		return diags
+	}
+
+	bucket, prefix := plan.BucketName.ValueString(), plan.BucketPrefix.ValueString()
+	upload, remove := staticSiteContentChanges(planFiles, stateFiles, moved)
+
+	// Delete removed files first, so that files uploaded to the same keys after moving the content are not deleted.
+	if len(remove) > 0 {
+		diags.Append(deleteStaticSiteContent(ctx, s3, state.BucketName.ValueString(), state.BucketPrefix.ValueString(), remove)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	for _, name := range upload {
+		content, err := os.ReadFile(filepath.Join(plan.SourceDir.ValueString(), filepath.FromSlash(name)))
+		if err != nil {
+			diags.AddError(
+				"Unable to read static site source file",
+				utils.ErrorDiagnosticDetail(err),
+			)
+			return diags
+		}
+		if sha256Hex(content) != planFiles[name] {
+			diags.AddError(
+				"Static site source file changed after planning",
+				"File "+name+" was modified after the plan was created. Run terraform apply again to upload the current content.",
+			)
+			return diags
+		}
+
+		key := prefix + name
+		if err := s3.putObject(ctx, bucket, key, content, objectMediaType(key), "", nil); err != nil {
+			diags.AddError(
+				"Unable to upload static site source file",
+				utils.ErrorDiagnosticDetail(err),
+			)
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func deleteStaticSiteContent(ctx context.Context, s3 *s3Client, bucket, prefix string, names []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, name := range names {
+		if err := s3.deleteObject(ctx, bucket, prefix+name); err != nil && !isS3NotFoundError(err) {
+			diags.AddError(
+				"Unable to delete static site file",
+				utils.ErrorDiagnosticDetail(err),
+			)
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// readStaticSiteContent removes files that do not exist in the bucket anymore from the source files, so that they are
+// uploaded again on the next apply.
+func readStaticSiteContent(ctx context.Context, s3 *s3Client, data *staticSiteModel) diag.Diagnostics {
+	files, diags := staticSiteFiles(ctx, data.SourceFiles)
+	if diags.HasError() || files == nil {
+		return diags
+	}
+
+	prefix := data.BucketPrefix.ValueString()
+	keys, err := s3.listObjectKeys(ctx, data.BucketName.ValueString(), prefix)
+	if err != nil {
+		diags.AddError(
+			"Unable to list static site files",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return diags
+	}
+
+	existing := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		existing[strings.TrimPrefix(key, prefix)] = true
+	}
+
+	for name := range files {
+		if !existing[name] {
+			delete(files, name)
+		}
+	}
+
+	data.SourceFiles, diags = staticSiteFilesValue(ctx, files)
+	data.SourceHash = types.StringValue(staticSiteSourceHash(files))
+	return diags
+}
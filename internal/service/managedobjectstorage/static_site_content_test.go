@@ -0,0 +1,90 @@
+package managedobjectstorage
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadStaticSiteSourceFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "assets", "img"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "index.html"), []byte(""), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "assets", "img", "logo.svg"), []byte("a"), 0o600))
+
+	files, err := readStaticSiteSourceFiles(dir)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"index.html":          "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		"assets/img/logo.svg": "ca978112ca1bbdcafac231b39a23dc4da786eff8147c4e72b9807785afee48bb",
+	}, files)
+
+	_, err = readStaticSiteSourceFiles(filepath.Join(dir, "missing"))
+	assert.Error(t, err)
+}
+
+func TestStaticSiteSourceHash(t *testing.T) {
+	t.Parallel()
+
+	a := staticSiteSourceHash(map[string]string{"index.html": "1", "404.html": "2"})
+	b := staticSiteSourceHash(map[string]string{"404.html": "2", "index.html": "1"})
+	c := staticSiteSourceHash(map[string]string{"404.html": "2", "index.html": "3"})
+
+	assert.Equal(t, a, b)
+	assert.NotEqual(t, a, c)
+}
+
+func TestStaticSiteContentChanges(t *testing.T) {
+	t.Parallel()
+
+	plan := map[string]string{"index.html": "1", "app.js": "2", "new.css": "3"}
+	state := map[string]string{"index.html": "1", "app.js": "0", "old.css": "4"}
+
+	upload, remove := staticSiteContentChanges(plan, state, false)
+	assert.Equal(t, []string{"app.js", "new.css"}, upload)
+	assert.Equal(t, []string{"old.css"}, remove)
+
+	upload, remove = staticSiteContentChanges(plan, state, true)
+	assert.Equal(t, []string{"app.js", "index.html", "new.css"}, upload)
+	assert.Equal(t, []string{"app.js", "index.html", "old.css"}, remove)
+
+	upload, remove = staticSiteContentChanges(plan, nil, false)
+	assert.Equal(t, []string{"app.js", "index.html", "new.css"}, upload)
+	assert.Empty(t, remove)
+
+	// Source directory has been removed.
+	upload, remove = staticSiteContentChanges(nil, state, false)
+	assert.Empty(t, upload)
+	assert.Equal(t, []string{"app.js", "index.html", "old.css"}, remove)
+}
+
+func TestS3ClientListObjectKeys(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "2", r.URL.Query().Get("list-type"))
+		assert.Equal(t, "public/", r.URL.Query().Get("prefix"))
+
+		if r.URL.Query().Get("continuation-token") == "" {
+			_, _ = w.Write([]byte(`<ListBucketResult><Contents><Key>public/index.html</Key></Contents><IsTruncated>true</IsTruncated><NextContinuationToken>next</NextContinuationToken></ListBucketResult>`))
+			return
+		}
+		_, _ = w.Write([]byte(`<ListBucketResult><Contents><Key>public/404.html</Key></Contents><IsTruncated>false</IsTruncated></ListBucketResult>`))
+	}))
+	defer srv.Close()
+
+	c, err := newS3Client(srv.URL, "europe-1", "AKIA", "secret")
+	require.NoError(t, err)
+
+	keys, err := c.listObjectKeys(context.Background(), "website", "public/")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"public/index.html", "public/404.html"}, keys)
+}
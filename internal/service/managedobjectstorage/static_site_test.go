@@ -77,3 +77,32 @@ func TestParseErrorPages(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateStaticSiteContent(t *testing.T) {
+	t.Parallel()
+
+	pages, diags := types.ListValueFrom(context.Background(), errorPageType(), []errorPageModel{
+		{StatusCode: types.Int64Value(404), ErrorDocument: types.StringValue("public/404.html")},
+		{StatusCode: types.Int64Value(500), ErrorDocument: types.StringValue("500.html")},
+	})
+	if diags.HasError() {
+		t.Fatalf("failed to create test list: %v", diags)
+	}
+
+	data := staticSiteModel{
+		BucketPrefix:  types.StringValue("public/"),
+		IndexDocument: types.StringNull(),
+		ErrorPages:    pages,
+		SourceDir:     types.StringValue("dist"),
+	}
+
+	diags = validateStaticSiteContent(context.Background(), &data, map[string]string{"index.html": "1", "404.html": "2"})
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Fatalf("expected a warning for the missing error document, got %v", diags)
+	}
+
+	diags = validateStaticSiteContent(context.Background(), &data, map[string]string{"404.html": "2", "500.html": "3"})
+	if diags.WarningsCount() != 1 || diags.Warnings()[0].Summary() != "Index document missing from static site content" {
+		t.Fatalf("expected a warning for the missing index document, got %v", diags)
+	}
+}
@@ -3,6 +3,7 @@ package managedobjectstorage
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -10,7 +11,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-const errorPageStatusMatcherValidationDetail = "Provide either status_code or both status_range_start and status_range_end, but not both."
+const (
+	errorPageStatusMatcherValidationDetail = "Provide either status_code or both status_range_start and status_range_end, but not both."
+	defaultStaticSiteIndexDocument         = "index.html"
+)
 
 type errorPagesValidator struct{}
 
@@ -89,3 +93,50 @@ func validateErrorPageStatusMatcherAtIndex(pages []errorPageModel) error {
 
 	return nil
 }
+
+// validateStaticSiteContent warns if the index document or an error document is not included in the synced files.
+func validateStaticSiteContent(ctx context.Context, data *staticSiteModel, files map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	prefix := data.BucketPrefix.ValueString()
+	hasFile := func(document string) bool {
+		_, ok := files[strings.TrimPrefix(document, prefix)]
+		return ok
+	}
+
+	indexDocument := defaultStaticSiteIndexDocument
+	if !data.IndexDocument.IsNull() && !data.IndexDocument.IsUnknown() {
+		indexDocument = data.IndexDocument.ValueString()
+	}
+	if !data.IndexDocument.IsUnknown() && !hasFile(indexDocument) {
+		diags.AddAttributeWarning(
+			path.Root("index_document"),
+			"Index document missing from static site content",
+			fmt.Sprintf("Index document %q was not found in the source directory %q.", indexDocument, data.SourceDir.ValueString()),
+		)
+	}
+
+	if data.ErrorPages.IsNull() || data.ErrorPages.IsUnknown() {
+		return diags
+	}
+
+	var pages []errorPageModel
+	diags.Append(data.ErrorPages.ElementsAs(ctx, &pages, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for i, page := range pages {
+		if page.ErrorDocument.IsUnknown() || hasFile(page.ErrorDocument.ValueString()) {
+			continue
+		}
+
+		diags.AddAttributeWarning(
+			path.Root("error_pages").AtListIndex(i).AtName("error_document"),
+			"Error document missing from static site content",
+			fmt.Sprintf("Error document %q was not found in the source directory %q.", page.ErrorDocument.ValueString(), data.SourceDir.ValueString()),
+		)
+	}
+
+	return diags
+}
@@ -9,7 +9,6 @@ import (
 	v9 "github.com/UpCloudLtd/upcloud-go-api/v9/pkg/upcloud"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -21,6 +20,7 @@ var (
 	_ resource.Resource                = &managedObjectStorageUserResource{}
 	_ resource.ResourceWithConfigure   = &managedObjectStorageUserResource{}
 	_ resource.ResourceWithImportState = &managedObjectStorageUserResource{}
+	_ resource.ResourceWithIdentity    = &managedObjectStorageUserResource{}
 )
 
 func NewUserResource() resource.Resource {
@@ -142,6 +142,7 @@ func (r *managedObjectStorageUserResource) Create(ctx context.Context, req resou
 
 	resp.Diagnostics.Append(setUserValues(ctx, &data, apiResp.JSON201)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, userIdentity, data.ID.ValueString())...)
 }
 
 func (r *managedObjectStorageUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -197,6 +198,7 @@ func (r *managedObjectStorageUserResource) Read(ctx context.Context, req resourc
 
 	resp.Diagnostics.Append(setUserValues(ctx, &data, apiResp.JSON200)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, userIdentity, data.ID.ValueString())...)
 }
 
 func (r *managedObjectStorageUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -236,6 +238,15 @@ func (r *managedObjectStorageUserResource) Delete(ctx context.Context, req resou
 	}
 }
 
+var userIdentity = []utils.IdentityAttribute{
+	{Name: "service_uuid", Description: "UUID of the managed object storage service."},
+	{Name: "username", Description: "Name of the user."},
+}
+
+func (r *managedObjectStorageUserResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(userIdentity)
+}
+
 func (r *managedObjectStorageUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, userIdentity, req, resp)
 }
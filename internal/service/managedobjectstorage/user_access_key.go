@@ -10,7 +10,6 @@ import (
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -23,6 +22,7 @@ var (
 	_ resource.Resource                = &managedObjectStorageUserAccessKeyResource{}
 	_ resource.ResourceWithConfigure   = &managedObjectStorageUserAccessKeyResource{}
 	_ resource.ResourceWithImportState = &managedObjectStorageUserAccessKeyResource{}
+	_ resource.ResourceWithIdentity    = &managedObjectStorageUserAccessKeyResource{}
 )
 
 func NewUserAccessKeyResource() resource.Resource {
@@ -217,6 +217,7 @@ func (r *managedObjectStorageUserAccessKeyResource) Create(ctx context.Context,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, userAccessKeyIdentity, data.ID.ValueString())...)
 }
 
 func (r *managedObjectStorageUserAccessKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -278,6 +279,7 @@ func (r *managedObjectStorageUserAccessKeyResource) Read(ctx context.Context, re
 
 	resp.Diagnostics.Append(setUserAccessKeyValues(&data, apiResp.JSON200)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, userAccessKeyIdentity, data.ID.ValueString())...)
 }
 
 func (r *managedObjectStorageUserAccessKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -357,6 +359,16 @@ func (r *managedObjectStorageUserAccessKeyResource) Delete(ctx context.Context,
 	}
 }
 
+var userAccessKeyIdentity = []utils.IdentityAttribute{
+	{Name: "service_uuid", Description: "UUID of the managed object storage service."},
+	{Name: "username", Description: "Name of the user."},
+	{Name: "access_key_id", Description: "Access key ID."},
+}
+
+func (r *managedObjectStorageUserAccessKeyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(userAccessKeyIdentity)
+}
+
 func (r *managedObjectStorageUserAccessKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, userAccessKeyIdentity, req, resp)
 }
@@ -0,0 +1,173 @@
+package managedobjectstorage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	v9 "github.com/UpCloudLtd/upcloud-go-api/v9/pkg/upcloud"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// userAccessKeyEphemeralPrivateKey is the private data key used to pass the created access key from Open to Close.
+const userAccessKeyEphemeralPrivateKey = "access_key"
+
+func NewUserAccessKeyEphemeral() ephemeral.EphemeralResource {
+	return &userAccessKeyEphemeral{}
+}
+
+var (
+	_ ephemeral.EphemeralResource              = &userAccessKeyEphemeral{}
+	_ ephemeral.EphemeralResourceWithConfigure = &userAccessKeyEphemeral{}
+	_ ephemeral.EphemeralResourceWithClose     = &userAccessKeyEphemeral{}
+)
+
+type userAccessKeyEphemeral struct {
+	client *v9.ClientWithResponses
+}
+
+func (e *userAccessKeyEphemeral) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_managed_object_storage_user_access_key"
+}
+
+func (e *userAccessKeyEphemeral) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.client, resp.Diagnostics = utils.GetV9ClientFromProviderData(req.ProviderData)
+}
+
+type userAccessKeyEphemeralModel struct {
+	AccessKeyID     types.String `tfsdk:"access_key_id"`
+	SecretAccessKey types.String `tfsdk:"secret_access_key"`
+	ServiceUUID     types.String `tfsdk:"service_uuid"`
+	Username        types.String `tfsdk:"username"`
+}
+
+type userAccessKeyEphemeralPrivateData struct {
+	ServiceUUID string `json:"service_uuid"`
+	Username    string `json:"username"`
+	AccessKeyID string `json:"access_key_id"`
+}
+
+func (e *userAccessKeyEphemeral) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Short-lived access key for an UpCloud Managed Object Storage user. The access key is created when Terraform opens the ephemeral resource and deleted when Terraform closes it, i.e. at the end of the Terraform run. The access key is not stored in the state or in the plan. Note that a user can have at most two access keys at a time.",
+		Attributes: map[string]schema.Attribute{
+			"access_key_id": schema.StringAttribute{
+				Description: "Access key ID.",
+				Computed:    true,
+			},
+			"secret_access_key": schema.StringAttribute{
+				Description: "Secret access key.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"service_uuid": schema.StringAttribute{
+				Description: "Managed Object Storage service UUID.",
+				Required:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "Username.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (e *userAccessKeyEphemeral) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data userAccessKeyEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	svcUUID, err := uuid.Parse(data.ServiceUUID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid service UUID", utils.ErrorDiagnosticDetail(err))
+		return
+	}
+
+	apiResp, err := e.client.CreateObjectStorageAccessKeyWithResponse(ctx, svcUUID, data.Username.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create managed object storage user access key",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+	if apiResp.StatusCode() != http.StatusCreated {
+		resp.Diagnostics.AddError(
+			"Unable to create managed object storage user access key",
+			objectStorageAPIErrorDetail(apiResp.ApplicationproblemJSONDefault, apiResp.Body),
+		)
+		return
+	}
+	if apiResp.JSON201 == nil || apiResp.JSON201.AccessKeyId == nil {
+		resp.Diagnostics.AddError(
+			"Unable to create managed object storage user access key",
+			utils.ErrorDiagnosticDetail(fmt.Errorf("unexpected response: %s", apiResp.HTTPResponse.Status)),
+		)
+		return
+	}
+
+	created := apiResp.JSON201
+	data.AccessKeyID = types.StringValue(*created.AccessKeyId)
+	data.SecretAccessKey = types.StringPointerValue(created.SecretAccessKey)
+
+	private, err := json.Marshal(userAccessKeyEphemeralPrivateData{
+		ServiceUUID: data.ServiceUUID.ValueString(),
+		Username:    data.Username.ValueString(),
+		AccessKeyID: *created.AccessKeyId,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to store managed object storage user access key details",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, userAccessKeyEphemeralPrivateKey, private)...)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (e *userAccessKeyEphemeral) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	private, diags := req.Private.GetKey(ctx, userAccessKeyEphemeralPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || private == nil {
+		return
+	}
+
+	var data userAccessKeyEphemeralPrivateData
+	if err := json.Unmarshal(private, &data); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read managed object storage user access key details",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	svcUUID, err := uuid.Parse(data.ServiceUUID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid service UUID", utils.ErrorDiagnosticDetail(err))
+		return
+	}
+
+	apiResp, err := e.client.DeleteObjectStorageAccessKeyWithResponse(ctx, svcUUID, data.Username, data.AccessKeyID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete managed object storage user access key",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+	if apiResp.StatusCode() != http.StatusNoContent && apiResp.StatusCode() != http.StatusNotFound {
+		resp.Diagnostics.AddError(
+			"Unable to delete managed object storage user access key",
+			objectStorageAPIErrorDetail(apiResp.ApplicationproblemJSONDefault, apiResp.Body),
+		)
+	}
+}
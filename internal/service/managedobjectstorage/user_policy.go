@@ -8,7 +8,6 @@ import (
 	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
 	v9 "github.com/UpCloudLtd/upcloud-go-api/v9/pkg/upcloud"
 	"github.com/google/uuid"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -20,6 +19,7 @@ var (
 	_ resource.Resource                = &managedObjectStorageUserPolicyResource{}
 	_ resource.ResourceWithConfigure   = &managedObjectStorageUserPolicyResource{}
 	_ resource.ResourceWithImportState = &managedObjectStorageUserPolicyResource{}
+	_ resource.ResourceWithIdentity    = &managedObjectStorageUserPolicyResource{}
 )
 
 func NewUserPolicyResource() resource.Resource {
@@ -120,6 +120,7 @@ func (r *managedObjectStorageUserPolicyResource) Create(ctx context.Context, req
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, userPolicyIdentity, data.ID.ValueString())...)
 }
 
 func policyExists(policies []v9.ObjectStorage2PolicyAttachmentResponse, name string) bool {
@@ -191,6 +192,7 @@ func (r *managedObjectStorageUserPolicyResource) Read(ctx context.Context, req r
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, userPolicyIdentity, data.ID.ValueString())...)
 }
 
 func (r *managedObjectStorageUserPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -233,6 +235,16 @@ func (r *managedObjectStorageUserPolicyResource) Delete(ctx context.Context, req
 	}
 }
 
+var userPolicyIdentity = []utils.IdentityAttribute{
+	{Name: "service_uuid", Description: "UUID of the managed object storage service."},
+	{Name: "username", Description: "Name of the user."},
+	{Name: "name", Description: "Name of the policy."},
+}
+
+func (r *managedObjectStorageUserPolicyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(userPolicyIdentity)
+}
+
 func (r *managedObjectStorageUserPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, userPolicyIdentity, req, resp)
 }
@@ -13,7 +13,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -30,6 +29,7 @@ var (
 	_ resource.Resource                = &networkResource{}
 	_ resource.ResourceWithConfigure   = &networkResource{}
 	_ resource.ResourceWithImportState = &networkResource{}
+	_ resource.ResourceWithIdentity    = &networkResource{}
 )
 
 func NewNetworkResource() resource.Resource {
@@ -770,6 +770,7 @@ func (r *networkResource) Create(ctx context.Context, req resource.CreateRequest
 
 	resp.Diagnostics.Append(setValues(ctx, &data, network)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, networkIdentity, data.ID.ValueString())...)
 }
 
 func (r *networkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -803,6 +804,7 @@ func (r *networkResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	resp.Diagnostics.Append(setValues(ctx, &data, network)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, networkIdentity, data.ID.ValueString())...)
 }
 
 func (r *networkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -881,6 +883,12 @@ func (r *networkResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 }
 
+var networkIdentity = utils.UUIDIdentity("network")
+
+func (r *networkResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(networkIdentity)
+}
+
 func (r *networkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, networkIdentity, req, resp)
 }
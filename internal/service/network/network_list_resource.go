@@ -0,0 +1,109 @@
+package network
+
+import (
+	"context"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &networkListResource{}
+	_ list.ListResourceWithConfigure = &networkListResource{}
+)
+
+func NewNetworkListResource() list.ListResource {
+	return &networkListResource{}
+}
+
+type networkListResource struct {
+	client *service.Service
+}
+
+func (r *networkListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network"
+}
+
+// Configure adds the provider configured client to the list resource.
+func (r *networkListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = utils.GetClientFromProviderData(req.ProviderData)
+}
+
+type networkListModel struct {
+	Zone   types.String `tfsdk:"zone"`
+	Labels types.Map    `tfsdk:"labels"`
+}
+
+func (r *networkListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists SDN private networks, e.g., for generating import blocks with `terraform query`.",
+		Attributes: map[string]schema.Attribute{
+			"zone":   utils.ListResourceZoneAttribute("networks"),
+			"labels": utils.ListResourceLabelsAttribute("networks"),
+		},
+	}
+}
+
+func (r *networkListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data networkListModel
+	diags := utils.GetListResourceConfig(ctx, req.Config, &data)
+
+	labels, labelsDiags := utils.ListResourceLabelsFilter(ctx, data.Labels)
+	diags.Append(labelsDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var networks *upcloud.Networks
+	var err error
+	if data.Zone.ValueString() != "" {
+		networks, err = r.client.GetNetworksInZone(ctx, &request.GetNetworksInZoneRequest{
+			Zone: data.Zone.ValueString(),
+		})
+	} else {
+		networks, err = r.client.GetNetworks(ctx)
+	}
+	if err != nil {
+		diags.AddError(
+			"Unable to list networks",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, network := range networks.Networks {
+			// Public and utility networks are managed by UpCloud.
+			if network.Type != upcloud.NetworkTypePrivate {
+				continue
+			}
+
+			if !utils.LabelsMatch(labels, network.Labels) {
+				continue
+			}
+
+			result := utils.NewListResult(ctx, req, networkIdentity, network.UUID, network.Name)
+			if req.IncludeResource {
+				result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("name"), network.Name)...)
+				result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("zone"), network.Zone)...)
+				result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("labels"), utils.LabelsSliceToMap(network.Labels))...)
+				if network.Router != "" {
+					result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("router"), network.Router)...)
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
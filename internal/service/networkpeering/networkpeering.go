@@ -12,7 +12,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -27,6 +26,7 @@ var (
 	_ resource.Resource                = &networkPeeringResource{}
 	_ resource.ResourceWithConfigure   = &networkPeeringResource{}
 	_ resource.ResourceWithImportState = &networkPeeringResource{}
+	_ resource.ResourceWithIdentity    = &networkPeeringResource{}
 )
 
 func NewNetworkPeeringResource() resource.Resource {
@@ -174,6 +174,7 @@ func (r *networkPeeringResource) Create(ctx context.Context, req resource.Create
 	resp.Diagnostics.Append(waitForPeeringToLeaveProvisionedState(ctx, r.client, peering.UUID)...)
 	resp.Diagnostics.Append(setValues(ctx, &data, peering)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, networkPeeringIdentity, data.ID.ValueString())...)
 }
 
 func (r *networkPeeringResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -207,6 +208,7 @@ func (r *networkPeeringResource) Read(ctx context.Context, req resource.ReadRequ
 
 	resp.Diagnostics.Append(setValues(ctx, &data, peering)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, networkPeeringIdentity, data.ID.ValueString())...)
 }
 
 func (r *networkPeeringResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -288,8 +290,14 @@ func (r *networkPeeringResource) Delete(ctx context.Context, req resource.Delete
 	}
 }
 
+var networkPeeringIdentity = utils.UUIDIdentity("network peering")
+
+func (r *networkPeeringResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(networkPeeringIdentity)
+}
+
 func (r *networkPeeringResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, networkPeeringIdentity, req, resp)
 }
 
 func waitForPeeringToLeaveProvisionedState(ctx context.Context, svc *service.Service, uuid string) (diags diag.Diagnostics) {
@@ -12,7 +12,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -26,6 +25,7 @@ var (
 	_ resource.Resource                = &routerResource{}
 	_ resource.ResourceWithConfigure   = &routerResource{}
 	_ resource.ResourceWithImportState = &routerResource{}
+	_ resource.ResourceWithIdentity    = &routerResource{}
 )
 
 func NewRouterResource() resource.Resource {
@@ -241,6 +241,7 @@ func (r *routerResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	resp.Diagnostics.Append(setValues(ctx, &data, router)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, routerIdentity, data.ID.ValueString())...)
 }
 
 func (r *routerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -274,6 +275,7 @@ func (r *routerResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	resp.Diagnostics.Append(setValues(ctx, &data, router)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, routerIdentity, data.ID.ValueString())...)
 }
 
 func (r *routerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -350,6 +352,12 @@ func (r *routerResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+var routerIdentity = utils.UUIDIdentity("router")
+
+func (r *routerResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(routerIdentity)
+}
+
 func (r *routerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, routerIdentity, req, resp)
 }
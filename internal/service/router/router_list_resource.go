@@ -0,0 +1,88 @@
+package router
+
+import (
+	"context"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &routerListResource{}
+	_ list.ListResourceWithConfigure = &routerListResource{}
+)
+
+func NewRouterListResource() list.ListResource {
+	return &routerListResource{}
+}
+
+type routerListResource struct {
+	client *service.Service
+}
+
+func (r *routerListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_router"
+}
+
+// Configure adds the provider configured client to the list resource.
+func (r *routerListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = utils.GetClientFromProviderData(req.ProviderData)
+}
+
+type routerListModel struct {
+	Labels types.Map `tfsdk:"labels"`
+}
+
+func (r *routerListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists routers, e.g., for generating import blocks with `terraform query`. Routers are not bound to a zone, so only labels can be used for filtering.",
+		Attributes: map[string]schema.Attribute{
+			"labels": utils.ListResourceLabelsAttribute("routers"),
+		},
+	}
+}
+
+func (r *routerListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data routerListModel
+	diags := utils.GetListResourceConfig(ctx, req.Config, &data)
+
+	labels, labelsDiags := utils.ListResourceLabelsFilter(ctx, data.Labels)
+	diags.Append(labelsDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	routers, err := r.client.GetRouters(ctx)
+	if err != nil {
+		diags.AddError(
+			"Unable to list routers",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, router := range routers.Routers {
+			if !utils.LabelsMatch(labels, router.Labels) {
+				continue
+			}
+
+			result := utils.NewListResult(ctx, req, routerIdentity, router.UUID, router.Name)
+			if req.IncludeResource {
+				result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("name"), router.Name)...)
+				result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("labels"), utils.LabelsSliceToMap(router.Labels))...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
 	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
@@ -53,6 +54,7 @@ var (
 	_ resource.Resource                 = &serverResource{}
 	_ resource.ResourceWithConfigure    = &serverResource{}
 	_ resource.ResourceWithImportState  = &serverResource{}
+	_ resource.ResourceWithIdentity     = &serverResource{}
 	_ resource.ResourceWithModifyPlan   = &serverResource{}
 	_ resource.ResourceWithUpgradeState = &serverResource{}
 )
@@ -75,30 +77,34 @@ func (r *serverResource) Configure(_ context.Context, req resource.ConfigureRequ
 }
 
 type serverModel struct {
-	ID                types.String `tfsdk:"id"`
-	Hostname          types.String `tfsdk:"hostname"`
-	Title             types.String `tfsdk:"title"`
-	Zone              types.String `tfsdk:"zone"`
-	ServerGroup       types.String `tfsdk:"server_group"`
-	Firewall          types.Bool   `tfsdk:"firewall"`
-	Metadata          types.Bool   `tfsdk:"metadata"`
-	CPU               types.Int64  `tfsdk:"cpu"`
-	Mem               types.Int64  `tfsdk:"mem"`
-	Timezone          types.String `tfsdk:"timezone"`
-	VideoModel        types.String `tfsdk:"video_model"`
-	NICModel          types.String `tfsdk:"nic_model"`
-	Tags              types.Set    `tfsdk:"tags"`
-	Host              types.Int64  `tfsdk:"host"`
-	NetworkInterfaces types.List   `tfsdk:"network_interface"`
-	Labels            types.Map    `tfsdk:"labels"`
-	UserData          types.String `tfsdk:"user_data"`
-	Plan              types.String `tfsdk:"plan"`
-	StorageDevices    types.Set    `tfsdk:"storage_devices"`
-	Template          types.List   `tfsdk:"template"`
-	Login             types.List   `tfsdk:"login"`
-	SimpleBackup      types.Set    `tfsdk:"simple_backup"`
-	BootOrder         types.String `tfsdk:"boot_order"`
-	HotResize         types.Bool   `tfsdk:"hot_resize"`
+	ID                types.String   `tfsdk:"id"`
+	Hostname          types.String   `tfsdk:"hostname"`
+	Title             types.String   `tfsdk:"title"`
+	Zone              types.String   `tfsdk:"zone"`
+	ServerGroup       types.String   `tfsdk:"server_group"`
+	Firewall          types.Bool     `tfsdk:"firewall"`
+	Metadata          types.Bool     `tfsdk:"metadata"`
+	CPU               types.Int64    `tfsdk:"cpu"`
+	Mem               types.Int64    `tfsdk:"mem"`
+	Timezone          types.String   `tfsdk:"timezone"`
+	VideoModel        types.String   `tfsdk:"video_model"`
+	NICModel          types.String   `tfsdk:"nic_model"`
+	Tags              types.Set      `tfsdk:"tags"`
+	Host              types.Int64    `tfsdk:"host"`
+	NetworkInterfaces types.List     `tfsdk:"network_interface"`
+	Labels            types.Map      `tfsdk:"labels"`
+	UserData          types.String   `tfsdk:"user_data"`
+	Plan              types.String   `tfsdk:"plan"`
+	StorageDevices    types.Set      `tfsdk:"storage_devices"`
+	Template          types.List     `tfsdk:"template"`
+	Login             types.List     `tfsdk:"login"`
+	SimpleBackup      types.Set      `tfsdk:"simple_backup"`
+	BootOrder         types.String   `tfsdk:"boot_order"`
+	HotResize         types.Bool     `tfsdk:"hot_resize"`
+	PowerState        types.String   `tfsdk:"power_state"`
+	StopType          types.String   `tfsdk:"stop_type"`
+	StopTimeout       types.String   `tfsdk:"stop_timeout"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
 }
 
 type networkInterfaceModel struct {
@@ -162,7 +168,7 @@ type simpleBackupModel struct {
 	Time types.String `tfsdk:"time"`
 }
 
-func (r *serverResource) getSchema(version int64) schema.Schema {
+func (r *serverResource) getSchema(ctx context.Context, version int64) schema.Schema {
 	return schema.Schema{
 		Version:             version,
 		MarkdownDescription: serverDescription,
@@ -317,8 +323,42 @@ func (r *serverResource) getSchema(version int64) schema.Schema {
 				Computed:    true,
 				Default:     booldefault.StaticBool(false),
 			},
+			"power_state": schema.StringAttribute{
+				MarkdownDescription: "The desired power state of the server, `started` or `stopped`. Set to `stopped` to keep the server provisioned but powered off. Note that a new server is started once before it is stopped, and that changes requiring the server to be stopped do not start a stopped server.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(upcloud.ServerStateStarted),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						upcloud.ServerStateStarted,
+						upcloud.ServerStateStopped,
+					),
+				},
+			},
+			"stop_type": schema.StringAttribute{
+				MarkdownDescription: "The method used to stop the server when `power_state` is changed to `stopped` or when the server needs to be stopped to apply changes, e.g. to change the plan or network interfaces. `soft` requests the operating system to shut down and stops the server forcibly after `stop_timeout`. `hard` stops the server immediately.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(upcloud.StopTypeSoft),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						upcloud.StopTypeSoft,
+						upcloud.StopTypeHard,
+					),
+				},
+			},
+			"stop_timeout": schema.StringAttribute{
+				MarkdownDescription: "How long to wait for the server to shut down after a `soft` stop before stopping it forcibly, e.g. `2m` or `10m`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(defaultStopTimeout),
+				Validators: []validator.String{
+					validatorutil.Duration(),
+				},
+			},
 		},
 		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
 			"network_interface": schema.ListNestedBlock{
 				Description: `One or more blocks describing the network interfaces of the server.
 
@@ -605,13 +645,13 @@ func (r *serverResource) getSchema(version int64) schema.Schema {
 	}
 }
 
-func (r *serverResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = r.getSchema(2)
+func (r *serverResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = r.getSchema(ctx, 2)
 }
 
-func (r *serverResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
-	schemaV0 := r.getSchema(0)
-	schemaV1 := r.getSchema(1)
+func (r *serverResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	schemaV0 := r.getSchema(ctx, 0)
+	schemaV1 := r.getSchema(ctx, 1)
 	return map[int64]resource.StateUpgrader{
 		// Add index value to network interfaces.
 		0: {
@@ -906,6 +946,19 @@ func setValues(ctx context.Context, data *serverModel, server *upcloud.ServerDet
 		data.HotResize = types.BoolValue(false)
 	}
 
+	// Only track stable power states, server might be e.g. in maintenance state temporarily.
+	if server.State == upcloud.ServerStateStarted || server.State == upcloud.ServerStateStopped {
+		data.PowerState = types.StringValue(server.State)
+	} else if data.PowerState.IsNull() {
+		data.PowerState = types.StringValue(upcloud.ServerStateStarted)
+	}
+	if data.StopType.IsNull() {
+		data.StopType = types.StringValue(upcloud.StopTypeSoft)
+	}
+	if data.StopTimeout.IsNull() {
+		data.StopTimeout = types.StringValue(defaultStopTimeout)
+	}
+
 	if !data.Tags.IsNull() {
 		data.Tags, diags = types.SetValueFrom(ctx, data.Tags.ElementType(ctx), server.Tags)
 		respDiagnostics.Append(diags...)
@@ -1030,6 +1083,13 @@ func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx, cancel, diags := utils.TimeoutContext(ctx, data.Timeouts, utils.TimeoutCreate)
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var labels map[string]string
 	if !data.Labels.IsNull() && !data.Labels.IsUnknown() {
 		resp.Diagnostics.Append(data.Labels.ElementsAs(ctx, &labels, false)...)
@@ -1221,13 +1281,22 @@ func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest,
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error while waiting for server to be in started state",
-			utils.ErrorDiagnosticDetail(err),
+			utils.WaitErrorDiagnosticDetail(ctx, err),
 		)
 		return
 	}
 
+	if data.PowerState.ValueString() == upcloud.ServerStateStopped {
+		server, err = stopServer(ctx, r.client, data)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to stop server", utils.WaitErrorDiagnosticDetail(ctx, err))
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(setValues(ctx, &data, server)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, serverIdentity, data.ID.ValueString())...)
 }
 
 func (r *serverResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -1261,6 +1330,7 @@ func (r *serverResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	resp.Diagnostics.Append(setValues(ctx, &data, server)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, serverIdentity, data.ID.ValueString())...)
 }
 
 func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -1269,6 +1339,17 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := utils.TimeoutContext(ctx, plan.Timeouts, utils.TimeoutUpdate)
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	uuid := plan.ID.ValueString()
 	serverDetails, err := r.client.GetServerDetails(ctx, &request.GetServerDetailsRequest{
 		UUID: uuid,
@@ -1294,14 +1375,12 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	// Server stop is required for certain changes - this takes precedence over hot resize
 	if needsServerStop {
-		err := utils.VerifyServerStopped(ctx, request.StopServerRequest{
-			UUID: uuid,
-		}, r.client)
+		err := utils.VerifyServerStopped(ctx, buildStopServerRequest(plan), r.client)
 		if err != nil {
-			resp.Diagnostics.AddError("Unable to stop server", utils.ErrorDiagnosticDetail(err))
+			resp.Diagnostics.AddError("Unable to stop server", utils.WaitErrorDiagnosticDetail(ctx, err))
 			return
 		}
-	} else if planChanged && hotResizeEnabled {
+	} else if planChanged && hotResizeEnabled && serverDetails.State == upcloud.ServerStateStarted {
 		// Only attempt hot resize if no server stop is required
 		hotResizeReq := &request.ModifyServerRequest{
 			UUID: uuid,
@@ -1490,7 +1569,7 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 				UUID:         templateState.ID.ValueString(),
 				DesiredState: upcloud.StorageStateOnline,
 			}); err != nil {
-				resp.Diagnostics.AddError("Unable to wait for storage to reach online state after detach", utils.ErrorDiagnosticDetail(err))
+				resp.Diagnostics.AddError("Unable to wait for storage to reach online state after detach", utils.WaitErrorDiagnosticDetail(ctx, err))
 				return
 			}
 
@@ -1531,7 +1610,7 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 				UUID:         serverStorageDevice.UUID,
 				DesiredState: upcloud.StorageStateOnline,
 			}); err != nil {
-				resp.Diagnostics.AddError("Unable to wait for storage to reach online state after detach", utils.ErrorDiagnosticDetail(err))
+				resp.Diagnostics.AddError("Unable to wait for storage to reach online state after detach", utils.WaitErrorDiagnosticDetail(ctx, err))
 				return
 			}
 
@@ -1556,7 +1635,7 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 				UUID:         storageDevice.Storage.ValueString(),
 				DesiredState: upcloud.StorageStateOnline,
 			}); err != nil {
-				resp.Diagnostics.AddError("Unable to wait for storage to reach online state before attach", utils.ErrorDiagnosticDetail(err))
+				resp.Diagnostics.AddError("Unable to wait for storage to reach online state before attach", utils.WaitErrorDiagnosticDetail(ctx, err))
 				return
 			}
 
@@ -1582,10 +1661,19 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 	}
 
-	server, err := utils.VerifyServerStarted(ctx, request.StartServerRequest{UUID: uuid, HostID: config.Host.ValueInt64()}, r.client)
-	if err != nil {
-		resp.Diagnostics.AddError("Unable to start server", utils.ErrorDiagnosticDetail(err))
-		return
+	var server *upcloud.ServerDetails
+	if plan.PowerState.ValueString() == upcloud.ServerStateStopped {
+		server, err = stopServer(ctx, r.client, plan)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to stop server", utils.WaitErrorDiagnosticDetail(ctx, err))
+			return
+		}
+	} else {
+		server, err = utils.VerifyServerStarted(ctx, request.StartServerRequest{UUID: uuid, HostID: config.Host.ValueInt64()}, r.client)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to start server", utils.WaitErrorDiagnosticDetail(ctx, err))
+			return
+		}
 	}
 
 	resp.Diagnostics.Append(setValues(ctx, &plan, server)...)
@@ -1628,9 +1716,20 @@ func (r *serverResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	var data serverModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := utils.TimeoutContext(ctx, data.Timeouts, utils.TimeoutDelete)
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	uuid := data.ID.ValueString()
-	if err := utils.VerifyServerStopped(ctx, request.StopServerRequest{UUID: uuid}, r.client); err != nil {
-		resp.Diagnostics.AddError("Unable to stop server", utils.ErrorDiagnosticDetail(err))
+	if err := utils.VerifyServerStopped(ctx, buildStopServerRequest(data), r.client); err != nil {
+		resp.Diagnostics.AddError("Unable to stop server", utils.WaitErrorDiagnosticDetail(ctx, err))
 	}
 
 	var tags []string
@@ -1662,6 +1761,12 @@ func (r *serverResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+var serverIdentity = utils.UUIDIdentity("server")
+
+func (r *serverResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(serverIdentity)
+}
+
 func (r *serverResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, serverIdentity, req, resp)
 }
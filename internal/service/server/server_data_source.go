@@ -0,0 +1,432 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewServerDataSource() datasource.DataSource {
+	return &serverDataSource{}
+}
+
+var (
+	_ datasource.DataSource                     = &serverDataSource{}
+	_ datasource.DataSourceWithConfigure        = &serverDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &serverDataSource{}
+)
+
+type serverDataSource struct {
+	client *service.Service
+}
+
+func (d *serverDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server"
+}
+
+func (d *serverDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, resp.Diagnostics = utils.GetClientFromProviderData(req.ProviderData)
+}
+
+type serverDataModel struct {
+	ID                types.String `tfsdk:"id"`
+	Hostname          types.String `tfsdk:"hostname"`
+	Title             types.String `tfsdk:"title"`
+	Zone              types.String `tfsdk:"zone"`
+	Plan              types.String `tfsdk:"plan"`
+	CPU               types.Int64  `tfsdk:"cpu"`
+	Mem               types.Int64  `tfsdk:"mem"`
+	Host              types.Int64  `tfsdk:"host"`
+	PowerState        types.String `tfsdk:"power_state"`
+	Tags              types.Set    `tfsdk:"tags"`
+	Labels            types.Map    `tfsdk:"labels"`
+	NetworkInterfaces types.List   `tfsdk:"network_interfaces"`
+	StorageDevices    types.List   `tfsdk:"storage_devices"`
+}
+
+type serverDataNetworkInterfaceModel struct {
+	Index             types.Int64  `tfsdk:"index"`
+	Type              types.String `tfsdk:"type"`
+	Network           types.String `tfsdk:"network"`
+	MACAddress        types.String `tfsdk:"mac_address"`
+	IPAddresses       types.List   `tfsdk:"ip_addresses"`
+	SourceIPFiltering types.Bool   `tfsdk:"source_ip_filtering"`
+	Bootable          types.Bool   `tfsdk:"bootable"`
+}
+
+type serverDataIPAddressModel struct {
+	Address  types.String `tfsdk:"address"`
+	Family   types.String `tfsdk:"family"`
+	Floating types.Bool   `tfsdk:"floating"`
+}
+
+type serverDataStorageDeviceModel struct {
+	Storage         types.String `tfsdk:"storage"`
+	Title           types.String `tfsdk:"title"`
+	Type            types.String `tfsdk:"type"`
+	Address         types.String `tfsdk:"address"`
+	AddressPosition types.String `tfsdk:"address_position"`
+	Size            types.Int64  `tfsdk:"size"`
+	Tier            types.String `tfsdk:"tier"`
+	Encrypt         types.Bool   `tfsdk:"encrypt"`
+}
+
+var serverDataIPAddressTypes = map[string]attr.Type{
+	"address":  types.StringType,
+	"family":   types.StringType,
+	"floating": types.BoolType,
+}
+
+var serverDataNetworkInterfaceTypes = map[string]attr.Type{
+	"index":               types.Int64Type,
+	"type":                types.StringType,
+	"network":             types.StringType,
+	"mac_address":         types.StringType,
+	"ip_addresses":        types.ListType{ElemType: types.ObjectType{AttrTypes: serverDataIPAddressTypes}},
+	"source_ip_filtering": types.BoolType,
+	"bootable":            types.BoolType,
+}
+
+var serverDataStorageDeviceTypes = map[string]attr.Type{
+	"storage":          types.StringType,
+	"title":            types.StringType,
+	"type":             types.StringType,
+	"address":          types.StringType,
+	"address_position": types.StringType,
+	"size":             types.Int64Type,
+	"tier":             types.StringType,
+	"encrypt":          types.BoolType,
+}
+
+var serverDataTypes = map[string]attr.Type{
+	"id":                 types.StringType,
+	"hostname":           types.StringType,
+	"title":              types.StringType,
+	"zone":               types.StringType,
+	"plan":               types.StringType,
+	"cpu":                types.Int64Type,
+	"mem":                types.Int64Type,
+	"host":               types.Int64Type,
+	"power_state":        types.StringType,
+	"tags":               types.SetType{ElemType: types.StringType},
+	"labels":             types.MapType{ElemType: types.StringType},
+	"network_interfaces": types.ListType{ElemType: types.ObjectType{AttrTypes: serverDataNetworkInterfaceTypes}},
+	"storage_devices":    types.ListType{ElemType: types.ObjectType{AttrTypes: serverDataStorageDeviceTypes}},
+}
+
+// serverDataAttributes returns the attributes describing a server in `upcloud_server` and `upcloud_servers` data sources.
+func serverDataAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "UUID of the server.",
+			Computed:            true,
+		},
+		"hostname": schema.StringAttribute{
+			MarkdownDescription: "The hostname of the server.",
+			Computed:            true,
+		},
+		"title": schema.StringAttribute{
+			MarkdownDescription: "A short, informational description of the server.",
+			Computed:            true,
+		},
+		"zone": schema.StringAttribute{
+			MarkdownDescription: "The zone in which the server is hosted, e.g. `de-fra1`.",
+			Computed:            true,
+		},
+		"plan": schema.StringAttribute{
+			MarkdownDescription: "The pricing plan used for the server.",
+			Computed:            true,
+		},
+		"cpu": schema.Int64Attribute{
+			MarkdownDescription: "The number of CPU cores of the server.",
+			Computed:            true,
+		},
+		"mem": schema.Int64Attribute{
+			MarkdownDescription: "The amount of memory of the server in megabytes.",
+			Computed:            true,
+		},
+		"host": schema.Int64Attribute{
+			MarkdownDescription: "The ID of the host on which the server is running, if the server is deployed on a private cloud.",
+			Computed:            true,
+		},
+		"power_state": schema.StringAttribute{
+			MarkdownDescription: "The current state of the server, e.g. `started`, `stopped`, `maintenance`, or `error`.",
+			Computed:            true,
+		},
+		"tags": schema.SetAttribute{
+			MarkdownDescription: "The server related tags.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"labels": schema.MapAttribute{
+			MarkdownDescription: "User defined key-value pairs to classify the server.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"network_interfaces": schema.ListNestedAttribute{
+			MarkdownDescription: "Network interfaces attached to the server.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"index": schema.Int64Attribute{
+						MarkdownDescription: "The interface index.",
+						Computed:            true,
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Network interface type, e.g. `public`, `utility`, or `private`.",
+						Computed:            true,
+					},
+					"network": schema.StringAttribute{
+						MarkdownDescription: "The UUID of the network the interface is attached to.",
+						Computed:            true,
+					},
+					"mac_address": schema.StringAttribute{
+						MarkdownDescription: "The MAC address of the interface.",
+						Computed:            true,
+					},
+					"ip_addresses": schema.ListNestedAttribute{
+						MarkdownDescription: "IP addresses assigned to the interface.",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"address": schema.StringAttribute{
+									MarkdownDescription: "The IP address.",
+									Computed:            true,
+								},
+								"family": schema.StringAttribute{
+									MarkdownDescription: "The IP address family, `IPv4` or `IPv6`.",
+									Computed:            true,
+								},
+								"floating": schema.BoolAttribute{
+									MarkdownDescription: "`true` if the address is a floating IP address.",
+									Computed:            true,
+								},
+							},
+						},
+					},
+					"source_ip_filtering": schema.BoolAttribute{
+						MarkdownDescription: "`true` if source IP filtering is enabled on the interface.",
+						Computed:            true,
+					},
+					"bootable": schema.BoolAttribute{
+						MarkdownDescription: "`true` if the interface is used as a boot device.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+		"storage_devices": schema.ListNestedAttribute{
+			MarkdownDescription: "Storage devices attached to the server.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"storage": schema.StringAttribute{
+						MarkdownDescription: "The UUID of the storage.",
+						Computed:            true,
+					},
+					"title": schema.StringAttribute{
+						MarkdownDescription: "The title of the storage.",
+						Computed:            true,
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The device type, `disk` or `cdrom`.",
+						Computed:            true,
+					},
+					"address": schema.StringAttribute{
+						MarkdownDescription: "The device address, e.g. `virtio`, `scsi`, or `ide`.",
+						Computed:            true,
+					},
+					"address_position": schema.StringAttribute{
+						MarkdownDescription: "The device position in the given bus, e.g. `0:0`.",
+						Computed:            true,
+					},
+					"size": schema.Int64Attribute{
+						MarkdownDescription: "The size of the storage in gigabytes.",
+						Computed:            true,
+					},
+					"tier": schema.StringAttribute{
+						MarkdownDescription: "The storage tier.",
+						Computed:            true,
+					},
+					"encrypt": schema.BoolAttribute{
+						MarkdownDescription: "`true` if the storage is encrypted at rest.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func setServerDataValues(ctx context.Context, data *serverDataModel, server *upcloud.ServerDetails) diag.Diagnostics {
+	var diags, respDiagnostics diag.Diagnostics
+
+	data.ID = types.StringValue(server.UUID)
+	data.Hostname = types.StringValue(server.Hostname)
+	data.Title = types.StringValue(server.Title)
+	data.Zone = types.StringValue(server.Zone)
+	data.Plan = types.StringValue(server.Plan)
+	data.CPU = types.Int64Value(int64(server.CoreNumber))
+	data.Mem = types.Int64Value(int64(server.MemoryAmount))
+	data.Host = types.Int64Value(server.HostID)
+	data.PowerState = types.StringValue(server.State)
+
+	data.Tags, diags = types.SetValueFrom(ctx, types.StringType, utils.NilAsEmptyList(server.Tags))
+	respDiagnostics.Append(diags...)
+
+	data.Labels, diags = types.MapValueFrom(ctx, types.StringType, utils.LabelsSliceToMap(server.Labels))
+	respDiagnostics.Append(diags...)
+
+	networkInterfaces := make([]serverDataNetworkInterfaceModel, 0)
+	for _, iface := range server.Networking.Interfaces {
+		ipAddresses := make([]serverDataIPAddressModel, 0)
+		for _, ip := range iface.IPAddresses {
+			ipAddresses = append(ipAddresses, serverDataIPAddressModel{
+				Address:  types.StringValue(ip.Address),
+				Family:   types.StringValue(ip.Family),
+				Floating: types.BoolValue(ip.Floating.Bool()),
+			})
+		}
+
+		ni := serverDataNetworkInterfaceModel{
+			Index:             types.Int64Value(int64(iface.Index)),
+			Type:              types.StringValue(iface.Type),
+			Network:           types.StringValue(iface.Network),
+			MACAddress:        types.StringValue(iface.MAC),
+			SourceIPFiltering: types.BoolValue(iface.SourceIPFiltering.Bool()),
+			Bootable:          types.BoolValue(iface.Bootable.Bool()),
+		}
+		ni.IPAddresses, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: serverDataIPAddressTypes}, ipAddresses)
+		respDiagnostics.Append(diags...)
+
+		networkInterfaces = append(networkInterfaces, ni)
+	}
+
+	data.NetworkInterfaces, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: serverDataNetworkInterfaceTypes}, networkInterfaces)
+	respDiagnostics.Append(diags...)
+
+	storageDevices := make([]serverDataStorageDeviceModel, 0)
+	for _, storage := range server.StorageDevices {
+		storageDevices = append(storageDevices, serverDataStorageDeviceModel{
+			Storage:         types.StringValue(storage.UUID),
+			Title:           types.StringValue(storage.Title),
+			Type:            types.StringValue(storage.Type),
+			Address:         types.StringValue(utils.StorageAddressFormat(storage.Address)),
+			AddressPosition: types.StringValue(utils.StorageAddressPositionFormat(storage.Address)),
+			Size:            types.Int64Value(int64(storage.Size)),
+			Tier:            types.StringValue(storage.Tier),
+			Encrypt:         types.BoolValue(storage.Encrypted.Bool()),
+		})
+	}
+
+	data.StorageDevices, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: serverDataStorageDeviceTypes}, storageDevices)
+	respDiagnostics.Append(diags...)
+
+	return respDiagnostics
+}
+
+func (d *serverDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := serverDataAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "UUID of the server. Either `id` or `hostname` must be set.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["hostname"] = schema.StringAttribute{
+		MarkdownDescription: "The hostname of the server. Either `id` or `hostname` must be set. The hostname must match exactly one server.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["zone"] = schema.StringAttribute{
+		MarkdownDescription: "The zone in which the server is hosted, e.g. `de-fra1`. Can be used to limit the hostname lookup to a single zone.",
+		Optional:            true,
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides details on a single [cloud server](https://upcloud.com/products/cloud-servers), including its network interfaces, IP addresses, and attached storages. This can be used to reference servers that are not managed in the current configuration.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *serverDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("hostname"),
+		),
+	}
+}
+
+func (d *serverDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data serverDataModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	uuid := data.ID.ValueString()
+	if uuid == "" {
+		servers, err := d.client.GetServers(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to read servers",
+				utils.ErrorDiagnosticDetail(err),
+			)
+			return
+		}
+
+		matches := make([]string, 0)
+		for _, server := range servers.Servers {
+			if server.Hostname != data.Hostname.ValueString() {
+				continue
+			}
+
+			if !data.Zone.IsNull() && server.Zone != data.Zone.ValueString() {
+				continue
+			}
+
+			matches = append(matches, server.UUID)
+		}
+
+		if len(matches) < 1 {
+			resp.Diagnostics.AddError("query returned no results", fmt.Sprintf("Could not find server with hostname %s.", data.Hostname.ValueString()))
+			return
+		}
+
+		if len(matches) > 1 {
+			resp.Diagnostics.AddError("query returned more than one result", fmt.Sprintf("Found %d servers with hostname %s. Use `id` or `zone` to select a single server.", len(matches), data.Hostname.ValueString()))
+			return
+		}
+
+		uuid = matches[0]
+	}
+
+	server, err := d.client.GetServerDetails(ctx, &request.GetServerDetailsRequest{UUID: uuid})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read server details",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	if !data.Zone.IsNull() && server.Zone != data.Zone.ValueString() {
+		resp.Diagnostics.AddError("query returned no results", fmt.Sprintf("Server %s is not in zone %s.", uuid, data.Zone.ValueString()))
+		return
+	}
+
+	resp.Diagnostics.Append(setServerDataValues(ctx, &data, server)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
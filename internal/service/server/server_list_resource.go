@@ -0,0 +1,100 @@
+package server
+
+import (
+	"context"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &serverListResource{}
+	_ list.ListResourceWithConfigure = &serverListResource{}
+)
+
+func NewServerListResource() list.ListResource {
+	return &serverListResource{}
+}
+
+type serverListResource struct {
+	client *service.Service
+}
+
+func (r *serverListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server"
+}
+
+// Configure adds the provider configured client to the list resource.
+func (r *serverListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = utils.GetClientFromProviderData(req.ProviderData)
+}
+
+type serverListModel struct {
+	Zone   types.String `tfsdk:"zone"`
+	Labels types.Map    `tfsdk:"labels"`
+}
+
+func (r *serverListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists cloud servers, e.g., for generating import blocks with `terraform query`.",
+		Attributes: map[string]schema.Attribute{
+			"zone":   utils.ListResourceZoneAttribute("servers"),
+			"labels": utils.ListResourceLabelsAttribute("servers"),
+		},
+	}
+}
+
+func (r *serverListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data serverListModel
+	diags := utils.GetListResourceConfig(ctx, req.Config, &data)
+
+	labels, labelsDiags := utils.ListResourceLabelsFilter(ctx, data.Labels)
+	diags.Append(labelsDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	filters := make([]request.QueryFilter, 0)
+	for k, v := range labels {
+		filters = append(filters, request.FilterLabel{Label: upcloud.Label{Key: k, Value: v}})
+	}
+
+	servers, err := r.client.GetServersWithFilters(ctx, &request.GetServersWithFiltersRequest{Filters: filters})
+	if err != nil {
+		diags.AddError(
+			"Unable to list servers",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, server := range servers.Servers {
+			if !data.Zone.IsNull() && server.Zone != data.Zone.ValueString() {
+				continue
+			}
+
+			result := utils.NewListResult(ctx, req, serverIdentity, server.UUID, server.Hostname)
+			if req.IncludeResource {
+				result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("hostname"), server.Hostname)...)
+				result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("title"), server.Title)...)
+				result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("zone"), server.Zone)...)
+				result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("plan"), server.Plan)...)
+				result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("labels"), utils.LabelsSliceToMap(server.Labels))...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,249 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/sync/errgroup"
+)
+
+// serverDetailsConcurrency limits the number of concurrent server details requests.
+const serverDetailsConcurrency = 8
+
+func NewServersDataSource() datasource.DataSource {
+	return &serversDataSource{}
+}
+
+var (
+	_ datasource.DataSource              = &serversDataSource{}
+	_ datasource.DataSourceWithConfigure = &serversDataSource{}
+)
+
+type serversDataSource struct {
+	client *service.Service
+}
+
+func (d *serversDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_servers"
+}
+
+func (d *serversDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, resp.Diagnostics = utils.GetClientFromProviderData(req.ProviderData)
+}
+
+type serversModel struct {
+	ID            types.String `tfsdk:"id"`
+	Zone          types.String `tfsdk:"zone"`
+	Labels        types.Map    `tfsdk:"labels"`
+	Tags          types.Set    `tfsdk:"tags"`
+	HostnameRegex types.String `tfsdk:"hostname_regex"`
+	Plan          types.String `tfsdk:"plan"`
+	PowerState    types.String `tfsdk:"power_state"`
+	Servers       types.List   `tfsdk:"servers"`
+}
+
+func (d *serversDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Returns a list of [cloud servers](https://upcloud.com/products/cloud-servers) matching the given filters, including their network interfaces, IP addresses, and attached storages. All configured filters must match for a server to be included.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "Only include servers in the given zone, e.g. `de-fra1`.",
+				Optional:            true,
+			},
+			"labels": schema.MapAttribute{
+				MarkdownDescription: "Only include servers that have all of the given labels with matching values.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Only include servers that have all of the given tags.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"hostname_regex": schema.StringAttribute{
+				MarkdownDescription: "Only include servers with hostname matching the given regular expression.",
+				Optional:            true,
+			},
+			"plan": schema.StringAttribute{
+				MarkdownDescription: "Only include servers using the given plan, e.g. `1xCPU-1GB`.",
+				Optional:            true,
+			},
+			"power_state": schema.StringAttribute{
+				MarkdownDescription: "Only include servers in the given state.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						upcloud.ServerStateStarted,
+						upcloud.ServerStateStopped,
+						upcloud.ServerStateMaintenance,
+						upcloud.ServerStateError,
+					),
+				},
+			},
+			"servers": schema.ListNestedAttribute{
+				MarkdownDescription: "Servers matching the given filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: serverDataAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func serverMatchesFilters(server upcloud.Server, data *serversModel, hostnameRegex *regexp.Regexp, labels map[string]string, tags []string) bool {
+	if !data.Zone.IsNull() && server.Zone != data.Zone.ValueString() {
+		return false
+	}
+
+	if !data.Plan.IsNull() && server.Plan != data.Plan.ValueString() {
+		return false
+	}
+
+	if !data.PowerState.IsNull() && server.State != data.PowerState.ValueString() {
+		return false
+	}
+
+	if hostnameRegex != nil && !hostnameRegex.MatchString(server.Hostname) {
+		return false
+	}
+
+	serverLabels := utils.LabelsSliceToMap(server.Labels)
+	for k, v := range labels {
+		if value, ok := serverLabels[k]; !ok || value != v {
+			return false
+		}
+	}
+
+	serverTags := sliceToMap(server.Tags)
+	for _, tag := range tags {
+		if !serverTags[tag] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (d *serversDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data serversModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var hostnameRegex *regexp.Regexp
+	if !data.HostnameRegex.IsNull() {
+		var err error
+		hostnameRegex, err = regexp.Compile(data.HostnameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Could not compile hostname_regex",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	labels := make(map[string]string)
+	if !data.Labels.IsNull() {
+		resp.Diagnostics.Append(data.Labels.ElementsAs(ctx, &labels, false)...)
+	}
+
+	tags := make([]string, 0)
+	if !data.Tags.IsNull() {
+		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Labels and tags are filtered by the API, other filters are applied to the server list before fetching server details.
+	filters := make([]request.QueryFilter, 0)
+	for k, v := range labels {
+		filters = append(filters, request.FilterLabel{Label: upcloud.Label{Key: k, Value: v}})
+	}
+	if len(tags) > 0 {
+		filters = append(filters, request.FilterTags{Tags: tags})
+	}
+
+	servers, err := d.client.GetServersWithFilters(ctx, &request.GetServersWithFiltersRequest{Filters: filters})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read servers",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	matches := make([]upcloud.Server, 0)
+	for _, server := range servers.Servers {
+		if serverMatchesFilters(server, &data, hostnameRegex, labels, tags) {
+			matches = append(matches, server)
+		}
+	}
+
+	details := make([]*upcloud.ServerDetails, len(matches))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(serverDetailsConcurrency)
+	for i, server := range matches {
+		g.Go(func() error {
+			serverDetails, err := d.client.GetServerDetails(gctx, &request.GetServerDetailsRequest{UUID: server.UUID})
+			if err != nil {
+				if utils.IsNotFoundError(err) {
+					// Server was deleted after listing the servers.
+					return nil
+				}
+				return fmt.Errorf("server %s: %w", server.UUID, err)
+			}
+			details[i] = serverDetails
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read server details",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		return
+	}
+
+	results := make([]serverDataModel, 0)
+	for _, serverDetails := range details {
+		if serverDetails == nil {
+			continue
+		}
+
+		var result serverDataModel
+		resp.Diagnostics.Append(setServerDataValues(ctx, &result, serverDetails)...)
+		results = append(results, result)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var diags diag.Diagnostics
+	data.Servers, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: serverDataTypes}, results)
+	resp.Diagnostics.Append(diags...)
+
+	data.ID = types.StringValue(time.Now().UTC().String())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
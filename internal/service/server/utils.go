@@ -3,7 +3,9 @@ package server
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
@@ -13,6 +15,9 @@ import (
 const (
 	// CustomPlanName is the name of the custom server plan
 	customPlanName = "custom"
+
+	// defaultStopTimeout is the default time to wait for soft stop before the server is stopped forcibly
+	defaultStopTimeout = "2m"
 )
 
 func isProviderAccountSubaccount(ctx context.Context, s *service.Service) (bool, error) {
@@ -73,6 +78,28 @@ func sliceToMap(input []string) map[string]bool {
 	return output
 }
 
+func buildStopServerRequest(data serverModel) request.StopServerRequest {
+	req := request.StopServerRequest{
+		UUID:     data.ID.ValueString(),
+		StopType: data.StopType.ValueString(),
+	}
+
+	// VerifyServerStopped uses default timeout, if timeout is not set or it is invalid.
+	if timeout, err := time.ParseDuration(data.StopTimeout.ValueString()); err == nil {
+		req.Timeout = timeout
+	}
+
+	return req
+}
+
+func stopServer(ctx context.Context, svc *service.Service, data serverModel) (*upcloud.ServerDetails, error) {
+	if err := utils.VerifyServerStopped(ctx, buildStopServerRequest(data), svc); err != nil {
+		return nil, err
+	}
+
+	return svc.GetServerDetails(ctx, &request.GetServerDetailsRequest{UUID: data.ID.ValueString()})
+}
+
 func changeRequiresServerStop(state, plan serverModel, stateDevices, planDevices []storageDeviceModel) bool {
 	// Only allow hot resize if it's enabled in the plan and not changing (i.e., it was also enabled in the state)
 	if plan.HotResize.ValueBool() && state.HotResize.ValueBool() &&
@@ -9,7 +9,6 @@ import (
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -45,6 +44,7 @@ var (
 	_ resource.Resource                = &serverGroupResource{}
 	_ resource.ResourceWithConfigure   = &serverGroupResource{}
 	_ resource.ResourceWithImportState = &serverGroupResource{}
+	_ resource.ResourceWithIdentity    = &serverGroupResource{}
 )
 
 func NewServerGroupResource() resource.Resource {
@@ -178,6 +178,7 @@ func (r *serverGroupResource) Create(ctx context.Context, req resource.CreateReq
 
 	resp.Diagnostics.Append(setValues(ctx, &data, serverGroup)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, serverGroupIdentity, data.ID.ValueString())...)
 }
 
 func (r *serverGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -211,6 +212,7 @@ func (r *serverGroupResource) Read(ctx context.Context, req resource.ReadRequest
 
 	resp.Diagnostics.Append(setValues(ctx, &data, serverGroup)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, serverGroupIdentity, data.ID.ValueString())...)
 }
 
 func (r *serverGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -261,6 +263,12 @@ func (r *serverGroupResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 }
 
+var serverGroupIdentity = utils.UUIDIdentity("server group")
+
+func (r *serverGroupResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(serverGroupIdentity)
+}
+
 func (r *serverGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, serverGroupIdentity, req, resp)
 }
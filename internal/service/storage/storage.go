@@ -30,6 +30,7 @@ var (
 	_ resource.Resource                = &storageResource{}
 	_ resource.ResourceWithConfigure   = &storageResource{}
 	_ resource.ResourceWithImportState = &storageResource{}
+	_ resource.ResourceWithIdentity    = &storageResource{}
 )
 
 func NewStorageResource() resource.Resource {
@@ -393,6 +394,7 @@ func (r *storageResource) Create(ctx context.Context, req resource.CreateRequest
 		resp.Diagnostics.Append(diags...)
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, storageIdentity, data.ID.ValueString())...)
 }
 
 func (r *storageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -425,6 +427,7 @@ func (r *storageResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	resp.Diagnostics.Append(setStorageValues(ctx, &data, storage)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, storageIdentity, data.ID.ValueString())...)
 }
 
 func (r *storageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -566,6 +569,12 @@ func (r *storageResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 }
 
+var storageIdentity = utils.UUIDIdentity("storage")
+
+func (r *storageResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(storageIdentity)
+}
+
 func (r *storageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, storageIdentity, req, resp)
 }
@@ -8,7 +8,6 @@ import (
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -21,6 +20,7 @@ var (
 	_ resource.Resource                = &storageBackupResource{}
 	_ resource.ResourceWithConfigure   = &storageBackupResource{}
 	_ resource.ResourceWithImportState = &storageBackupResource{}
+	_ resource.ResourceWithIdentity    = &storageBackupResource{}
 )
 
 type storageBackupResource struct {
@@ -167,6 +167,7 @@ func (r *storageBackupResource) Create(ctx context.Context, req resource.CreateR
 	data.CreatedAt = types.StringValue(backupDetails.Created.Format(time.RFC3339))
 	resp.Diagnostics.Append(setCommonValues(ctx, &data.storageCommonModel, &backupDetails.Storage)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, storageBackupIdentity, data.ID.ValueString())...)
 }
 
 func (r *storageBackupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -202,6 +203,7 @@ func (r *storageBackupResource) Read(ctx context.Context, req resource.ReadReque
 	resp.Diagnostics.Append(setCommonValues(ctx, &data.storageCommonModel, &backupDetails.Storage)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, storageBackupIdentity, data.ID.ValueString())...)
 }
 
 func (r *storageBackupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -274,10 +276,16 @@ func (r *storageBackupResource) Delete(ctx context.Context, req resource.DeleteR
 	resp.State.RemoveResource(ctx)
 }
 
+var storageBackupIdentity = utils.UUIDIdentity("backup")
+
+func (r *storageBackupResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(storageBackupIdentity)
+}
+
 func (r *storageBackupResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, storageBackupIdentity, req, resp)
 }
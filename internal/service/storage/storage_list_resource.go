@@ -0,0 +1,103 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &storageListResource{}
+	_ list.ListResourceWithConfigure = &storageListResource{}
+)
+
+func NewStorageListResource() list.ListResource {
+	return &storageListResource{}
+}
+
+type storageListResource struct {
+	client *service.Service
+}
+
+func (r *storageListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage"
+}
+
+// Configure adds the provider configured client to the list resource.
+func (r *storageListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = utils.GetClientFromProviderData(req.ProviderData)
+}
+
+type storageListModel struct {
+	Zone   types.String `tfsdk:"zone"`
+	Labels types.Map    `tfsdk:"labels"`
+}
+
+func (r *storageListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists private block storage devices, e.g., for generating import blocks with `terraform query`. Templates, backups and CD-ROMs are not included.",
+		Attributes: map[string]schema.Attribute{
+			"zone":   utils.ListResourceZoneAttribute("storages"),
+			"labels": utils.ListResourceLabelsAttribute("storages"),
+		},
+	}
+}
+
+func (r *storageListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data storageListModel
+	diags := utils.GetListResourceConfig(ctx, req.Config, &data)
+
+	labels, labelsDiags := utils.ListResourceLabelsFilter(ctx, data.Labels)
+	diags.Append(labelsDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	storages, err := r.client.GetStorages(ctx, &request.GetStoragesRequest{Type: upcloud.StorageTypeNormal})
+	if err != nil {
+		diags.AddError(
+			"Unable to list storages",
+			utils.ErrorDiagnosticDetail(err),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, storage := range storages.Storages {
+			if storage.Access != upcloud.StorageAccessPrivate {
+				continue
+			}
+
+			if !data.Zone.IsNull() && storage.Zone != data.Zone.ValueString() {
+				continue
+			}
+
+			if !utils.LabelsMatch(labels, storage.Labels) {
+				continue
+			}
+
+			result := utils.NewListResult(ctx, req, storageIdentity, storage.UUID, storage.Title)
+			if req.IncludeResource {
+				result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("title"), storage.Title)...)
+				result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("zone"), storage.Zone)...)
+				result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("size"), int64(storage.Size))...)
+				result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("tier"), storage.Tier)...)
+				result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("labels"), utils.LabelsSliceToMap(storage.Labels))...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/service"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -23,6 +22,7 @@ var (
 	_ resource.Resource                = &storageTemplateResource{}
 	_ resource.ResourceWithConfigure   = &storageTemplateResource{}
 	_ resource.ResourceWithImportState = &storageTemplateResource{}
+	_ resource.ResourceWithIdentity    = &storageTemplateResource{}
 )
 
 func NewStorageTemplateResource() resource.Resource {
@@ -145,6 +145,7 @@ func (r *storageTemplateResource) Create(ctx context.Context, req resource.Creat
 
 	resp.Diagnostics.Append(setCommonValues(ctx, &data.storageCommonModel, &storage.Storage)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, templateIdentity, data.ID.ValueString())...)
 }
 
 func (r *storageTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -177,6 +178,7 @@ func (r *storageTemplateResource) Read(ctx context.Context, req resource.ReadReq
 
 	resp.Diagnostics.Append(setCommonValues(ctx, &data.storageCommonModel, &storage.Storage)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, templateIdentity, data.ID.ValueString())...)
 }
 
 func (r *storageTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -252,6 +254,12 @@ func (r *storageTemplateResource) Delete(ctx context.Context, req resource.Delet
 	}
 }
 
+var templateIdentity = utils.UUIDIdentity("template")
+
+func (r *storageTemplateResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IdentitySchema(templateIdentity)
+}
+
 func (r *storageTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithIdentity(ctx, templateIdentity, req, resp)
 }
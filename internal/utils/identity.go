@@ -0,0 +1,148 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	sdkv2_schema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// IdentityAttribute describes a component of a resource identity. The resource ID is built by joining the identity attributes with MarshalID in the order they are defined.
+type IdentityAttribute struct {
+	Name        string
+	Description string
+}
+
+// UUIDIdentity returns identity attributes for resources identified only by their UUID.
+func UUIDIdentity(resourceName string) []IdentityAttribute {
+	return []IdentityAttribute{
+		{Name: "id", Description: fmt.Sprintf("UUID of the %s.", resourceName)},
+	}
+}
+
+func IdentitySchema(attributes []IdentityAttribute) identityschema.Schema {
+	s := identityschema.Schema{
+		Attributes: make(map[string]identityschema.Attribute),
+	}
+	for _, attribute := range attributes {
+		s.Attributes[attribute.Name] = identityschema.StringAttribute{
+			Description:       attribute.Description,
+			RequiredForImport: true,
+		}
+	}
+	return s
+}
+
+// ImportStateWithIdentity sets the resource ID from either the import ID or from the identity attributes, when importing with an identity (Terraform 1.12 and later).
+func ImportStateWithIdentity(ctx context.Context, attributes []IdentityAttribute, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != "" || req.Identity == nil {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	components := make([]string, len(attributes))
+	for i, attribute := range attributes {
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root(attribute.Name), &components[i])...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), MarshalID(components...))...)
+}
+
+// SetIdentity sets the identity attributes from the resource ID.
+func SetIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, attributes []IdentityAttribute, id string) (diags diag.Diagnostics) {
+	if identity == nil || id == "" {
+		return
+	}
+
+	components := make([]string, len(attributes))
+	pointers := make([]*string, len(attributes))
+	for i := range components {
+		pointers[i] = &components[i]
+	}
+
+	diags.Append(UnmarshalIDDiag(id, pointers...)...)
+	if diags.HasError() {
+		return
+	}
+
+	for i, attribute := range attributes {
+		diags.Append(identity.SetAttribute(ctx, path.Root(attribute.Name), components[i])...)
+	}
+	return
+}
+
+// SDKv2IdentitySchema returns the identity schema for SDKv2 resources.
+func SDKv2IdentitySchema(attributes []IdentityAttribute) *sdkv2_schema.ResourceIdentity {
+	return &sdkv2_schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*sdkv2_schema.Schema {
+			s := make(map[string]*sdkv2_schema.Schema)
+			for _, attribute := range attributes {
+				s[attribute.Name] = &sdkv2_schema.Schema{
+					Type:              sdkv2_schema.TypeString,
+					Description:       attribute.Description,
+					RequiredForImport: true,
+				}
+			}
+			return s
+		},
+	}
+}
+
+// SDKv2ImportStateWithIdentity returns an SDKv2 importer function that sets the resource ID from either the import ID or from the identity attributes.
+func SDKv2ImportStateWithIdentity(attributes []IdentityAttribute) sdkv2_schema.StateContextFunc {
+	return func(_ context.Context, d *sdkv2_schema.ResourceData, _ interface{}) ([]*sdkv2_schema.ResourceData, error) {
+		if d.Id() != "" {
+			return []*sdkv2_schema.ResourceData{d}, nil
+		}
+
+		identity, err := d.Identity()
+		if err != nil {
+			return nil, err
+		}
+
+		components := make([]string, len(attributes))
+		for i, attribute := range attributes {
+			value, ok := identity.GetOk(attribute.Name)
+			if !ok {
+				return nil, fmt.Errorf("expected identity to contain %s", attribute.Name)
+			}
+			components[i] = value.(string)
+		}
+
+		d.SetId(MarshalID(components...))
+		return []*sdkv2_schema.ResourceData{d}, nil
+	}
+}
+
+// SetSDKv2Identity sets the identity attributes of an SDKv2 resource from the resource ID.
+func SetSDKv2Identity(d *sdkv2_schema.ResourceData, attributes []IdentityAttribute) error {
+	identity, err := d.Identity()
+	if err != nil {
+		return err
+	}
+
+	components := make([]string, len(attributes))
+	pointers := make([]*string, len(attributes))
+	for i := range components {
+		pointers[i] = &components[i]
+	}
+
+	if err := UnmarshalID(d.Id(), pointers...); err != nil {
+		return err
+	}
+
+	for i, attribute := range attributes {
+		if err := identity.Set(attribute.Name, components[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
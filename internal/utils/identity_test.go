@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetIdentity(t *testing.T) {
+	ctx := context.Background()
+	attributes := []IdentityAttribute{
+		{Name: "loadbalancer"},
+		{Name: "name"},
+	}
+
+	s := IdentitySchema(attributes)
+	identity := &tfsdk.ResourceIdentity{
+		Schema: s,
+		Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+	}
+
+	diags := SetIdentity(ctx, identity, attributes, MarshalID("0aded5c1-c7a3-498a-b9c8-a871611c47a2", "example"))
+	assert.False(t, diags.HasError())
+
+	var loadbalancer, name string
+	identity.GetAttribute(ctx, path.Root("loadbalancer"), &loadbalancer)
+	identity.GetAttribute(ctx, path.Root("name"), &name)
+	assert.Equal(t, "0aded5c1-c7a3-498a-b9c8-a871611c47a2", loadbalancer)
+	assert.Equal(t, "example", name)
+
+	assert.False(t, SetIdentity(ctx, nil, attributes, "").HasError())
+}
@@ -0,0 +1,74 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ListResourceZoneAttribute returns the zone filter attribute for list resources.
+func ListResourceZoneAttribute(resource string) listschema.StringAttribute {
+	return listschema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("Only list %s in the given zone, e.g. `de-fra1`.", resource),
+		Optional:            true,
+	}
+}
+
+// ListResourceLabelsAttribute returns the labels filter attribute for list resources.
+func ListResourceLabelsAttribute(resource string) listschema.MapAttribute {
+	return listschema.MapAttribute{
+		MarkdownDescription: fmt.Sprintf("Only list %s that have all of the given labels with matching values.", resource),
+		Optional:            true,
+		ElementType:         types.StringType,
+	}
+}
+
+// GetListResourceConfig reads the list resource configuration into target. The configuration is left empty, if the config block is not defined.
+func GetListResourceConfig(ctx context.Context, config tfsdk.Config, target interface{}) diag.Diagnostics {
+	if config.Raw.IsNull() {
+		return nil
+	}
+	return config.Get(ctx, target)
+}
+
+// ListResourceLabelsFilter converts the labels filter into a map.
+func ListResourceLabelsFilter(ctx context.Context, labels types.Map) (map[string]string, diag.Diagnostics) {
+	filter := make(map[string]string)
+	if labels.IsNull() || labels.IsUnknown() {
+		return filter, nil
+	}
+
+	diags := labels.ElementsAs(ctx, &filter, false)
+	return filter, diags
+}
+
+// LabelsMatch reports whether labels contain all the labels in the filter with matching values.
+func LabelsMatch(filter map[string]string, labels []upcloud.Label) bool {
+	m := LabelsSliceToMap(labels)
+	for k, v := range filter {
+		if value, ok := m[k]; !ok || value != v {
+			return false
+		}
+	}
+	return true
+}
+
+// NewListResult returns a list result with the identity set from the resource ID. If the request includes the resource, the ID is also set to the resource data, other attributes can be set by the caller.
+func NewListResult(ctx context.Context, req list.ListRequest, attributes []IdentityAttribute, id, displayName string) list.ListResult {
+	result := req.NewListResult(ctx)
+	result.DisplayName = displayName
+
+	result.Diagnostics.Append(SetIdentity(ctx, result.Identity, attributes, id)...)
+	if req.IncludeResource {
+		result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("id"), id)...)
+	}
+
+	return result
+}
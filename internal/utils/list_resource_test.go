@@ -0,0 +1,23 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLabelsMatch(t *testing.T) {
+	labels := []upcloud.Label{
+		{Key: "env", Value: "prod"},
+		{Key: "team", Value: "iaas"},
+	}
+
+	assert.True(t, LabelsMatch(nil, labels))
+	assert.True(t, LabelsMatch(map[string]string{"env": "prod"}, labels))
+	assert.True(t, LabelsMatch(map[string]string{"env": "prod", "team": "iaas"}, labels))
+	assert.False(t, LabelsMatch(map[string]string{"env": "dev"}, labels))
+	assert.False(t, LabelsMatch(map[string]string{"owner": ""}, labels))
+	assert.False(t, LabelsMatch(map[string]string{"env": "prod"}, nil))
+}
@@ -0,0 +1,77 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+const (
+	TimeoutCreate = "create"
+	TimeoutUpdate = "update"
+	TimeoutDelete = "delete"
+)
+
+type operationTimeoutKey struct{}
+
+type operationTimeout struct {
+	operation string
+	timeout   time.Duration
+}
+
+// TimeoutsBlock returns the schema for the `timeouts` block of long-running resources.
+func TimeoutsBlock(ctx context.Context) schema.Block {
+	return timeouts.Block(ctx, timeouts.Opts{
+		Create: true,
+		Update: true,
+		Delete: true,
+	})
+}
+
+// TimeoutContext returns a context that is cancelled when the timeout configured for the given operation in the `timeouts` block is exceeded.
+// If no timeout is configured, the returned context is not limited.
+func TimeoutContext(ctx context.Context, t timeouts.Value, operation string) (context.Context, context.CancelFunc, diag.Diagnostics) {
+	var timeout time.Duration
+	var diags diag.Diagnostics
+
+	// Zero default timeout means that the operation is not limited.
+	switch operation {
+	case TimeoutCreate:
+		timeout, diags = t.Create(ctx, 0)
+	case TimeoutUpdate:
+		timeout, diags = t.Update(ctx, 0)
+	case TimeoutDelete:
+		timeout, diags = t.Delete(ctx, 0)
+	default:
+		diags.AddError("Invalid timeout", fmt.Sprintf("Unknown timeout operation %s", operation))
+	}
+
+	if diags.HasError() || timeout == 0 {
+		return ctx, func() {}, diags
+	}
+
+	ctx = context.WithValue(ctx, operationTimeoutKey{}, operationTimeout{operation: operation, timeout: timeout})
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	return ctx, cancel, diags
+}
+
+// WaitErrorDiagnosticDetail returns diagnostic detail for errors returned while waiting for a resource to reach a target state.
+// If the wait was interrupted by a timeout configured in the `timeouts` block, the detail explains which timeout was exceeded.
+func WaitErrorDiagnosticDetail(ctx context.Context, err error) string {
+	detail := ErrorDiagnosticDetail(err)
+
+	if !errors.Is(err, context.DeadlineExceeded) && !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return detail
+	}
+
+	if t, ok := ctx.Value(operationTimeoutKey{}).(operationTimeout); ok {
+		return fmt.Sprintf("%s\n\nThe %s operation did not complete within the configured timeout (%s). The operation might still be in progress in UpCloud. If the operation needs more time, increase `timeouts.%s` value.", detail, t.operation, t.timeout, t.operation)
+	}
+
+	return detail
+}
@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeoutContext(t *testing.T) {
+	attrTypes := map[string]attr.Type{
+		TimeoutCreate: types.StringType,
+		TimeoutUpdate: types.StringType,
+		TimeoutDelete: types.StringType,
+	}
+	configured := timeouts.Value{Object: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+		TimeoutCreate: types.StringValue("1ms"),
+		TimeoutUpdate: types.StringNull(),
+		TimeoutDelete: types.StringNull(),
+	})}
+
+	ctx, cancel, diags := TimeoutContext(context.Background(), timeouts.Value{Object: types.ObjectNull(attrTypes)}, TimeoutCreate)
+	defer cancel()
+	assert.False(t, diags.HasError())
+	_, ok := ctx.Deadline()
+	assert.False(t, ok)
+
+	ctx, cancel, diags = TimeoutContext(context.Background(), configured, TimeoutUpdate)
+	defer cancel()
+	assert.False(t, diags.HasError())
+	_, ok = ctx.Deadline()
+	assert.False(t, ok)
+
+	ctx, cancel, diags = TimeoutContext(context.Background(), configured, TimeoutCreate)
+	defer cancel()
+	assert.False(t, diags.HasError())
+	_, ok = ctx.Deadline()
+	assert.True(t, ok)
+
+	<-ctx.Done()
+	detail := WaitErrorDiagnosticDetail(ctx, ctx.Err())
+	assert.Contains(t, detail, "create operation did not complete within the configured timeout (1ms)")
+	assert.Contains(t, detail, "timeouts.create")
+
+	assert.Equal(t, "Error: failed", WaitErrorDiagnosticDetail(context.Background(), errors.New("failed")))
+	assert.Equal(t, "Error: "+context.DeadlineExceeded.Error(), WaitErrorDiagnosticDetail(context.Background(), context.DeadlineExceeded))
+}
@@ -27,6 +27,11 @@ func WaitForResourceToBeDeleted(ctx context.Context, svc *service.Service, getDe
 
 			tflog.Info(ctx, "waiting for resource to be deleted", details)
 		}
-		time.Sleep(5 * time.Second)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(5 * time.Second):
+		}
 	}
 }
@@ -0,0 +1,48 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = durationValidator{}
+
+// durationValidator validates that the value of a string attribute is a positive Go duration, e.g. `30m` or `1h30m`.
+type durationValidator struct{}
+
+// Description describes the validation.
+func (v durationValidator) Description(_ context.Context) string {
+	return "value must be a positive duration, e.g. 30m or 1h30m"
+}
+
+// MarkdownDescription describes the validation in Markdown.
+func (v durationValidator) MarkdownDescription(_ context.Context) string {
+	return "value must be a positive duration, e.g. `30m` or `1h30m`"
+}
+
+// ValidateString validates.
+func (v durationValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	d, err := time.ParseDuration(request.ConfigValue.ValueString())
+	if err != nil || d <= 0 {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			fmt.Sprintf("%q", request.ConfigValue.ValueString()),
+		))
+	}
+}
+
+// Duration returns an AttributeValidator to validate that the value can be parsed with time.ParseDuration and is positive.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func Duration() validator.String {
+	return durationValidator{}
+}
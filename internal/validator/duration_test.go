@@ -0,0 +1,55 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	fwvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func TestDuration(t *testing.T) {
+	validValues := []string{
+		"30s",
+		"10m",
+		"1h30m",
+		"2h",
+	}
+	invalidValues := []string{
+		"",
+		"10",
+		"0s",
+		"-5m",
+		"ten minutes",
+	}
+
+	v := Duration()
+
+	for _, value := range validValues {
+		req := fwvalidator.StringRequest{
+			Path:        path.Empty(),
+			ConfigValue: types.StringValue(value),
+		}
+		resp := fwvalidator.StringResponse{}
+
+		v.ValidateString(context.Background(), req, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Errorf("Duration failed with valid value %q: %s", value, resp.Diagnostics.Errors())
+		}
+	}
+
+	for _, value := range invalidValues {
+		req := fwvalidator.StringRequest{
+			Path:        path.Empty(),
+			ConfigValue: types.StringValue(value),
+		}
+		resp := fwvalidator.StringResponse{}
+
+		v.ValidateString(context.Background(), req, &resp)
+		if !resp.Diagnostics.HasError() {
+			t.Errorf("Duration did not fail with invalid value %q", value)
+		}
+	}
+}
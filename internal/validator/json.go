@@ -0,0 +1,48 @@
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = jsonObjectValidator{}
+
+// jsonObjectValidator validates that the value of a string attribute is a JSON encoded object.
+type jsonObjectValidator struct{}
+
+// Description describes the validation.
+func (v jsonObjectValidator) Description(_ context.Context) string {
+	return "value must be a JSON object, e.g. {\"key\": \"value\"}"
+}
+
+// MarkdownDescription describes the validation in Markdown.
+func (v jsonObjectValidator) MarkdownDescription(_ context.Context) string {
+	return "value must be a JSON object, e.g. `{\"key\": \"value\"}`"
+}
+
+// ValidateString validates.
+func (v jsonObjectValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var obj map[string]interface{}
+	if err := json.Unmarshal([]byte(request.ConfigValue.ValueString()), &obj); err != nil || obj == nil {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			fmt.Sprintf("%q", request.ConfigValue.ValueString()),
+		))
+	}
+}
+
+// JSONObject returns an AttributeValidator to validate that the value can be parsed as a JSON object.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func JSONObject() validator.String {
+	return jsonObjectValidator{}
+}
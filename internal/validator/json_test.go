@@ -0,0 +1,54 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	fwvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func TestJSONObject(t *testing.T) {
+	validValues := []string{
+		"{}",
+		`{"index_patterns": ["logs-*"]}`,
+		`{"policy": {"states": []}}`,
+	}
+	invalidValues := []string{
+		"",
+		"null",
+		"[]",
+		`"string"`,
+		`{"key": }`,
+	}
+
+	v := JSONObject()
+
+	for _, value := range validValues {
+		req := fwvalidator.StringRequest{
+			Path:        path.Empty(),
+			ConfigValue: types.StringValue(value),
+		}
+		resp := fwvalidator.StringResponse{}
+
+		v.ValidateString(context.Background(), req, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Errorf("JSONObject failed with valid value %q: %s", value, resp.Diagnostics.Errors())
+		}
+	}
+
+	for _, value := range invalidValues {
+		req := fwvalidator.StringRequest{
+			Path:        path.Empty(),
+			ConfigValue: types.StringValue(value),
+		}
+		resp := fwvalidator.StringResponse{}
+
+		v.ValidateString(context.Background(), req, &resp)
+		if !resp.Diagnostics.HasError() {
+			t.Errorf("JSONObject did not fail with invalid value %q", value)
+		}
+	}
+}
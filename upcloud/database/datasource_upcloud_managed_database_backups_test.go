@@ -0,0 +1,28 @@
+package databasetests
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	"github.com/UpCloudLtd/terraform-provider-upcloud/upcloud"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceUpcloudManagedDatabaseBackups(t *testing.T) {
+	testDataS1 := utils.ReadTestDataFile(t, "testdata/data_source_backups_s1.tf")
+
+	name := "data.upcloud_managed_database_backups.backups"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { upcloud.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: upcloud.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataS1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "id", "upcloud_managed_database_postgresql.backups", "id"),
+					resource.TestCheckResourceAttrSet(name, "backups.#"),
+				),
+			},
+		},
+	})
+}
@@ -12,6 +12,7 @@ func TestAccDataSourceUpcloudManagedDatabasePostgreSQLSessions(t *testing.T) {
 	testDataS1 := utils.ReadTestDataFile(t, "testdata/data_source_postgresql_sessions_s1.tf")
 
 	name := "data.upcloud_managed_database_postgresql_sessions.postgresql_sessions"
+	filtered := "data.upcloud_managed_database_postgresql_sessions.postgresql_sessions_filtered"
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { upcloud.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: upcloud.TestAccProviderFactories,
@@ -21,6 +22,9 @@ func TestAccDataSourceUpcloudManagedDatabasePostgreSQLSessions(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(name, "service"),
 					resource.TestCheckResourceAttrSet(name, "sessions.#"),
+					resource.TestCheckResourceAttr(name, "limit", "10"),
+					resource.TestCheckResourceAttr(name, "order", "query_duration:desc"),
+					resource.TestCheckResourceAttr(filtered, "sessions.#", "0"),
 				),
 			},
 		},
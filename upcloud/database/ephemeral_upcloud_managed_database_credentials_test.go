@@ -0,0 +1,43 @@
+package databasetests
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	"github.com/UpCloudLtd/terraform-provider-upcloud/upcloud"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccEphemeralUpcloudManagedDatabaseCredentials(t *testing.T) {
+	testData := utils.ReadTestDataFile(t, "testdata/ephemeral_credentials.tf")
+
+	factories := map[string]func() (tfprotov6.ProviderServer, error){
+		"echo": echoprovider.NewProviderServer(),
+	}
+	for name, factory := range upcloud.TestAccProviderFactories {
+		factories[name] = factory
+	}
+
+	name := "echo.this"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { upcloud.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: factories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testData,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "data.type", "pg"),
+					resource.TestCheckResourceAttr(name, "data.primary_database", "defaultdb"),
+					resource.TestCheckResourceAttrPair(name, "data.service_host", "upcloud_managed_database_postgresql.this", "service_host"),
+					resource.TestCheckResourceAttrSet(name, "data.service_password"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,36 @@
+package databasetests
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	"github.com/UpCloudLtd/terraform-provider-upcloud/upcloud"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUpcloudManagedDatabaseIntegration(t *testing.T) {
+	testData := utils.ReadTestDataFile(t, "testdata/integration.tf")
+
+	name := "upcloud_managed_database_integration.logs"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { upcloud.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: upcloud.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testData,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "type", "logs"),
+					resource.TestCheckResourceAttrPair(name, "source_service", "upcloud_managed_database_postgresql.source", "id"),
+					resource.TestCheckResourceAttrPair(name, "destination_service", "upcloud_managed_database_opensearch.destination", "id"),
+					resource.TestCheckResourceAttrSet(name, "uuid"),
+				),
+			},
+			{
+				Config:            testData,
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
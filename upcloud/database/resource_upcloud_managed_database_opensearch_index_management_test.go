@@ -0,0 +1,52 @@
+package databasetests
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	"github.com/UpCloudLtd/terraform-provider-upcloud/upcloud"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUpcloudManagedDatabaseOpenSearchIndexManagement(t *testing.T) {
+	testData := utils.ReadTestDataFile(t, "testdata/opensearch_index_management.tf")
+
+	index := "upcloud_managed_database_opensearch_index.this"
+	template := "upcloud_managed_database_opensearch_index_template.this"
+	policy := "upcloud_managed_database_opensearch_ism_policy.this"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { upcloud.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: upcloud.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testData,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(index, "index_name", "logs-tf-acc-test"),
+					resource.TestCheckResourceAttr(index, "number_of_shards", "1"),
+					resource.TestCheckResourceAttr(index, "number_of_replicas", "0"),
+					resource.TestCheckResourceAttr(index, "refresh_interval", "30s"),
+					resource.TestCheckResourceAttr(template, "name", "tf-acc-test"),
+					resource.TestCheckResourceAttr(policy, "policy_id", "tf-acc-test"),
+				),
+			},
+			{
+				Config:            testData,
+				ResourceName:      index,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testData,
+				ConfigVariables: config.Variables{
+					"number_of_replicas": config.IntegerVariable(1),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(index, "number_of_replicas", "1"),
+					resource.TestCheckResourceAttr(index, "number_of_shards", "1"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,50 @@
+package databasetests
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	"github.com/UpCloudLtd/terraform-provider-upcloud/upcloud"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUpcloudManagedDatabasePostgreSQLConnectionPool(t *testing.T) {
+	testData := utils.ReadTestDataFile(t, "testdata/postgresql_connection_pool.tf")
+
+	name := "upcloud_managed_database_postgresql_connection_pool.pool"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { upcloud.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: upcloud.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testData,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "pool_name", "pool"),
+					resource.TestCheckResourceAttr(name, "database", "pool_db"),
+					resource.TestCheckResourceAttr(name, "username", "pool_user"),
+					resource.TestCheckResourceAttr(name, "pool_mode", "transaction"),
+					resource.TestCheckResourceAttr(name, "pool_size", "10"),
+					resource.TestCheckResourceAttrSet(name, "connection_uri"),
+				),
+			},
+			{
+				Config:            testData,
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testData,
+				ConfigVariables: config.Variables{
+					"pool_mode": config.StringVariable("session"),
+					"pool_size": config.IntegerVariable(20),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "pool_mode", "session"),
+					resource.TestCheckResourceAttr(name, "pool_size", "20"),
+				),
+			},
+		},
+	})
+}
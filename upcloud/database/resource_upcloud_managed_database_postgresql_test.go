@@ -127,3 +127,28 @@ func TestAccUpcloudManagedDatabasePostgreSQLProperties(t *testing.T) {
 		},
 	})
 }
+
+func TestAccUpcloudManagedDatabasePostgreSQLFork(t *testing.T) {
+	testData := utils.ReadTestDataFile(t, "testdata/postgresql_fork.tf")
+
+	source := "upcloud_managed_database_postgresql.source"
+	fork := "upcloud_managed_database_postgresql.fork"
+	forkOtherZone := "upcloud_managed_database_postgresql.fork_other_zone"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { upcloud.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: upcloud.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testData,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(source, "state", "running"),
+					resource.TestCheckResourceAttr(fork, "state", "running"),
+					resource.TestCheckResourceAttrPair(fork, "fork.0.service", source, "id"),
+					resource.TestCheckResourceAttr(forkOtherZone, "state", "running"),
+					resource.TestCheckResourceAttr(forkOtherZone, "zone", "fi-hel2"),
+					resource.TestCheckResourceAttrPair(forkOtherZone, "fork.0.service", source, "id"),
+				),
+			},
+		},
+	})
+}
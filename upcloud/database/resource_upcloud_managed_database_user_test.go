@@ -0,0 +1,91 @@
+package databasetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	"github.com/UpCloudLtd/terraform-provider-upcloud/upcloud"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccUpcloudManagedDatabaseUserPasswordWO(t *testing.T) {
+	testData := utils.ReadTestDataFile(t, "testdata/user_password_wo.tf")
+
+	name := "upcloud_managed_database_user.this"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { upcloud.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: upcloud.TestAccProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testData,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "username", "wo_user"),
+					resource.TestCheckResourceAttr(name, "password_wo_version", "1"),
+					resource.TestCheckNoResourceAttr(name, "password"),
+					resource.TestCheckNoResourceAttr(name, "password_wo"),
+				),
+			},
+			{
+				Config: testData,
+				ConfigVariables: config.Variables{
+					"password":         config.StringVariable("Superpass456"),
+					"password_version": config.IntegerVariable(2),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "password_wo_version", "2"),
+					resource.TestCheckNoResourceAttr(name, "password"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUpcloudManagedDatabaseUserPasswordRotation(t *testing.T) {
+	testData := utils.ReadTestDataFile(t, "testdata/user_password_rotation.tf")
+
+	name := "upcloud_managed_database_user.this"
+	var password string
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { upcloud.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: upcloud.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testData,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "password_rotation_trigger", "1"),
+					resource.TestCheckResourceAttrWith(name, "password", func(value string) error {
+						password = value
+						return nil
+					}),
+				),
+			},
+			{
+				Config: testData,
+				ConfigVariables: config.Variables{
+					"rotation": config.StringVariable("2"),
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(name, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "password_rotation_trigger", "2"),
+					resource.TestCheckResourceAttrWith(name, "password", func(value string) error {
+						if value == password {
+							return fmt.Errorf("password was not rotated")
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
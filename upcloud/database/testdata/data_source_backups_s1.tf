@@ -0,0 +1,10 @@
+resource "upcloud_managed_database_postgresql" "backups" {
+  name  = "postgresql-backups-test-1"
+  title = "tf-acc-test-postgresql-backups-1"
+  plan  = "1x1xCPU-2GB-25GB"
+  zone  = "fi-hel2"
+}
+
+data "upcloud_managed_database_backups" "backups" {
+  service = upcloud_managed_database_postgresql.backups.id
+}
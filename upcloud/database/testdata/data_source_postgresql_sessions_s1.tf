@@ -8,3 +8,8 @@ resource "upcloud_managed_database_postgresql" "postgresql_sessions" {
 data "upcloud_managed_database_postgresql_sessions" "postgresql_sessions" {
   service = upcloud_managed_database_postgresql.postgresql_sessions.id
 }
+
+data "upcloud_managed_database_postgresql_sessions" "postgresql_sessions_filtered" {
+  service  = upcloud_managed_database_postgresql.postgresql_sessions.id
+  username = "not-a-user"
+}
@@ -0,0 +1,26 @@
+variable "prefix" {
+  default = "tf-acc-test-db-ephemeral-"
+  type    = string
+}
+
+variable "zone" {
+  default = "fi-hel2"
+  type    = string
+}
+
+resource "upcloud_managed_database_postgresql" "this" {
+  name  = "pg-ephemeral-test"
+  title = "${var.prefix}pg"
+  plan  = "1x1xCPU-2GB-25GB"
+  zone  = var.zone
+}
+
+ephemeral "upcloud_managed_database_credentials" "this" {
+  id = upcloud_managed_database_postgresql.this.id
+}
+
+provider "echo" {
+  data = ephemeral.upcloud_managed_database_credentials.this
+}
+
+resource "echo" "this" {}
@@ -0,0 +1,29 @@
+variable "prefix" {
+  default = "tf-acc-test-db-integration-"
+  type    = string
+}
+
+variable "zone" {
+  default = "fi-hel2"
+  type    = string
+}
+
+resource "upcloud_managed_database_postgresql" "source" {
+  name  = "pg-integration-test"
+  title = "${var.prefix}pg"
+  plan  = "1x1xCPU-2GB-25GB"
+  zone  = var.zone
+}
+
+resource "upcloud_managed_database_opensearch" "destination" {
+  name  = "os-integration-test"
+  title = "${var.prefix}os"
+  plan  = "1x2xCPU-4GB-80GB-1D"
+  zone  = var.zone
+}
+
+resource "upcloud_managed_database_integration" "logs" {
+  type                = "logs"
+  source_service      = upcloud_managed_database_postgresql.source.id
+  destination_service = upcloud_managed_database_opensearch.destination.id
+}
@@ -0,0 +1,68 @@
+variable "prefix" {
+  default = "tf-acc-test-db-os-index-"
+  type    = string
+}
+
+variable "zone" {
+  default = "fi-hel2"
+  type    = string
+}
+
+variable "number_of_replicas" {
+  default = 0
+  type    = number
+}
+
+resource "upcloud_managed_database_opensearch" "this" {
+  name  = "os-index-test"
+  title = "${var.prefix}os"
+  plan  = "1x2xCPU-4GB-80GB-1D"
+  zone  = var.zone
+}
+
+resource "upcloud_managed_database_opensearch_index" "this" {
+  service            = upcloud_managed_database_opensearch.this.id
+  index_name         = "logs-tf-acc-test"
+  number_of_shards   = 1
+  number_of_replicas = var.number_of_replicas
+  refresh_interval   = "30s"
+}
+
+resource "upcloud_managed_database_opensearch_index_template" "this" {
+  service = upcloud_managed_database_opensearch.this.id
+  name    = "tf-acc-test"
+  template = jsonencode({
+    index_patterns = ["tf-acc-test-*"]
+    template = {
+      settings = {
+        number_of_replicas = var.number_of_replicas
+      }
+    }
+  })
+}
+
+resource "upcloud_managed_database_opensearch_ism_policy" "this" {
+  service   = upcloud_managed_database_opensearch.this.id
+  policy_id = "tf-acc-test"
+  policy = jsonencode({
+    description   = "Delete indices after ${var.number_of_replicas + 1} days"
+    default_state = "hot"
+    states = [
+      {
+        name    = "hot"
+        actions = []
+        transitions = [
+          {
+            state_name = "delete"
+            conditions = { min_index_age = "${var.number_of_replicas + 1}d" }
+          }
+        ]
+      },
+      {
+        name        = "delete"
+        actions     = [{ delete = {} }]
+        transitions = []
+      }
+    ]
+  })
+}
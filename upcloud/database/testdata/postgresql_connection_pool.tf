@@ -0,0 +1,40 @@
+variable "prefix" {
+  default = "tf-acc-test-postgresql-pool-"
+  type    = string
+}
+
+variable "pool_mode" {
+  default = "transaction"
+  type    = string
+}
+
+variable "pool_size" {
+  default = 10
+  type    = number
+}
+
+resource "upcloud_managed_database_postgresql" "pool" {
+  name  = "pg-pool-test"
+  title = "${var.prefix}db"
+  plan  = "1x1xCPU-2GB-25GB"
+  zone  = "fi-hel2"
+}
+
+resource "upcloud_managed_database_logical_database" "pool" {
+  service = upcloud_managed_database_postgresql.pool.id
+  name    = "pool_db"
+}
+
+resource "upcloud_managed_database_user" "pool" {
+  service  = upcloud_managed_database_postgresql.pool.id
+  username = "pool_user"
+}
+
+resource "upcloud_managed_database_postgresql_connection_pool" "pool" {
+  service   = upcloud_managed_database_postgresql.pool.id
+  pool_name = "pool"
+  database  = upcloud_managed_database_logical_database.pool.name
+  username  = upcloud_managed_database_user.pool.username
+  pool_mode = var.pool_mode
+  pool_size = var.pool_size
+}
@@ -0,0 +1,38 @@
+variable "prefix" {
+  default = "tf-acc-test-postgresql-fork-"
+  type    = string
+}
+
+variable "zone" {
+  default = "fi-hel1"
+  type    = string
+}
+
+resource "upcloud_managed_database_postgresql" "source" {
+  name  = "pg-fork-source-test"
+  title = "${var.prefix}source"
+  plan  = "1x1xCPU-2GB-25GB"
+  zone  = var.zone
+}
+
+resource "upcloud_managed_database_postgresql" "fork" {
+  name  = "pg-fork-test"
+  title = "${var.prefix}fork"
+  plan  = "1x1xCPU-2GB-25GB"
+  zone  = var.zone
+
+  fork {
+    service = upcloud_managed_database_postgresql.source.id
+  }
+}
+
+resource "upcloud_managed_database_postgresql" "fork_other_zone" {
+  name  = "pg-fork-other-zone-test"
+  title = "${var.prefix}fork-other-zone"
+  plan  = "1x1xCPU-2GB-25GB"
+  zone  = "fi-hel2"
+
+  fork {
+    service = upcloud_managed_database_postgresql.source.id
+  }
+}
@@ -0,0 +1,32 @@
+variable "prefix" {
+  default = "tf-acc-test-db-user-rotation-"
+  type    = string
+}
+
+variable "zone" {
+  default = "fi-hel2"
+  type    = string
+}
+
+variable "rotation" {
+  default = "1"
+  type    = string
+}
+
+resource "upcloud_managed_database_valkey" "this" {
+  name  = "valkey-user-rotation-test"
+  title = "${var.prefix}valkey"
+  plan  = "1x1xCPU-2GB"
+  zone  = var.zone
+}
+
+resource "upcloud_managed_database_user" "this" {
+  service                   = upcloud_managed_database_valkey.this.id
+  username                  = "rotation_user"
+  password_rotation_trigger = var.rotation
+
+  valkey_access_control {
+    categories = ["+@read"]
+    keys       = ["cache:*"]
+  }
+}
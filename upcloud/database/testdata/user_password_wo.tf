@@ -0,0 +1,33 @@
+variable "prefix" {
+  default = "tf-acc-test-db-user-wo-"
+  type    = string
+}
+
+variable "zone" {
+  default = "fi-hel2"
+  type    = string
+}
+
+variable "password" {
+  default = "Superpass123"
+  type    = string
+}
+
+variable "password_version" {
+  default = 1
+  type    = number
+}
+
+resource "upcloud_managed_database_postgresql" "this" {
+  name  = "pg-user-wo-test"
+  title = "${var.prefix}pg"
+  plan  = "1x1xCPU-2GB-25GB"
+  zone  = var.zone
+}
+
+resource "upcloud_managed_database_user" "this" {
+  service             = upcloud_managed_database_postgresql.this.id
+  username            = "wo_user"
+  password_wo         = var.password
+  password_wo_version = var.password_version
+}
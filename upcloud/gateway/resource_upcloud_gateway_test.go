@@ -59,7 +59,8 @@ func TestAccUpcloudGateway(t *testing.T) {
 					resource.TestCheckResourceAttrSet(tunnel1Name, "uuid"),
 					resource.TestCheckResourceAttr(tunnel1Name, "local_address_name", "my-public-ip"),
 					resource.TestCheckResourceAttr(tunnel1Name, "remote_address", "100.123.123.10"),
-					resource.TestCheckResourceAttr(tunnel1Name, "ipsec_auth_psk.0.psk", "presharedkey1"),
+					resource.TestCheckResourceAttr(tunnel1Name, "ipsec_auth_psk.#", "1"),
+					resource.TestCheckNoResourceAttr(tunnel1Name, "ipsec_auth_psk.0.psk"),
 					resource.TestCheckResourceAttrSet(tunnel1Name, "ipsec_properties.0.child_rekey_time"),
 					resource.TestCheckResourceAttrSet(tunnel1Name, "ipsec_properties.0.dpd_delay"),
 					resource.TestCheckResourceAttrSet(tunnel1Name, "ipsec_properties.0.dpd_timeout"),
@@ -111,6 +112,12 @@ func TestAccUpcloudGateway(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"operational_state"},
 			},
+			{
+				ResourceName:            tunnel2Name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"operational_state", "ipsec_auth_psk"},
+			},
 			{
 				Config: testDataS2,
 				Check: resource.ComposeTestCheckFunc(
@@ -130,7 +137,8 @@ func TestAccUpcloudGateway(t *testing.T) {
 					resource.TestCheckResourceAttr(conn1Name, "remote_route.0.static_network", "111.123.123.0/24"),
 
 					resource.TestCheckResourceAttr(tunnel1Name, "remote_address", "100.123.123.20"),
-					resource.TestCheckResourceAttr(tunnel1Name, "ipsec_auth_psk.0.psk", "presharedkey2"),
+					resource.TestCheckResourceAttr(tunnel1Name, "ipsec_auth_psk.0.psk_version", "2"),
+					resource.TestCheckNoResourceAttr(tunnel1Name, "ipsec_auth_psk.0.psk"),
 					resource.TestCheckResourceAttr(tunnel1Name, "ipsec_properties.0.child_rekey_time", "1000"),
 					resource.TestCheckResourceAttr(tunnel1Name, "ipsec_properties.0.rekey_time", "1000"),
 					resource.TestCheckResourceAttr(tunnel1Name, "ipsec_properties.0.phase1_algorithms.#", "2"),
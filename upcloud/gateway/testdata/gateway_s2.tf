@@ -75,7 +75,8 @@ resource "upcloud_gateway_connection_tunnel" "this" {
   remote_address     = "100.123.123.20"
 
   ipsec_auth_psk {
-    psk = "presharedkey2"
+    psk         = "presharedkey2"
+    psk_version = 2
   }
 
   ipsec_properties {
@@ -0,0 +1,68 @@
+package loadbalancertests
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	"github.com/UpCloudLtd/terraform-provider-upcloud/upcloud"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceUpcloudLoadBalancer(t *testing.T) {
+	testData := utils.ReadTestDataFile(t, "testdata/loadbalancer_data_source.tf")
+
+	lbName := "upcloud_loadbalancer.this"
+	byID := "data.upcloud_loadbalancer.by_id"
+	byName := "data.upcloud_loadbalancer.by_name"
+	filtered := "data.upcloud_loadbalancers.filtered"
+	none := "data.upcloud_loadbalancers.none"
+	metrics := "data.upcloud_loadbalancer_metrics.this"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { upcloud.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: upcloud.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testData,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(byID, "name", lbName, "name"),
+					resource.TestCheckResourceAttr(byID, "operational_state", "running"),
+					resource.TestCheckResourceAttr(byID, "labels.test", "tf-acc-test-lb-data-source"),
+					resource.TestCheckResourceAttr(byID, "networks.#", "2"),
+					resource.TestCheckResourceAttrPair(byID, "networks.1.network", "upcloud_network.this", "id"),
+					resource.TestCheckResourceAttr(byID, "frontends.#", "1"),
+					resource.TestCheckResourceAttr(byID, "frontends.0.name", "fe"),
+					resource.TestCheckResourceAttr(byID, "frontends.0.mode", "http"),
+					resource.TestCheckResourceAttr(byID, "frontends.0.port", "80"),
+					resource.TestCheckResourceAttr(byID, "frontends.0.default_backend_name", "be"),
+					resource.TestCheckResourceAttr(byID, "frontends.0.networks.0.name", "public"),
+					resource.TestCheckResourceAttr(byID, "backends.#", "1"),
+					resource.TestCheckResourceAttr(byID, "backends.0.name", "be"),
+					resource.TestCheckResourceAttr(byID, "backends.0.members.#", "1"),
+					resource.TestCheckResourceAttr(byID, "backends.0.members.0.name", "member"),
+					resource.TestCheckResourceAttr(byID, "backends.0.members.0.type", "static"),
+					resource.TestCheckResourceAttr(byID, "backends.0.members.0.ip", "172.16.15.10"),
+					resource.TestCheckResourceAttr(byID, "backends.0.members.0.port", "8080"),
+					resource.TestCheckResourceAttr(byID, "backends.0.properties.0.health_check_type", "tcp"),
+					resource.TestCheckResourceAttr(byID, "certificate_bundles.#", "0"),
+					resource.TestCheckResourceAttrSet(byID, "nodes.0.operational_state"),
+					resource.TestCheckResourceAttrPair(byName, "id", lbName, "id"),
+					resource.TestCheckResourceAttr(filtered, "loadbalancers.#", "1"),
+					resource.TestCheckResourceAttrPair(filtered, "loadbalancers.0.id", lbName, "id"),
+					resource.TestCheckResourceAttr(filtered, "loadbalancers.0.backends.0.members.0.name", "member"),
+					resource.TestCheckResourceAttr(none, "loadbalancers.#", "0"),
+					resource.TestCheckResourceAttr(metrics, "operational_state", "running"),
+					resource.TestCheckResourceAttrSet(metrics, "nodes.0.operational_state"),
+					resource.TestCheckResourceAttr(metrics, "frontends.0.name", "fe"),
+					resource.TestCheckResourceAttr(metrics, "backends.0.name", "be"),
+					resource.TestCheckResourceAttr(metrics, "backends.0.members.0.name", "member"),
+					resource.TestCheckResourceAttr(metrics, "backends.0.members.0.enabled", "true"),
+					resource.TestCheckResourceAttrSet(metrics, "backends.0.members.0.status"),
+					// Nothing is listening on the member address, so the health check should fail.
+					resource.TestCheckResourceAttr(metrics, "backends.0.members.0.healthy", "false"),
+					resource.TestCheckResourceAttr(metrics, "all_members_healthy", "false"),
+				),
+			},
+		},
+	})
+}
@@ -6,7 +6,9 @@ import (
 
 	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
 	"github.com/UpCloudLtd/terraform-provider-upcloud/upcloud"
+	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestAccUpcloudLoadBalancer(t *testing.T) {
@@ -510,3 +512,105 @@ func TestAccUpcloudLoadBalancerManualCertificateBundle(t *testing.T) {
 		},
 	})
 }
+
+func TestAccUpcloudLoadBalancerFrontendRules(t *testing.T) {
+	testDataS1 := utils.ReadTestDataFile(t, "testdata/frontend_rules_s1.tf")
+	testDataS2 := utils.ReadTestDataFile(t, "testdata/frontend_rules_s2.tf")
+	name := "upcloud_loadbalancer_frontend_rules.this"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { upcloud.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: upcloud.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataS1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "id", "upcloud_loadbalancer_frontend.this", "id"),
+					resource.TestCheckResourceAttr(name, "rule.#", "3"),
+					resource.TestCheckResourceAttr(name, "rule.0.name", "api"),
+					resource.TestCheckResourceAttr(name, "rule.0.priority", "100"),
+					resource.TestCheckResourceAttr(name, "rule.0.matching_condition", "and"),
+					resource.TestCheckResourceAttr(name, "rule.1.name", "redirect"),
+					resource.TestCheckResourceAttr(name, "rule.1.priority", "99"),
+					resource.TestCheckResourceAttr(name, "rule.1.actions.0.http_redirect.0.location", "/new/"),
+					resource.TestCheckResourceAttrSet(name, "rule.1.actions.0.http_redirect.0.status"),
+					resource.TestCheckResourceAttr(name, "rule.2.name", "reject"),
+					resource.TestCheckResourceAttr(name, "rule.2.priority", "98"),
+					resource.TestCheckResourceAttr(name, "rule.2.matching_condition", "or"),
+					resource.TestCheckResourceAttr(name, "rule.2.matchers.0.src_ip.#", "2"),
+				),
+			},
+			{
+				// Reorder rules, remove a rule, and modify matchers of a rule.
+				Config: testDataS2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "rule.#", "2"),
+					resource.TestCheckResourceAttr(name, "rule.0.name", "reject"),
+					resource.TestCheckResourceAttr(name, "rule.0.priority", "100"),
+					resource.TestCheckResourceAttr(name, "rule.1.name", "api"),
+					resource.TestCheckResourceAttr(name, "rule.1.priority", "99"),
+					resource.TestCheckResourceAttr(name, "rule.1.matchers.0.path.0.value", "/api/v2/"),
+				),
+			},
+			{
+				Config:            testDataS2,
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccUpcloudLoadBalancerFrontendIPFilter(t *testing.T) {
+	testData := utils.ReadTestDataFile(t, "testdata/frontend_ip_filter.tf")
+	name := "upcloud_loadbalancer_frontend_ip_filter.this"
+	ruleName := "upcloud_loadbalancer_frontend_rule.this"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { upcloud.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: upcloud.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testData,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", "ip-filter"),
+					resource.TestCheckResourceAttr(name, "type", "allow"),
+					resource.TestCheckResourceAttr(name, "priority", "100"),
+					resource.TestCheckResourceAttr(name, "addresses.#", "1"),
+					resource.TestCheckTypeSetElemAttr(name, "addresses.*", "192.0.2.0/24"),
+				),
+			},
+			{
+				// Modifying the addresses of the filter should not modify the other rules of the frontend.
+				Config: testData,
+				ConfigVariables: map[string]config.Variable{
+					"type":      config.StringVariable("deny"),
+					"addresses": config.ListVariable(config.StringVariable("192.0.2.0/24"), config.StringVariable("198.51.100.10")),
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(name, plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction(ruleName, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "type", "deny"),
+					resource.TestCheckResourceAttr(name, "addresses.#", "2"),
+					resource.TestCheckTypeSetElemAttr(name, "addresses.*", "198.51.100.10"),
+					resource.TestCheckResourceAttr(ruleName, "name", "other"),
+				),
+			},
+			{
+				Config: testData,
+				ConfigVariables: map[string]config.Variable{
+					"type":      config.StringVariable("deny"),
+					"addresses": config.ListVariable(config.StringVariable("192.0.2.0/24"), config.StringVariable("198.51.100.10")),
+				},
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
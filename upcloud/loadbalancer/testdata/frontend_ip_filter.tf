@@ -0,0 +1,73 @@
+variable "basename" {
+  type    = string
+  default = "tf-acc-test-lb-fe-ip-filter-"
+}
+
+variable "zone" {
+  type    = string
+  default = "fi-hel2"
+}
+
+variable "type" {
+  type    = string
+  default = "allow"
+}
+
+variable "addresses" {
+  type    = list(string)
+  default = ["192.0.2.0/24"]
+}
+
+resource "upcloud_loadbalancer" "this" {
+  name = "${var.basename}lb"
+  plan = "development"
+  zone = var.zone
+
+  networks {
+    type   = "public"
+    name   = "public"
+    family = "IPv4"
+  }
+}
+
+resource "upcloud_loadbalancer_backend" "this" {
+  loadbalancer = upcloud_loadbalancer.this.id
+  name         = "be"
+}
+
+resource "upcloud_loadbalancer_frontend" "this" {
+  loadbalancer         = upcloud_loadbalancer.this.id
+  name                 = "fe"
+  mode                 = "tcp"
+  port                 = 80
+  default_backend_name = upcloud_loadbalancer_backend.this.name
+
+  networks {
+    name = upcloud_loadbalancer.this.networks[0].name
+  }
+}
+
+resource "upcloud_loadbalancer_frontend_rule" "this" {
+  frontend = upcloud_loadbalancer_frontend.this.id
+  name     = "other"
+  priority = 10
+
+  matchers {
+    src_port {
+      method = "equal"
+      value  = 1234
+    }
+  }
+
+  actions {
+    tcp_reject {}
+  }
+}
+
+resource "upcloud_loadbalancer_frontend_ip_filter" "this" {
+  frontend  = upcloud_loadbalancer_frontend.this.id
+  name      = "ip-filter"
+  type      = var.type
+  priority  = 100
+  addresses = var.addresses
+}
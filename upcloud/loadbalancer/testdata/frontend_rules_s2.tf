@@ -0,0 +1,86 @@
+variable "basename" {
+  type    = string
+  default = "tf-acc-test-lb-fe-rules-"
+}
+
+variable "zone" {
+  type    = string
+  default = "fi-hel2"
+}
+
+resource "upcloud_loadbalancer" "this" {
+  name = "${var.basename}lb"
+  plan = "development"
+  zone = var.zone
+
+  networks {
+    type   = "public"
+    name   = "public"
+    family = "IPv4"
+  }
+}
+
+resource "upcloud_loadbalancer_backend" "this" {
+  loadbalancer = upcloud_loadbalancer.this.id
+  name         = "be"
+}
+
+resource "upcloud_loadbalancer_backend" "api" {
+  loadbalancer = upcloud_loadbalancer.this.id
+  name         = "api"
+}
+
+resource "upcloud_loadbalancer_frontend" "this" {
+  loadbalancer         = upcloud_loadbalancer.this.id
+  name                 = "fe"
+  mode                 = "http"
+  port                 = 80
+  default_backend_name = upcloud_loadbalancer_backend.this.name
+
+  networks {
+    name = upcloud_loadbalancer.this.networks[0].name
+  }
+}
+
+resource "upcloud_loadbalancer_frontend_rules" "this" {
+  frontend = upcloud_loadbalancer_frontend.this.id
+
+  rule {
+    name               = "reject"
+    matching_condition = "or"
+
+    matchers {
+      src_ip {
+        value = "192.168.0.0/24"
+      }
+      src_ip {
+        value = "192.168.1.0/24"
+      }
+    }
+
+    actions {
+      http_return {
+        content_type = "text/plain"
+        status       = 403
+        payload      = "Forbidden"
+      }
+    }
+  }
+
+  rule {
+    name = "api"
+
+    matchers {
+      path {
+        method = "starts"
+        value  = "/api/v2/"
+      }
+    }
+
+    actions {
+      use_backend {
+        backend_name = upcloud_loadbalancer_backend.api.name
+      }
+    }
+  }
+}
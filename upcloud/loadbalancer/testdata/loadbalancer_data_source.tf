@@ -0,0 +1,121 @@
+variable "basename" {
+  type    = string
+  default = "tf-acc-test-lb-data-source-"
+}
+
+variable "zone" {
+  type    = string
+  default = "fi-hel2"
+}
+
+resource "upcloud_network" "this" {
+  name = "${var.basename}net"
+  zone = var.zone
+
+  ip_network {
+    address = "172.16.15.0/24"
+    dhcp    = true
+    family  = "IPv4"
+  }
+}
+
+resource "upcloud_loadbalancer" "this" {
+  name = "${var.basename}lb"
+  plan = "development"
+  zone = var.zone
+
+  labels = {
+    test = "tf-acc-test-lb-data-source"
+  }
+
+  networks {
+    type   = "public"
+    name   = "public"
+    family = "IPv4"
+  }
+
+  networks {
+    type    = "private"
+    name    = "private"
+    family  = "IPv4"
+    network = upcloud_network.this.id
+  }
+}
+
+resource "upcloud_loadbalancer_backend" "this" {
+  loadbalancer = upcloud_loadbalancer.this.id
+  name         = "be"
+}
+
+resource "upcloud_loadbalancer_static_backend_member" "this" {
+  backend      = upcloud_loadbalancer_backend.this.id
+  name         = "member"
+  ip           = "172.16.15.10"
+  port         = 8080
+  weight       = 100
+  max_sessions = 1000
+  enabled      = true
+}
+
+resource "upcloud_loadbalancer_frontend" "this" {
+  loadbalancer         = upcloud_loadbalancer.this.id
+  name                 = "fe"
+  mode                 = "http"
+  port                 = 80
+  default_backend_name = upcloud_loadbalancer_backend.this.name
+
+  networks {
+    name = "public"
+  }
+}
+
+data "upcloud_loadbalancer" "by_id" {
+  id = upcloud_loadbalancer.this.id
+
+  depends_on = [
+    upcloud_loadbalancer_frontend.this,
+    upcloud_loadbalancer_static_backend_member.this,
+  ]
+}
+
+data "upcloud_loadbalancer" "by_name" {
+  name = upcloud_loadbalancer.this.name
+  zone = upcloud_loadbalancer.this.zone
+
+  depends_on = [
+    upcloud_loadbalancer_frontend.this,
+    upcloud_loadbalancer_static_backend_member.this,
+  ]
+}
+
+data "upcloud_loadbalancers" "filtered" {
+  zone       = upcloud_loadbalancer.this.zone
+  name_regex = "^${var.basename}lb$"
+  network    = upcloud_network.this.id
+  labels     = upcloud_loadbalancer.this.labels
+
+  depends_on = [
+    upcloud_loadbalancer_frontend.this,
+    upcloud_loadbalancer_static_backend_member.this,
+  ]
+}
+
+data "upcloud_loadbalancers" "none" {
+  zone       = upcloud_loadbalancer.this.zone
+  name_regex = "^${var.basename}lb$"
+
+  labels = {
+    test = "no-such-label-value"
+  }
+
+  depends_on = [upcloud_loadbalancer.this]
+}
+
+data "upcloud_loadbalancer_metrics" "this" {
+  id = upcloud_loadbalancer.this.id
+
+  depends_on = [
+    upcloud_loadbalancer_frontend.this,
+    upcloud_loadbalancer_static_backend_member.this,
+  ]
+}
@@ -0,0 +1,57 @@
+package managedobjectstoragetests
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	"github.com/UpCloudLtd/terraform-provider-upcloud/upcloud"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceUpcloudManagedObjectStoragePolicyDocument(t *testing.T) {
+	testDataS1 := utils.ReadTestDataFile(t, "testdata/data_source_managed_object_storage_policy_document_s1.tf")
+
+	name := "data.upcloud_managed_object_storage_policy_document.this"
+	policy := "upcloud_managed_object_storage_policy.this"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { upcloud.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: upcloud.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataS1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "version", "2012-10-17"),
+					resource.TestCheckResourceAttr(name, "statement.0.effect", "Allow"),
+					resource.TestCheckResourceAttr(name, "json", `{"Statement":[{"Action":["s3:GetObject","s3:ListBucket"],"Condition":{"IpAddress":{"aws:SourceIp":["192.0.2.0/24"]}},"Effect":"Allow","Resource":["arn:aws:s3:::website","arn:aws:s3:::website/*"],"Sid":"ReadWebsite"},{"Action":["s3:DeleteObject"],"Effect":"Deny","Resource":["*"]}],"Version":"2012-10-17"}`),
+					resource.TestCheckResourceAttrPair(policy, "document", name, "document"),
+				),
+			},
+			{
+				// Validate that the generated document does not cause changes to the policy.
+				Config:   testDataS1,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccDataSourceUpcloudManagedObjectStoragePolicyDocument_UnsupportedAction(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: upcloud.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "upcloud_managed_object_storage_policy_document" "this" {
+  statement {
+    actions   = ["s3:GetObjects"]
+    resources = ["*"]
+  }
+}
+`,
+				ExpectError: regexp.MustCompile("Unsupported policy action"),
+			},
+		},
+	})
+}
@@ -0,0 +1,56 @@
+package managedobjectstoragetests
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	"github.com/UpCloudLtd/terraform-provider-upcloud/upcloud"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUpcloudManagedObjectStorageBucket_Configuration(t *testing.T) {
+	testDataS1 := utils.ReadTestDataFile(t, "testdata/managed_object_storage_bucket_configuration_s1.tf")
+	testDataS2 := utils.ReadTestDataFile(t, "testdata/managed_object_storage_bucket_configuration_s2.tf")
+
+	bucket := "upcloud_managed_object_storage_bucket.this"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { upcloud.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: upcloud.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataS1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(bucket, "name", "configured"),
+					resource.TestCheckResourceAttr(bucket, "versioning", "Enabled"),
+					resource.TestCheckNoResourceAttr(bucket, "server_side_encryption"),
+					resource.TestCheckResourceAttr(bucket, "lifecycle_rule.#", "2"),
+					resource.TestCheckResourceAttr(bucket, "lifecycle_rule.0.id", "expire-logs"),
+					resource.TestCheckResourceAttr(bucket, "lifecycle_rule.0.enabled", "true"),
+					resource.TestCheckResourceAttr(bucket, "lifecycle_rule.0.prefix", "logs/"),
+					resource.TestCheckResourceAttr(bucket, "lifecycle_rule.0.expiration_days", "30"),
+					resource.TestCheckResourceAttr(bucket, "lifecycle_rule.1.prefix", ""),
+					resource.TestCheckResourceAttr(bucket, "lifecycle_rule.1.noncurrent_version_expiration_days", "7"),
+					resource.TestCheckResourceAttr(bucket, "cors_rule.#", "1"),
+					resource.TestCheckResourceAttr(bucket, "cors_rule.0.allowed_methods.#", "2"),
+					resource.TestCheckResourceAttr(bucket, "cors_rule.0.max_age_seconds", "3600"),
+				),
+			},
+			{
+				Config:   testDataS1,
+				PlanOnly: true,
+			},
+			{
+				Config: testDataS2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(bucket, "versioning", "Suspended"),
+					resource.TestCheckResourceAttr(bucket, "server_side_encryption", "AES256"),
+					resource.TestCheckResourceAttr(bucket, "lifecycle_rule.#", "1"),
+					resource.TestCheckResourceAttr(bucket, "lifecycle_rule.0.enabled", "false"),
+					resource.TestCheckResourceAttr(bucket, "lifecycle_rule.0.expiration_days", "60"),
+					resource.TestCheckResourceAttr(bucket, "cors_rule.#", "0"),
+				),
+			},
+		},
+	})
+}
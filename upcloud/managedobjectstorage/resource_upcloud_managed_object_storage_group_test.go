@@ -0,0 +1,72 @@
+package managedobjectstoragetests
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	"github.com/UpCloudLtd/terraform-provider-upcloud/upcloud"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUpcloudManagedObjectStorageGroup(t *testing.T) {
+	testDataS1 := utils.ReadTestDataFile(t, "testdata/managed_object_storage_group_s1.tf")
+
+	group := "upcloud_managed_object_storage_group.this"
+	membership := "upcloud_managed_object_storage_group_membership.this"
+	groupPolicy := "upcloud_managed_object_storage_group_policy.this"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { upcloud.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: upcloud.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataS1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(group, "name", "tf-acc-test-objstov2-group-developers"),
+					resource.TestCheckResourceAttrSet(group, "arn"),
+					resource.TestCheckResourceAttrSet(group, "group_id"),
+					resource.TestCheckResourceAttr(membership+".0", "username", "tf-acc-test-objstov2-group-member-0"),
+					resource.TestCheckResourceAttr(membership+".1", "username", "tf-acc-test-objstov2-group-member-1"),
+					resource.TestCheckResourceAttr(groupPolicy, "name", "ECSS3FullAccess"),
+					resource.TestCheckResourceAttrPair(groupPolicy, "group", group, "name"),
+				),
+			},
+			{
+				Config:   testDataS1,
+				PlanOnly: true,
+			},
+			{
+				Config:                  testDataS1,
+				ResourceName:            group,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"access_key_username", "arn", "group_id"},
+			},
+			{
+				Config:                  testDataS1,
+				ResourceName:            membership + ".0",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"access_key_username"},
+			},
+			{
+				Config:                  testDataS1,
+				ResourceName:            groupPolicy,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"access_key_username"},
+			},
+			{
+				Config: testDataS1,
+				ConfigVariables: config.Variables{
+					"member_count": config.IntegerVariable(1),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(membership+".0", "id"),
+					resource.TestCheckNoResourceAttr(membership+".1", "id"),
+				),
+			},
+		},
+	})
+}
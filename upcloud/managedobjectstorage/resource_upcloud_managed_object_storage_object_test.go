@@ -0,0 +1,55 @@
+package managedobjectstoragetests
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	"github.com/UpCloudLtd/terraform-provider-upcloud/upcloud"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUpcloudManagedObjectStorageObject(t *testing.T) {
+	testDataS1 := utils.ReadTestDataFile(t, "testdata/managed_object_storage_object_s1.tf")
+	testDataS2 := utils.ReadTestDataFile(t, "testdata/managed_object_storage_object_s2.tf")
+
+	object := "upcloud_managed_object_storage_object.this"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { upcloud.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: upcloud.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataS1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(object, "key", "config/app.json"),
+					resource.TestCheckResourceAttr(object, "content_type", "application/json"),
+					resource.TestCheckResourceAttr(object, "content_sha256", "aa0ca6242c13eb7bea50854eb412d299f29425290fc3d568cfcc62d4aa9b6b05"),
+					resource.TestCheckResourceAttrSet(object, "etag"),
+					resource.TestCheckNoResourceAttr(object, "cache_control"),
+					resource.TestCheckNoResourceAttr(object, "metadata.%"),
+				),
+			},
+			{
+				Config:   testDataS1,
+				PlanOnly: true,
+			},
+			// The object is read after import once access_key_username has been set on the next apply.
+			{
+				Config:                  testDataS1,
+				ResourceName:            object,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content", "access_key_username", "content_sha256", "content_type", "etag"},
+			},
+			{
+				Config: testDataS2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(object, "content_type", "application/vnd.example+json"),
+					resource.TestCheckResourceAttr(object, "cache_control", "max-age=60"),
+					resource.TestCheckResourceAttr(object, "metadata.%", "1"),
+					resource.TestCheckResourceAttr(object, "metadata.environment", "test"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,74 @@
+package managedobjectstoragetests
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/utils"
+	"github.com/UpCloudLtd/terraform-provider-upcloud/upcloud"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccUpcloudManagedObjectStorageStaticSite_SourceDir(t *testing.T) {
+	testDataS1 := utils.ReadTestDataFile(t, "testdata/managed_object_storage_static_site_content_s1.tf")
+
+	// Terraform is run in a temporary directory, so the source directory must be defined with an absolute path.
+	sourceDir := func(version string) config.Variables {
+		dir, err := filepath.Abs(filepath.Join("testdata", "static_site_content", version))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return config.Variables{"source_dir": config.StringVariable(dir)}
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { upcloud.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: upcloud.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:          testDataS1,
+				ConfigVariables: sourceDir("v1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(staticSite, "bucket_prefix", "public/"),
+					resource.TestCheckResourceAttr(staticSite, "source_files.%", "3"),
+					resource.TestCheckResourceAttrSet(staticSite, "source_files.index.html"),
+					resource.TestCheckResourceAttrSet(staticSite, "source_files.errors/404.html"),
+					resource.TestCheckResourceAttrSet(staticSite, "source_hash"),
+				),
+			},
+			{
+				Config:          testDataS1,
+				ConfigVariables: sourceDir("v1"),
+				PlanOnly:        true,
+			},
+			{
+				Config:          testDataS1,
+				ConfigVariables: sourceDir("v2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(staticSite, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(staticSite, "source_files.%", "2"),
+					resource.TestCheckNoResourceAttr(staticSite, "source_files.app.js"),
+				),
+			},
+			{
+				// Removing the source directory deletes the synced files from the bucket.
+				Config: testDataS1,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(staticSite, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(staticSite, "source_files.%"),
+					resource.TestCheckNoResourceAttr(staticSite, "source_hash"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,41 @@
+variable "prefix" {
+  default = "tf-acc-test-objstov2-policy-doc-"
+  type    = string
+}
+
+variable "region" {
+  default = "europe-3"
+  type    = string
+}
+
+data "upcloud_managed_object_storage_policy_document" "this" {
+  statement {
+    sid       = "ReadWebsite"
+    actions   = ["s3:ListBucket", "s3:GetObject"]
+    resources = ["arn:aws:s3:::website", "arn:aws:s3:::website/*"]
+
+    condition {
+      test     = "IpAddress"
+      variable = "aws:SourceIp"
+      values   = ["192.0.2.0/24"]
+    }
+  }
+
+  statement {
+    effect    = "Deny"
+    actions   = ["s3:DeleteObject"]
+    resources = ["*"]
+  }
+}
+
+resource "upcloud_managed_object_storage" "this" {
+  name              = "${var.prefix}objsto"
+  region            = var.region
+  configured_status = "started"
+}
+
+resource "upcloud_managed_object_storage_policy" "this" {
+  name         = "read-website"
+  document     = data.upcloud_managed_object_storage_policy_document.this.document
+  service_uuid = upcloud_managed_object_storage.this.id
+}
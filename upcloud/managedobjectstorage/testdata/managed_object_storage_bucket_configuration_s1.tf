@@ -0,0 +1,62 @@
+variable "prefix" {
+  default = "tf-acc-test-objstov2-bucket-"
+  type    = string
+}
+
+variable "region" {
+  default = "europe-3"
+  type    = string
+}
+
+resource "upcloud_managed_object_storage" "this" {
+  name              = "${var.prefix}objsto"
+  region            = var.region
+  configured_status = "started"
+
+  network {
+    family = "IPv4"
+    name   = "${var.prefix}public"
+    type   = "public"
+  }
+}
+
+resource "upcloud_managed_object_storage_user" "this" {
+  username     = "${var.prefix}user"
+  service_uuid = upcloud_managed_object_storage.this.id
+}
+
+resource "upcloud_managed_object_storage_user_policy" "this" {
+  name         = "ECSS3FullAccess" // Predefined policy
+  username     = upcloud_managed_object_storage_user.this.username
+  service_uuid = upcloud_managed_object_storage.this.id
+}
+
+resource "upcloud_managed_object_storage_bucket" "this" {
+  access_key_username = upcloud_managed_object_storage_user.this.username
+
+  service_uuid = upcloud_managed_object_storage.this.id
+  name         = "configured"
+
+  versioning = "Enabled"
+
+  lifecycle_rule {
+    id              = "expire-logs"
+    prefix          = "logs/"
+    expiration_days = 30
+  }
+
+  lifecycle_rule {
+    id                                     = "cleanup"
+    noncurrent_version_expiration_days     = 7
+    abort_incomplete_multipart_upload_days = 1
+  }
+
+  cors_rule {
+    allowed_methods = ["GET", "HEAD"]
+    allowed_origins = ["https://example.com"]
+    expose_headers  = ["ETag"]
+    max_age_seconds = 3600
+  }
+
+  depends_on = [upcloud_managed_object_storage_user_policy.this]
+}
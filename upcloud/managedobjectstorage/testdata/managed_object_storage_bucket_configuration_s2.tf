@@ -0,0 +1,51 @@
+variable "prefix" {
+  default = "tf-acc-test-objstov2-bucket-"
+  type    = string
+}
+
+variable "region" {
+  default = "europe-3"
+  type    = string
+}
+
+resource "upcloud_managed_object_storage" "this" {
+  name              = "${var.prefix}objsto"
+  region            = var.region
+  configured_status = "started"
+
+  network {
+    family = "IPv4"
+    name   = "${var.prefix}public"
+    type   = "public"
+  }
+}
+
+resource "upcloud_managed_object_storage_user" "this" {
+  username     = "${var.prefix}user"
+  service_uuid = upcloud_managed_object_storage.this.id
+}
+
+resource "upcloud_managed_object_storage_user_policy" "this" {
+  name         = "ECSS3FullAccess" // Predefined policy
+  username     = upcloud_managed_object_storage_user.this.username
+  service_uuid = upcloud_managed_object_storage.this.id
+}
+
+resource "upcloud_managed_object_storage_bucket" "this" {
+  access_key_username = upcloud_managed_object_storage_user.this.username
+
+  service_uuid = upcloud_managed_object_storage.this.id
+  name         = "configured"
+
+  versioning             = "Suspended"
+  server_side_encryption = "AES256"
+
+  lifecycle_rule {
+    id              = "expire-logs"
+    enabled         = false
+    prefix          = "logs/"
+    expiration_days = 60
+  }
+
+  depends_on = [upcloud_managed_object_storage_user_policy.this]
+}
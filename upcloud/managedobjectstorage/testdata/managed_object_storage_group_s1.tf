@@ -0,0 +1,84 @@
+variable "prefix" {
+  default = "tf-acc-test-objstov2-group-"
+  type    = string
+}
+
+variable "region" {
+  default = "europe-3"
+  type    = string
+}
+
+variable "member_count" {
+  default = 2
+  type    = number
+}
+
+resource "upcloud_managed_object_storage" "this" {
+  name              = "${var.prefix}objsto"
+  region            = var.region
+  configured_status = "started"
+
+  network {
+    family = "IPv4"
+    name   = "${var.prefix}public"
+    type   = "public"
+  }
+}
+
+data "upcloud_managed_object_storage_policy_document" "iam_admin" {
+  statement {
+    actions   = ["iam:*"]
+    resources = ["*"]
+  }
+}
+
+resource "upcloud_managed_object_storage_policy" "iam_admin" {
+  name         = "${var.prefix}iam-admin"
+  document     = data.upcloud_managed_object_storage_policy_document.iam_admin.document
+  service_uuid = upcloud_managed_object_storage.this.id
+}
+
+resource "upcloud_managed_object_storage_user" "admin" {
+  username     = "${var.prefix}admin"
+  service_uuid = upcloud_managed_object_storage.this.id
+}
+
+resource "upcloud_managed_object_storage_user_policy" "admin" {
+  name         = upcloud_managed_object_storage_policy.iam_admin.name
+  username     = upcloud_managed_object_storage_user.admin.username
+  service_uuid = upcloud_managed_object_storage.this.id
+}
+
+resource "upcloud_managed_object_storage_user" "member" {
+  count = 2
+
+  username     = "${var.prefix}member-${count.index}"
+  service_uuid = upcloud_managed_object_storage.this.id
+}
+
+resource "upcloud_managed_object_storage_group" "this" {
+  access_key_username = upcloud_managed_object_storage_user.admin.username
+
+  name         = "${var.prefix}developers"
+  service_uuid = upcloud_managed_object_storage.this.id
+
+  depends_on = [upcloud_managed_object_storage_user_policy.admin]
+}
+
+resource "upcloud_managed_object_storage_group_membership" "this" {
+  count = var.member_count
+
+  access_key_username = upcloud_managed_object_storage_user.admin.username
+
+  group        = upcloud_managed_object_storage_group.this.name
+  username     = upcloud_managed_object_storage_user.member[count.index].username
+  service_uuid = upcloud_managed_object_storage.this.id
+}
+
+resource "upcloud_managed_object_storage_group_policy" "this" {
+  access_key_username = upcloud_managed_object_storage_user.admin.username
+
+  group        = upcloud_managed_object_storage_group.this.name
+  name         = "ECSS3FullAccess" // Predefined policy
+  service_uuid = upcloud_managed_object_storage.this.id
+}
@@ -0,0 +1,59 @@
+variable "prefix" {
+  default = "tf-acc-test-objstov2-object-"
+  type    = string
+}
+
+variable "region" {
+  default = "europe-3"
+  type    = string
+}
+
+variable "content" {
+  default = "{\"env\": \"test\", \"debug\": true}"
+  type    = string
+}
+
+resource "upcloud_managed_object_storage" "this" {
+  name              = "${var.prefix}objsto"
+  region            = var.region
+  configured_status = "started"
+
+  network {
+    family = "IPv4"
+    name   = "${var.prefix}public"
+    type   = "public"
+  }
+}
+
+resource "upcloud_managed_object_storage_user" "this" {
+  username     = "${var.prefix}user"
+  service_uuid = upcloud_managed_object_storage.this.id
+}
+
+resource "upcloud_managed_object_storage_user_policy" "this" {
+  name         = "ECSS3FullAccess" // Predefined policy
+  username     = upcloud_managed_object_storage_user.this.username
+  service_uuid = upcloud_managed_object_storage.this.id
+}
+
+resource "upcloud_managed_object_storage_bucket" "this" {
+  service_uuid = upcloud_managed_object_storage.this.id
+  name         = "artifacts"
+}
+
+resource "upcloud_managed_object_storage_object" "this" {
+  access_key_username = upcloud_managed_object_storage_user.this.username
+
+  service_uuid  = upcloud_managed_object_storage.this.id
+  bucket        = upcloud_managed_object_storage_bucket.this.name
+  key           = "config/app.json"
+  content       = var.content
+  content_type  = "application/vnd.example+json"
+  cache_control = "max-age=60"
+
+  metadata = {
+    environment = "test"
+  }
+
+  depends_on = [upcloud_managed_object_storage_user_policy.this]
+}
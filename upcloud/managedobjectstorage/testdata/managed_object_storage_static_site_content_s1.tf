@@ -0,0 +1,60 @@
+variable "prefix" {
+  default = "tf-acc-test-objstov2-static-content-"
+  type    = string
+}
+
+variable "region" {
+  default = "europe-3"
+  type    = string
+}
+
+variable "source_dir" {
+  default = null
+  type    = string
+}
+
+resource "upcloud_managed_object_storage" "this" {
+  name              = "${var.prefix}objsto"
+  region            = var.region
+  configured_status = "started"
+
+  network {
+    family = "IPv4"
+    name   = "public"
+    type   = "public"
+  }
+}
+
+resource "upcloud_managed_object_storage_bucket" "this" {
+  service_uuid = upcloud_managed_object_storage.this.id
+  name         = "website"
+}
+
+resource "upcloud_managed_object_storage_user" "this" {
+  username     = "${var.prefix}user"
+  service_uuid = upcloud_managed_object_storage.this.id
+}
+
+resource "upcloud_managed_object_storage_user_policy" "this" {
+  name         = "ECSS3FullAccess" // Predefined policy
+  username     = upcloud_managed_object_storage_user.this.username
+  service_uuid = upcloud_managed_object_storage.this.id
+}
+
+resource "upcloud_managed_object_storage_static_site" "this" {
+  access_key_username = upcloud_managed_object_storage_user.this.username
+
+  service_uuid   = upcloud_managed_object_storage.this.id
+  bucket_name    = upcloud_managed_object_storage_bucket.this.name
+  bucket_prefix  = "public/"
+  index_document = "index.html"
+
+  error_pages = [{
+    error_document = "public/errors/404.html"
+    status_code    = 404
+  }]
+
+  source_dir = var.source_dir
+
+  depends_on = [upcloud_managed_object_storage_user_policy.this]
+}
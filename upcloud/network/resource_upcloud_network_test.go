@@ -12,7 +12,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccUpCloudNetwork_basic(t *testing.T) {
@@ -151,6 +154,44 @@ func TestAccUpCloudNetwork_withRouter(t *testing.T) {
 	})
 }
 
+func TestAccUpCloudNetwork_importWithIdentity(t *testing.T) {
+	netName := fmt.Sprintf("test_network_%s", acctest.RandString(5))
+	subnet := acctest.RandIntRange(0, 250)
+	cidr := fmt.Sprintf("10.0.%d.0/24", subnet)
+	gateway := fmt.Sprintf("10.0.%d.1", subnet)
+
+	config := testAccNetworkConfig(netName, "fi-hel1", cidr, gateway, true, false, true, nil, nil)
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		PreCheck:                 func() { upcloud.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: upcloud.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState("upcloud_network.test_network", tfjsonpath.New("id")),
+					statecheck.ExpectIdentityValueMatchesState("upcloud_router.test_network_router", tfjsonpath.New("id")),
+				},
+			},
+			{
+				Config:          config,
+				ResourceName:    "upcloud_network.test_network",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+			{
+				Config:          config,
+				ResourceName:    "upcloud_router.test_network_router",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
 func TestAccUpCloudNetwork_amendWithRouter(t *testing.T) {
 	netName := fmt.Sprintf("test_network_%s", acctest.RandString(5))
 	subnet := acctest.RandIntRange(0, 250)
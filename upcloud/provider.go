@@ -12,6 +12,7 @@ import (
 	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/service/filestorage"
 	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/service/firewall"
 	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/service/firewallruleset"
+	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/service/gateway"
 	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/service/ip"
 	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/service/kubernetes"
 	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/service/loadbalancer"
@@ -29,6 +30,7 @@ import (
 	retryablehttp "github.com/hashicorp/go-retryablehttp"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -60,6 +62,7 @@ type upcloudProvider struct {
 var (
 	_ provider.Provider                       = &upcloudProvider{}
 	_ provider.ProviderWithEphemeralResources = &upcloudProvider{}
+	_ provider.ProviderWithListResources      = &upcloudProvider{}
 )
 
 func New() provider.Provider {
@@ -180,6 +183,7 @@ func (p *upcloudProvider) Configure(ctx context.Context, req provider.ConfigureR
 
 	resp.DataSourceData = withV9
 	resp.EphemeralResourceData = withV9
+	resp.ListResourceData = withV9
 	resp.ResourceData = withV9
 }
 
@@ -187,11 +191,18 @@ func (p *upcloudProvider) Resources(_ context.Context) []func() resource.Resourc
 	return []func() resource.Resource{
 		database.NewMySQLResource,
 		database.NewOpenSearchResource,
+		database.NewOpenSearchIndexResource,
+		database.NewOpenSearchIndexTemplateResource,
+		database.NewOpenSearchISMPolicyResource,
 		database.NewPostgresResource,
+		database.NewConnectionPoolResource,
+		database.NewIntegrationResource,
+		database.NewLogicalDatabaseResource,
 		database.NewValkeyResource,
 		database.NewUserResource,
 		firewall.NewFirewallRulesResource,
 		firewallruleset.NewFirewallRulesetResource,
+		gateway.NewConnectionResource,
 		ip.NewFloatingIPAddressResource,
 		kubernetes.NewKubernetesClusterResource,
 		kubernetes.NewKubernetesNodeGroupResource,
@@ -201,14 +212,20 @@ func (p *upcloudProvider) Resources(_ context.Context) []func() resource.Resourc
 		loadbalancer.NewDynamicCertificateBundleResource,
 		loadbalancer.NewBackendResource,
 		loadbalancer.NewFrontendResource,
+		loadbalancer.NewFrontendIPFilterResource,
 		loadbalancer.NewFrontendRuleResource,
+		loadbalancer.NewFrontendRulesResource,
 		loadbalancer.NewFrontendTLSConfigResource,
 		loadbalancer.NewLoadBalancerResource,
 		loadbalancer.NewManualCertificateBundleResource,
 		loadbalancer.NewResolverResource,
 		managedobjectstorage.NewManagedObjectStorageResource,
 		managedobjectstorage.NewBucketResource,
+		managedobjectstorage.NewObjectResource,
 		managedobjectstorage.NewCustomDomainResource,
+		managedobjectstorage.NewGroupResource,
+		managedobjectstorage.NewGroupMembershipResource,
+		managedobjectstorage.NewGroupPolicyResource,
 		managedobjectstorage.NewStaticSiteResource,
 		managedobjectstorage.NewPolicyResource,
 		managedobjectstorage.NewUserResource,
@@ -233,11 +250,22 @@ func (p *upcloudProvider) DataSources(_ context.Context) []func() datasource.Dat
 		cloud.NewHostsDataSource,
 		cloud.NewZoneDataSource,
 		cloud.NewZonesDataSource,
+		database.NewBackupsDataSource,
+		database.NewOpenSearchIndicesDataSource,
+		database.NewSessionsMySQLDataSource,
+		database.NewSessionsPostgreSQLDataSource,
+		database.NewSessionsValkeyDataSource,
 		ip.NewIPAddressesDataSource,
 		kubernetes.NewKubernetesClusterDataSource,
 		loadbalancer.NewDNSChallengeDomainDataSource,
+		loadbalancer.NewLoadBalancerDataSource,
+		loadbalancer.NewLoadBalancersDataSource,
+		loadbalancer.NewLoadBalancerMetricsDataSource,
 		managedobjectstorage.NewPoliciesDataSource,
+		managedobjectstorage.NewPolicyDocumentDataSource,
 		managedobjectstorage.NewRegionsDataSource,
+		server.NewServerDataSource,
+		server.NewServersDataSource,
 		storage.NewStorageDataSource,
 	}
 }
@@ -245,5 +273,21 @@ func (p *upcloudProvider) DataSources(_ context.Context) []func() datasource.Dat
 func (p *upcloudProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
 	return []func() ephemeral.EphemeralResource{
 		kubernetes.NewKubernetesClusterEphemeral,
+		database.NewCredentialsEphemeral,
+		managedobjectstorage.NewUserAccessKeyEphemeral,
+	}
+}
+
+func (p *upcloudProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		database.NewMySQLListResource,
+		database.NewOpenSearchListResource,
+		database.NewPostgresListResource,
+		database.NewValkeyListResource,
+		ip.NewFloatingIPAddressListResource,
+		network.NewNetworkListResource,
+		router.NewRouterListResource,
+		server.NewServerListResource,
+		storage.NewStorageListResource,
 	}
 }
@@ -5,7 +5,6 @@ import (
 	"time"
 
 	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/config"
-	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/service/database"
 	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/service/gateway"
 	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/service/network"
 	"github.com/UpCloudLtd/terraform-provider-upcloud/internal/service/tag"
@@ -63,20 +62,14 @@ func Provider() *schema.Provider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"upcloud_tag":                               tag.ResourceTag(),
-			"upcloud_gateway":                           gateway.ResourceGateway(),
-			"upcloud_gateway_connection":                gateway.ResourceConnection(),
-			"upcloud_gateway_connection_tunnel":         gateway.ResourceTunnel(),
-			"upcloud_managed_database_logical_database": database.ResourceLogicalDatabase(),
+			"upcloud_tag":                       tag.ResourceTag(),
+			"upcloud_gateway":                   gateway.ResourceGateway(),
+			"upcloud_gateway_connection_tunnel": gateway.ResourceTunnel(),
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
 			"upcloud_networks": network.DataSourceNetworks(),
 			"upcloud_tags":     tag.DataSourceTags(),
-			"upcloud_managed_database_opensearch_indices":  database.DataSourceOpenSearchIndices(),
-			"upcloud_managed_database_mysql_sessions":      database.DataSourceSessionsMySQL(),
-			"upcloud_managed_database_postgresql_sessions": database.DataSourceSessionsPostgreSQL(),
-			"upcloud_managed_database_valkey_sessions":     database.DataSourceSessionsValkey(),
 		},
 
 		ConfigureContextFunc: providerConfigureWithDefaultUserAgent,
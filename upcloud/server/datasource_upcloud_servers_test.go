@@ -0,0 +1,80 @@
+package servertests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/UpCloudLtd/terraform-provider-upcloud/upcloud"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceUpcloudServers_basic(t *testing.T) {
+	config := fmt.Sprintf(`
+		resource "upcloud_server" "this" {
+			zone     = "fi-hel1"
+			hostname = "tf-acc-test-datasource-servers"
+			plan     = "1xCPU-1GB"
+
+			labels = {
+				test = "tf-acc-test-datasource-servers"
+			}
+
+			template {
+				storage = "%s"
+				size    = 10
+			}
+
+			network_interface {
+				type = "public"
+			}
+		}
+
+		data "upcloud_server" "by_id" {
+			id = upcloud_server.this.id
+		}
+
+		data "upcloud_server" "by_hostname" {
+			hostname = upcloud_server.this.hostname
+			zone     = upcloud_server.this.zone
+		}
+
+		data "upcloud_servers" "filtered" {
+			zone           = upcloud_server.this.zone
+			hostname_regex = "^tf-acc-test-datasource-servers$"
+			plan           = "1xCPU-1GB"
+			power_state    = "started"
+
+			labels = upcloud_server.this.labels
+		}
+
+		data "upcloud_servers" "none" {
+			zone           = upcloud_server.this.zone
+			hostname_regex = "^tf-acc-test-datasource-servers$"
+			power_state    = "stopped"
+		}`, upcloud.DebianTemplateUUID)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { upcloud.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: upcloud.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.upcloud_server.by_id", "hostname", "upcloud_server.this", "hostname"),
+					resource.TestCheckResourceAttr("data.upcloud_server.by_id", "power_state", "started"),
+					resource.TestCheckResourceAttr("data.upcloud_server.by_id", "network_interfaces.#", "1"),
+					resource.TestCheckResourceAttr("data.upcloud_server.by_id", "network_interfaces.0.type", "public"),
+					resource.TestCheckResourceAttr("data.upcloud_server.by_id", "network_interfaces.0.ip_addresses.#", "1"),
+					resource.TestCheckResourceAttrPair("data.upcloud_server.by_id", "network_interfaces.0.ip_addresses.0.address", "upcloud_server.this", "network_interface.0.ip_address"),
+					resource.TestCheckResourceAttr("data.upcloud_server.by_id", "storage_devices.#", "1"),
+					resource.TestCheckResourceAttr("data.upcloud_server.by_id", "storage_devices.0.size", "10"),
+					resource.TestCheckResourceAttrPair("data.upcloud_server.by_hostname", "id", "upcloud_server.this", "id"),
+					resource.TestCheckResourceAttr("data.upcloud_servers.filtered", "servers.#", "1"),
+					resource.TestCheckResourceAttrPair("data.upcloud_servers.filtered", "servers.0.id", "upcloud_server.this", "id"),
+					resource.TestCheckResourceAttr("data.upcloud_servers.filtered", "servers.0.labels.test", "tf-acc-test-datasource-servers"),
+					resource.TestCheckResourceAttr("data.upcloud_servers.none", "servers.#", "0"),
+				),
+			},
+		},
+	})
+}
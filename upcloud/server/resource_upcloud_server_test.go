@@ -1189,6 +1189,58 @@ func TestAccUpCloudServer_metadataChange(t *testing.T) {
 	})
 }
 
+func TestAccUpCloudServer_powerState(t *testing.T) {
+	testData := utils.ReadTestDataFile(t, "testdata/server_power_state.tf")
+
+	serverName := "upcloud_server.this"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { upcloud.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: upcloud.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testData,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(serverName, "power_state", "stopped"),
+					resource.TestCheckResourceAttr(serverName, "stop_type", "hard"),
+					resource.TestCheckResourceAttr(serverName, "stop_timeout", "1m"),
+				),
+			},
+			{
+				// Changing the plan should not start the server.
+				Config: testData,
+				ConfigVariables: map[string]config.Variable{
+					"plan": config.StringVariable("1xCPU-2GB"),
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(serverName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(serverName, "plan", "1xCPU-2GB"),
+					resource.TestCheckResourceAttr(serverName, "power_state", "stopped"),
+				),
+			},
+			{
+				Config: testData,
+				ConfigVariables: map[string]config.Variable{
+					"plan":        config.StringVariable("1xCPU-2GB"),
+					"power_state": config.StringVariable("started"),
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(serverName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(serverName, "power_state", "started"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccUpCloudServer_oneTimePassword(t *testing.T) {
 	testDataS1 := utils.ReadTestDataFile(t, "testdata/server_otp.tf")
 
@@ -0,0 +1,38 @@
+variable "prefix" {
+  default = "tf-acc-test-server-power-state-"
+  type    = string
+}
+
+variable "zone" {
+  default = "fi-hel2"
+  type    = string
+}
+
+variable "plan" {
+  default = "1xCPU-1GB"
+  type    = string
+}
+
+variable "power_state" {
+  default = "stopped"
+  type    = string
+}
+
+resource "upcloud_server" "this" {
+  hostname     = "${var.prefix}server"
+  zone         = var.zone
+  plan         = var.plan
+  metadata     = true
+  power_state  = var.power_state
+  stop_type    = "hard"
+  stop_timeout = "1m"
+
+  template {
+    storage = "Ubuntu Server 24.04 LTS (Noble Numbat)"
+    size    = 25
+  }
+
+  network_interface {
+    type = "utility"
+  }
+}